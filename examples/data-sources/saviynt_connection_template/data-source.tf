@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+variable "rest_apps" {
+  description = "REST applications to onboard from the golden connection, keyed by connection name"
+  type = map(object({
+    description     = string
+    connection_json = string
+  }))
+}
+
+# Read the golden REST connection once per application and replace the keys that differ
+data "saviynt_connection_template" "rest" {
+  for_each        = var.rest_apps
+  connection_name = "Golden_Rest_Connection"
+
+  overrides = {
+    description = each.value.description
+    # A null value leaves the key out of attributes
+    status_threshold_config = null
+  }
+}
+
+# attributes is keyed by the arguments of resource_type, here saviynt_rest_connection_resource.
+# Credentials are never returned, so the connection JSON of each application is set separately.
+# Credentials inside JSON attributes such as config_json come back masked as ********.
+resource "saviynt_rest_connection_resource" "app" {
+  for_each        = var.rest_apps
+  connection_name = each.key
+
+  description             = lookup(data.saviynt_connection_template.rest[each.key].attributes, "description", null)
+  defaultsavroles         = lookup(data.saviynt_connection_template.rest[each.key].attributes, "defaultsavroles", null)
+  email_template          = lookup(data.saviynt_connection_template.rest[each.key].attributes, "email_template", null)
+  import_user_json        = lookup(data.saviynt_connection_template.rest[each.key].attributes, "import_user_json", null)
+  import_account_ent_json = lookup(data.saviynt_connection_template.rest[each.key].attributes, "import_account_ent_json", null)
+  create_account_json     = lookup(data.saviynt_connection_template.rest[each.key].attributes, "create_account_json", null)
+  update_account_json     = lookup(data.saviynt_connection_template.rest[each.key].attributes, "update_account_json", null)
+  enable_account_json     = lookup(data.saviynt_connection_template.rest[each.key].attributes, "enable_account_json", null)
+  disable_account_json    = lookup(data.saviynt_connection_template.rest[each.key].attributes, "disable_account_json", null)
+  add_access_json         = lookup(data.saviynt_connection_template.rest[each.key].attributes, "add_access_json", null)
+  remove_access_json      = lookup(data.saviynt_connection_template.rest[each.key].attributes, "remove_access_json", null)
+
+  connection_json_wo = each.value.connection_json
+  wo_version         = "1"
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# Test the saved AD connection. When the connection name comes from a connection resource of the
+# same configuration, the test runs during apply, right after the connection is saved.
+variable "ad_password" {
+  type      = string
+  sensitive = true
+}
+
+data "saviynt_connection_test" "ad" {
+  connection_name = "AD_Connection_1"
+
+  # Saviynt returns saved credentials masked, so they are sent with the test
+  sensitive_attributes = {
+    PASSWORD = var.ad_password
+  }
+
+  # Fail the run when Saviynt cannot reach the target system
+  lifecycle {
+    postcondition {
+      condition     = self.success
+      error_message = "Test Connection failed for ${self.connection_name}: ${self.message}"
+    }
+  }
+}
+
+# The import job only runs against a connection that passed the test
+resource "saviynt_accounts_import_full_job_resource" "ad" {
+  jobs = [
+    {
+      trigger_name    = "ad_accounts_import"
+      job_group       = "DATABASE"
+      cron_expression = "0 0 2 * * ?"
+      connection_name = data.saviynt_connection_test.ad.connection_name
+    }
+  ]
+}
+
+output "ad_connection_latency_ms" {
+  value = data.saviynt_connection_test.ad.latency_ms
+}
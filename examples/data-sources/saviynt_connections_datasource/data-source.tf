@@ -5,4 +5,19 @@ data "saviynt_connections_datasource" "example" {
   connection_name = "sample"
 
   authenticate = true
-}
\ No newline at end of file
+}
+# Audit the configuration of every REST connection
+data "saviynt_connections_datasource" "rest_audit" {
+  connection_type = "REST"
+  fetch_all       = true
+  include_details = true
+
+  authenticate = true
+}
+
+output "rest_config_json" {
+  value = {
+    for c in data.saviynt_connections_datasource.rest_audit.results :
+    c.connectionname => c.details.rest.config_json
+  }
+}
@@ -16,3 +16,11 @@ data "saviynt_entitlement_datasource" "filtered_entitlements" {
   # entitlement_value    = "sample_ent"
   # ent_query           = "ent.id like '1'"
 }
+
+# Gets every entitlement of an endpoint, reading the pages concurrently
+data "saviynt_entitlement_datasource" "endpoint_entitlements" {
+  authenticate = true
+  endpoint     = "sample_endpoint"
+  fetch_all    = true
+  max          = 500
+}
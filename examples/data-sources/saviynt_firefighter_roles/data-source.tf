@@ -0,0 +1,23 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# List every firefighter role
+data "saviynt_firefighter_roles" "all" {
+  authenticate = true
+}
+
+# Look up a single firefighter role
+data "saviynt_firefighter_roles" "db_emergency" {
+  authenticate = true
+  role_name    = "FF_DB_Emergency"
+}
+
+# Fail the plan when emergency access can be requested for more than a day
+check "firefighter_time_frames" {
+  assert {
+    condition = alltrue([
+      for role in data.saviynt_firefighter_roles.all.firefighter_roles : role.max_time_frame_hrs == null || role.max_time_frame_hrs <= 24
+    ])
+    error_message = "Firefighter roles must not allow access for more than 24 hours."
+  }
+}
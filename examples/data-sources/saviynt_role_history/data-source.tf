@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+locals {
+  audited_roles = ["ROLE_FINANCE_APPROVER", "ROLE_PAYROLL_ADMIN"]
+}
+
+# Read the version and last certification of each audited role
+data "saviynt_role_history" "audited" {
+  for_each     = toset(local.audited_roles)
+  role_name    = each.value
+  authenticate = true
+}
+
+# Certify roles at least every 180 days instead of the default 365
+data "saviynt_role_history" "privileged" {
+  role_name           = "ROLE_DOMAIN_ADMIN"
+  authenticate        = true
+  review_max_age_days = 180
+}
+
+# Flag roles that were not certified in the last 12 months
+check "role_certification" {
+  assert {
+    condition = alltrue([
+      for role in data.saviynt_role_history.audited : !role.review_overdue
+    ])
+    error_message = "Roles not certified in the last 12 months: ${join(", ", [for name, role in data.saviynt_role_history.audited : name if role.review_overdue])}"
+  }
+}
+
+output "role_versions" {
+  value = {
+    for name, role in data.saviynt_role_history.audited : name => {
+      version            = role.version
+      last_reviewed_date = role.last_reviewed_date
+      days_since_review  = role.days_since_review
+    }
+  }
+}
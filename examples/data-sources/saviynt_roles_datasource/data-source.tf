@@ -30,6 +30,5 @@ data "saviynt_roles_datasource" "example" {
   # requested_object = "entitlement"
 
   # Custom property filters (examples)
-  # custom_property1 = "Department"
-  # custom_property2 = "Business Unit"
+  # custom_properties = { "1" = "Department", "2" = "Business Unit" }
 }
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# List every object in an exported transport package
+data "saviynt_transport_package" "release" {
+  package_path = "${path.module}/packages/release.zip"
+}
+
+output "package_roles" {
+  value = data.saviynt_transport_package.release.object_names["roles"]
+}
+
+# Only list the workflows and connections of the package
+data "saviynt_transport_package" "workflows" {
+  package_path = "${path.module}/packages/release.zip"
+  object_types = ["workflows", "connections"]
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+variable "SERVICENOW_URL" {
+  type        = string
+  description = "ServiceNow instance URL (e.g., https://dev12345.service-now.com)"
+}
+
+variable "SERVICENOW_USERNAME" {
+  type        = string
+  description = "ServiceNow integration user"
+}
+
+variable "SERVICENOW_PASSWORD" {
+  type        = string
+  description = "Password of the ServiceNow integration user"
+  sensitive   = true
+}
+
+resource "saviynt_connection" "example" {
+  connection_name = "Terraform_ServiceNow_Connector"
+  connection_type = "ServiceNow"
+  description     = "ServiceNow connection managed by Terraform"
+
+  # Attributes are keyed by their API name and are checked for drift
+  attributes = {
+    URL             = var.SERVICENOW_URL
+    USERNAME        = var.SERVICENOW_USERNAME
+    ACCOUNTS_FILTER = jsonencode({ active = "true" })
+  }
+
+  # Sensitive attributes are write-only; change wo_version to send new values
+  sensitive_attributes = {
+    PASSWORD = var.SERVICENOW_PASSWORD
+  }
+  wo_version = "1"
+}
@@ -18,6 +18,11 @@ variable "PASSWORD" {
   description = "DB Connector PASSWORD"
   sensitive   = true
 }
+variable "TRUSTSTORE_PASSWORD" {
+  type        = string
+  description = "Password of the trust store used for the DB connection"
+  sensitive   = true
+}
 resource "saviynt_db_connection_resource" "example" {
   connection_name           = "Terraform_DB_Connector"
   url                       = var.URL
@@ -29,6 +34,14 @@ resource "saviynt_db_connection_resource" "example" {
   password_no_of_caps_alpha = "2"
   password_no_of_digits     = "2"
   password_no_of_spl_chars  = "2"
+  # The placeholder is replaced by the secret in the request only; state keeps the placeholder
+  connection_properties = jsonencode({
+    "javax.net.ssl.trustStore"         = "/opt/saviynt/truststore.jks"
+    "javax.net.ssl.trustStorePassword" = "$${secret.truststore_password}"
+  })
+  secrets = {
+    truststore_password = var.TRUSTSTORE_PASSWORD
+  }
   create_account_json = jsonencode({
     "CreateAccountQry" : [
       "CREATE USER $${accountName.toUpperCase()} PASSWORD $${randomPassword};",
@@ -27,11 +27,13 @@ resource "saviynt_endpoint_resource" "endpoint" {
   endpoint_config = "{\"audit\":true}"
 
   # Sample custom properties (only showing 1–5 for brevity)
-  custom_property1 = "BusinessUnit"
-  custom_property2 = "ApplicationName"
-  custom_property3 = "Region"
-  custom_property4 = "Environment"
-  custom_property5 = "IntegrationID"
+  custom_properties = {
+    "1" = "BusinessUnit"
+    "2" = "ApplicationName"
+    "3" = "Region"
+    "4" = "Environment"
+    "5" = "IntegrationID"
+  }
 
   # Labels for custom properties
   account_custom_property_1_label = "Business Unit"
@@ -82,10 +82,15 @@ resource "saviynt_enterprise_roles_resource" "example" {
     }
   ]
 
+  # Remove the users and entitlements listed here from the role on destroy
+  deletion_policy = "deactivate"
+
   # Custom properties for additional metadata
-  custom_property1 = "Department: HR"
-  custom_property2 = "Cost Center: 1001"
-  custom_property3 = "Business Unit: Corporate"
-  custom_property4 = "Approval Required: Yes"
-  custom_property5 = "Review Frequency: Quarterly"
+  custom_properties = {
+    "1" = "Department: HR"
+    "2" = "Cost Center: 1001"
+    "3" = "Business Unit: Corporate"
+    "4" = "Approval Required: Yes"
+    "5" = "Review Frequency: Quarterly"
+  }
 }
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# The payroll team owns the primary entitlement and sets manage_entitlement_map = false on it
+resource "saviynt_entitlement_map" "payroll_admin_readers" {
+  endpoint          = "payroll-app"
+  entitlement_type  = "Roles"
+  entitlement_value = "payroll_admin"
+
+  # The mapped entitlement can belong to another application
+  mapped_endpoint          = "hr-directory"
+  mapped_entitlement_type  = "Groups"
+  mapped_entitlement_value = "payroll_readers"
+
+  add_dependent_task        = true
+  remove_dependent_ent_task = true
+}
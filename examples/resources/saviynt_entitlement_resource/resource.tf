@@ -49,8 +49,17 @@ resource "saviynt_entitlement_resource" "test_entitlement" {
     }
   ]
 
-  customproperty1  = "Test Custom Property 1"
-  customproperty2  = "Test Custom Property 2"
-  customproperty4  = "Test Custom Property 4"
-  customproperty21 = "Test custom property 21"
+  custom_properties = {
+    "1"  = "Test Custom Property 1"
+    "2"  = "Test Custom Property 2"
+    "4"  = "Test Custom Property 4"
+    "21" = "Test custom property 21"
+
+    # Custom properties can also be set by the label configured on the entitlement type
+    cost_center = "1234"
+  }
+  # Deactivate the entitlement instead of failing when it is removed from the configuration
+  deletion_policy    = "deactivate"
+  deactivation_owner = "user1"
+  deactivation_note  = "Decommissioned with CHG0012345"
 }
\ No newline at end of file
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# Entitlements listed in the configuration
+resource "saviynt_entitlements_bulk" "finance_groups" {
+  endpoint         = "finance-app"
+  entitlement_type = "Groups"
+
+  entitlements = [
+    {
+      entitlement_value = "finance_readers"
+      displayname       = "Finance Readers"
+      risk              = 1
+    },
+    {
+      entitlement_value = "finance_approvers"
+      displayname       = "Finance Approvers"
+      risk              = 3
+      custom_properties = {
+        "3" = "Finance"
+      }
+    },
+  ]
+}
+
+# Entitlements exported from another system as CSV, for example:
+#   entitlement_value,displayname,risk,custom_property3
+#   sap_fi_ap_clerk,AP Clerk,2,Finance
+resource "saviynt_entitlements_bulk" "sap_roles" {
+  endpoint         = "sap-erp"
+  entitlement_type = "Roles"
+  file_path        = "${path.module}/sap_roles.csv"
+
+  batch_size  = 200
+  parallelism = 8
+}
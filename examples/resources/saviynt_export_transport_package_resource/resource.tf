@@ -37,6 +37,9 @@ resource "saviynt_export_transport_package_resource" "comprehensive" {
   environment_name       = "production"
   business_justification = "Full system backup before major upgrade"
   export_package_version = "2.1"
+  wait_for_completion    = true
+  status_timeout         = 3600
+  status_poll_interval   = 30
 
   sav_roles        = ["ROLE_ADMIN", "ROLE_USER", "ROLE_AUDITOR"]
   roles            = ["Manager", "Employee", "Contractor"]
@@ -7,4 +7,11 @@ resource "saviynt_file_upload_resource" "f" {
 
   #Optional
   file_version = "v1.1"
-}
\ No newline at end of file
+}
+
+# Upload every CSV feed of a directory together with additional files
+resource "saviynt_file_upload_resource" "feeds" {
+  file_glob     = "/path/to/feeds/*.csv"
+  file_paths    = ["/path/to/extra/accounts.csv"]
+  path_location = "Datafiles"
+}
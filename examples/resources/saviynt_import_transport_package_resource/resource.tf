@@ -6,6 +6,13 @@ resource "saviynt_import_transport_package_resource" "example" {
   update_user            = "admin"                                             # optional - User performing the import
   business_justification = "Importing configuration changes for Q1 release"    # optional - Business reason for import
   import_package_version = "1.0"                                               # optional - Version identifier for the package
+  wait_for_completion    = true                                                # optional - Wait for the import to reach a terminal status (default true)
+  status_timeout         = 3600                                                # optional - Maximum seconds to wait for the import to complete (default 1800)
+  status_poll_interval   = 30                                                  # optional - Seconds between status checks (default 15)
+}
+
+output "import_status" {
+  value = saviynt_import_transport_package_resource.example.status
 }
 
 # Example with minimal configuration
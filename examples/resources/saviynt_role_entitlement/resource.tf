@@ -0,0 +1,10 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+# The central role module sets manage_entitlements = false on the shared role
+resource "saviynt_role_entitlement" "payroll_read" {
+  role_name         = "finance_approver"
+  endpoint          = "payroll-app"
+  entitlement_type  = "Roles"
+  entitlement_value = "payroll_read"
+}
@@ -0,0 +1,7 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+resource "saviynt_role_members" "finance_approver" {
+  role_name  = "finance_approver"
+  user_names = ["jdoe", "asmith"]
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+resource "saviynt_enterprise_roles_resource" "finance_approver" {
+  role_name    = "finance_approver"
+  role_type    = "ENTERPRISE"
+  requestor    = "admin"
+  manage_users = false
+  owners = [
+    {
+      owner_name = "admin"
+      rank       = "1"
+    }
+  ]
+}
+
+resource "saviynt_role_membership" "jdoe" {
+  role_name = saviynt_enterprise_roles_resource.finance_approver.role_name
+  user_name = "jdoe"
+}
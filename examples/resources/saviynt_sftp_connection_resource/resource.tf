@@ -19,5 +19,12 @@ resource "saviynt_sftp_connection_resource" "example" {
   files_to_get = "*.csv,*.txt"
   files_to_put = "upload/*.json"
 
+  connection_timeout_config = {
+    connection_timeout = 30
+    read_timeout       = 300
+    retry_wait         = 5
+    retry_count        = 3
+  }
+
   wo_version = "v1.2"
 }
\ No newline at end of file
@@ -15,9 +15,12 @@ import (
 // This interface is used by all connection resources (AD, ADSI, REST, DB, etc.)
 type ConnectionOperationsInterface interface {
 	GetConnectionDetails(ctx context.Context, connectionName string) (*openapi.GetConnectionDetailsResponse, *http.Response, error)
-	CreateOrUpdateConnection(ctx context.Context, req openapi.CreateOrUpdateRequest) (*openapi.CreateOrUpdateResponse, *http.Response, error)
+	CreateOrUpdateConnection(ctx context.Context, req openapi.CreateOrUpdateRequest, timeoutConfig *openapi.ConnectionTimeoutConfig) (*openapi.CreateOrUpdateResponse, *http.Response, error)
 	GetConnectionDetailsDataSource(ctx context.Context, connectionParam openapi.GetConnectionDetailsRequest) (*openapi.GetConnectionDetailsResponse, *http.Response, error)
 	GetConnectionsDataSource(ctx context.Context, req openapi.GetConnectionsRequest) (*openapi.GetConnectionsResponse, *http.Response, error)
+	CreateOrUpdateGenericConnection(ctx context.Context, connector openapi.GenericConnector) (*openapi.CreateOrUpdateResponse, *http.Response, error)
+	GetGenericConnectionDetails(ctx context.Context, connectionName string) (*openapi.GenericConnectionResponse, *http.Response, error)
+	TestGenericConnection(ctx context.Context, connector openapi.GenericConnector) (*openapi.CreateOrUpdateResponse, *http.Response, error)
 }
 
 // ConnectionOperationsWrapper wraps the actual connection operations to implement the interface
@@ -31,8 +34,8 @@ func (w *ConnectionOperationsWrapper) GetConnectionDetails(ctx context.Context,
 	return w.client.ConnectionsAPI.GetConnectionDetails(ctx).GetConnectionDetailsRequest(reqParams).Execute()
 }
 
-func (w *ConnectionOperationsWrapper) CreateOrUpdateConnection(ctx context.Context, req openapi.CreateOrUpdateRequest) (*openapi.CreateOrUpdateResponse, *http.Response, error) {
-	return w.client.ConnectionsAPI.CreateOrUpdate(ctx).CreateOrUpdateRequest(req).Execute()
+func (w *ConnectionOperationsWrapper) CreateOrUpdateConnection(ctx context.Context, req openapi.CreateOrUpdateRequest, timeoutConfig *openapi.ConnectionTimeoutConfig) (*openapi.CreateOrUpdateResponse, *http.Response, error) {
+	return w.client.ConnectionsAPI.CreateOrUpdateWithTimeoutConfig(ctx, req, timeoutConfig)
 }
 
 func (w *ConnectionOperationsWrapper) GetConnectionDetailsDataSource(ctx context.Context, connectionParam openapi.GetConnectionDetailsRequest) (*openapi.GetConnectionDetailsResponse, *http.Response, error) {
@@ -43,6 +46,20 @@ func (w *ConnectionOperationsWrapper) GetConnectionsDataSource(ctx context.Conte
 	return w.client.ConnectionsAPI.GetConnections(ctx).GetConnectionsRequest(req).Execute()
 }
 
+func (w *ConnectionOperationsWrapper) CreateOrUpdateGenericConnection(ctx context.Context, connector openapi.GenericConnector) (*openapi.CreateOrUpdateResponse, *http.Response, error) {
+	return w.client.ConnectionsAPI.CreateOrUpdateGeneric(ctx, connector)
+}
+
+func (w *ConnectionOperationsWrapper) GetGenericConnectionDetails(ctx context.Context, connectionName string) (*openapi.GenericConnectionResponse, *http.Response, error) {
+	reqParams := openapi.GetConnectionDetailsRequest{}
+	reqParams.SetConnectionname(connectionName)
+	return w.client.ConnectionsAPI.GetConnectionDetailsGeneric(ctx, reqParams)
+}
+
+func (w *ConnectionOperationsWrapper) TestGenericConnection(ctx context.Context, connector openapi.GenericConnector) (*openapi.CreateOrUpdateResponse, *http.Response, error) {
+	return w.client.ConnectionsAPI.TestConnectionGeneric(ctx, connector)
+}
+
 // ConnectionFactoryInterface defines the interface for creating connection operations
 // This factory is used by all connection resources for dependency injection
 type ConnectionFactoryInterface interface {
@@ -20,6 +20,7 @@ type RoleOperationsInterface interface {
 	UpdateEnterpriseRole(ctx context.Context, req openapi.UpdateEnterpriseRoleRequest) (*openapi.UpdateEnterpriseRoleResponse, *http.Response, error)
 	AddUserToRole(ctx context.Context, userName string, roleName string) (*openapi.AddOrRemoveRoleResponse, *http.Response, error)
 	RemoveUserFromRole(ctx context.Context, userName string, roleName string) (*openapi.AddOrRemoveRoleResponse, *http.Response, error)
+	GetFireFighterRoles(ctx context.Context) ([]openapi.GetFireFighterRole, *http.Response, error)
 }
 
 // RoleOperationsWrapper wraps the actual role operations to implement the interface
@@ -55,6 +56,10 @@ func (w *RoleOperationsWrapper) RemoveUserFromRole(ctx context.Context, userName
 	return w.client.RolesAPI.Removerole(ctx).AddOrRemoveRoleRequest(req).Execute()
 }
 
+func (w *RoleOperationsWrapper) GetFireFighterRoles(ctx context.Context) ([]openapi.GetFireFighterRole, *http.Response, error) {
+	return w.client.RolesAPI.GetFireFighterRoles(ctx).Execute()
+}
+
 // RoleFactoryInterface defines the interface for creating role operations
 // This factory is used by the roles resource for dependency injection
 type RoleFactoryInterface interface {
@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -39,64 +41,64 @@ var adErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeAD)
 
 type ADConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                        types.String `tfsdk:"id"`
-	URL                       types.String `tfsdk:"url"`
-	Username                  types.String `tfsdk:"username"`
-	Password                  types.String `tfsdk:"password"`
-	PasswordWo                types.String `tfsdk:"password_wo"`
-	LdapOrAd                  types.String `tfsdk:"ldap_or_ad"`
-	EntitlementAttribute      types.String `tfsdk:"entitlement_attribute"`
-	CheckForUnique            types.String `tfsdk:"check_for_unique"`
-	GroupSearchBaseDN         types.String `tfsdk:"group_search_base_dn"`
-	CreateUpdateMappings      types.String `tfsdk:"create_update_mappings"`
-	IncrementalConfig         types.String `tfsdk:"incremental_config"`
-	MaxChangeNumber           types.String `tfsdk:"max_changenumber"`
-	ReadOperationalAttributes types.String `tfsdk:"read_operational_attributes"`
-	Base                      types.String `tfsdk:"base"`
-	DcLocator                 types.String `tfsdk:"dc_locator"`
-	StatusThresholdConfig     types.String `tfsdk:"status_threshold_config"`
-	RemoveAccountAction       types.String `tfsdk:"remove_account_action"`
-	AccountAttribute          types.String `tfsdk:"account_attribute"`
-	AccountNameRule           types.String `tfsdk:"account_name_rule"`
-	Advsearch                 types.String `tfsdk:"advsearch"`
-	Setdefaultpagesize        types.String `tfsdk:"setdefaultpagesize"`
-	ResetAndChangePasswrdJson types.String `tfsdk:"reset_and_change_passwrd_json"`
-	ReuseInactiveAccount      types.String `tfsdk:"reuse_inactive_account"`
-	ImportJson                types.String `tfsdk:"import_json"`
-	SupportEmptyString        types.String `tfsdk:"support_empty_string"`
-	EnableAccountJson         types.String `tfsdk:"enable_account_json"`
-	PageSize                  types.String `tfsdk:"page_size"`
-	UserAttribute             types.String `tfsdk:"user_attribute"`
-	DefaultUserRole           types.String `tfsdk:"default_user_role"`
-	Searchfilter              types.String `tfsdk:"searchfilter"`
-	EndpointsFilter           types.String `tfsdk:"endpoints_filter"`
-	CreateAccountJson         types.String `tfsdk:"create_account_json"`
-	UpdateAccountJson         types.String `tfsdk:"update_account_json"`
-	ReuseAccountJson          types.String `tfsdk:"reuse_account_json"`
-	EnforceTreeDeletion       types.String `tfsdk:"enforce_tree_deletion"`
-	AdvanceFilterJson         types.String `tfsdk:"advance_filter_json"`
-	Filter                    types.String `tfsdk:"filter"`
-	Objectfilter              types.String `tfsdk:"objectfilter"`
-	UpdateUserJson            types.String `tfsdk:"update_user_json"`
-	Setrandompassword         types.String `tfsdk:"set_random_password"`
-	PasswordMinLength         types.String `tfsdk:"password_min_length"`
-	PasswordMaxLength         types.String `tfsdk:"password_max_length"`
-	PasswordNoofcapsalpha     types.String `tfsdk:"password_noofcapsalpha"`
-	PasswordNoofsplchars      types.String `tfsdk:"password_noofsplchars"`
-	PasswordNoofdigits        types.String `tfsdk:"password_noofdigits"`
-	GroupImportMapping        types.String `tfsdk:"group_import_mapping"`
-	UnlockAccountJson         types.String `tfsdk:"unlock_account_json"`
-	StatusKeyJson             types.String `tfsdk:"status_key_json"`
-	DisableAccountJson        types.String `tfsdk:"disable_account_json"`
-	ModifyUserdataJson        types.String `tfsdk:"modify_user_data_json"`
-	OrgBase                   types.String `tfsdk:"org_base"`
-	OrganizationAttribute     types.String `tfsdk:"organization_attribute"`
-	Createorgjson             types.String `tfsdk:"create_org_json"`
-	Updateorgjson             types.String `tfsdk:"update_org_json"`
-	ConfigJson                types.String `tfsdk:"config_json"`
-	PamConfig                 types.String `tfsdk:"pam_config"`
-	EnableGroupManagement     types.String `tfsdk:"enable_group_management"`
-	OrgImportJson             types.String `tfsdk:"org_import_json"`
+	ID                        types.String           `tfsdk:"id"`
+	URL                       types.String           `tfsdk:"url"`
+	Username                  types.String           `tfsdk:"username"`
+	Password                  types.String           `tfsdk:"password"`
+	PasswordWo                types.String           `tfsdk:"password_wo"`
+	LdapOrAd                  types.String           `tfsdk:"ldap_or_ad"`
+	EntitlementAttribute      types.String           `tfsdk:"entitlement_attribute"`
+	CheckForUnique            types.String           `tfsdk:"check_for_unique"`
+	GroupSearchBaseDN         types.String           `tfsdk:"group_search_base_dn"`
+	CreateUpdateMappings      customtypes.JSONString `tfsdk:"create_update_mappings"`
+	IncrementalConfig         customtypes.JSONString `tfsdk:"incremental_config"`
+	MaxChangeNumber           types.String           `tfsdk:"max_changenumber"`
+	ReadOperationalAttributes types.String           `tfsdk:"read_operational_attributes"`
+	Base                      types.String           `tfsdk:"base"`
+	DcLocator                 types.String           `tfsdk:"dc_locator"`
+	StatusThresholdConfig     customtypes.JSONString `tfsdk:"status_threshold_config"`
+	RemoveAccountAction       types.String           `tfsdk:"remove_account_action"`
+	AccountAttribute          types.String           `tfsdk:"account_attribute"`
+	AccountNameRule           types.String           `tfsdk:"account_name_rule"`
+	Advsearch                 types.String           `tfsdk:"advsearch"`
+	Setdefaultpagesize        types.String           `tfsdk:"setdefaultpagesize"`
+	ResetAndChangePasswrdJson customtypes.JSONString `tfsdk:"reset_and_change_passwrd_json"`
+	ReuseInactiveAccount      types.String           `tfsdk:"reuse_inactive_account"`
+	ImportJson                customtypes.JSONString `tfsdk:"import_json"`
+	SupportEmptyString        types.String           `tfsdk:"support_empty_string"`
+	EnableAccountJson         customtypes.JSONString `tfsdk:"enable_account_json"`
+	PageSize                  types.String           `tfsdk:"page_size"`
+	UserAttribute             types.String           `tfsdk:"user_attribute"`
+	DefaultUserRole           types.String           `tfsdk:"default_user_role"`
+	Searchfilter              types.String           `tfsdk:"searchfilter"`
+	EndpointsFilter           customtypes.JSONString `tfsdk:"endpoints_filter"`
+	CreateAccountJson         customtypes.JSONString `tfsdk:"create_account_json"`
+	UpdateAccountJson         customtypes.JSONString `tfsdk:"update_account_json"`
+	ReuseAccountJson          customtypes.JSONString `tfsdk:"reuse_account_json"`
+	EnforceTreeDeletion       types.String           `tfsdk:"enforce_tree_deletion"`
+	AdvanceFilterJson         customtypes.JSONString `tfsdk:"advance_filter_json"`
+	Filter                    types.String           `tfsdk:"filter"`
+	Objectfilter              types.String           `tfsdk:"objectfilter"`
+	UpdateUserJson            customtypes.JSONString `tfsdk:"update_user_json"`
+	Setrandompassword         types.String           `tfsdk:"set_random_password"`
+	PasswordMinLength         types.String           `tfsdk:"password_min_length"`
+	PasswordMaxLength         types.String           `tfsdk:"password_max_length"`
+	PasswordNoofcapsalpha     types.String           `tfsdk:"password_noofcapsalpha"`
+	PasswordNoofsplchars      types.String           `tfsdk:"password_noofsplchars"`
+	PasswordNoofdigits        types.String           `tfsdk:"password_noofdigits"`
+	GroupImportMapping        customtypes.JSONString `tfsdk:"group_import_mapping"`
+	UnlockAccountJson         customtypes.JSONString `tfsdk:"unlock_account_json"`
+	StatusKeyJson             customtypes.JSONString `tfsdk:"status_key_json"`
+	DisableAccountJson        customtypes.JSONString `tfsdk:"disable_account_json"`
+	ModifyUserdataJson        customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	OrgBase                   types.String           `tfsdk:"org_base"`
+	OrganizationAttribute     types.String           `tfsdk:"organization_attribute"`
+	Createorgjson             customtypes.JSONString `tfsdk:"create_org_json"`
+	Updateorgjson             customtypes.JSONString `tfsdk:"update_org_json"`
+	ConfigJson                customtypes.JSONString `tfsdk:"config_json"`
+	PamConfig                 customtypes.JSONString `tfsdk:"pam_config"`
+	EnableGroupManagement     types.String           `tfsdk:"enable_group_management"`
+	OrgImportJson             customtypes.JSONString `tfsdk:"org_import_json"`
 }
 
 type AdConnectionResource struct {
@@ -175,14 +177,19 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Base DN for group search. Example: \"CN=Users,DC=Saviynt,DC=ABC,DC=Com\"",
 		},
 		"create_update_mappings": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Mapping for group creation/updation (JSON string). Example: '{\"cn\":\"${role?.customproperty27}\",\"objectCategory\":\"CN=Group,CN=Schema,CN=Configuration,...}'",
 		},
 		"incremental_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Incremental import configuration.",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_incremental_config"),
+			},
 		},
 		"max_changenumber": schema.StringAttribute{
 			Optional:    true,
@@ -205,9 +212,13 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Domain controller locator.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for status thresholds. Example: '{\"statusAndThresholdConfig\":{...}}'",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"remove_account_action": schema.StringAttribute{
 			Optional:    true,
@@ -235,9 +246,13 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Default page size setting. Example: \"FALSE\"",
 		},
 		"reset_and_change_passwrd_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for reset/change password actions. Example: '{\"RESET\":{\"pwdLastSet\":\"0\",\"title\":\"password reset\"},\"CHANGE\":{\"pwdLastSet\":\"-1\",\"title\":\"password changed\"}}'",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_reset_and_change_passwrd_json"),
+			},
 		},
 		"reuse_inactive_account": schema.StringAttribute{
 			Optional:    true,
@@ -245,9 +260,13 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Reuse inactive account flag. Example: \"TRUE\"",
 		},
 		"import_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON import configuration. Example: '{\"envproperties\":{\"com.sun.jndi.ldap.connect.timeout\":\"10000\",...}}'",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_import_json"),
+			},
 		},
 		"support_empty_string": schema.StringAttribute{
 			Optional:    true,
@@ -255,9 +274,13 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Flag for sending empty values. Example: \"FALSE\"",
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration to enable account actions. Example: '{\"USEDNFROMACCOUNT\":\"NO\", ...}'",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_enable_account_json"),
+			},
 		},
 		"page_size": schema.StringAttribute{
 			Optional:    true,
@@ -280,21 +303,25 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "LDAP search filter for users. Example: \"OU=Users,DC=domainname,DC=com\"",
 		},
 		"endpoints_filter": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration for child endpoints.",
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create an account. Example: '{\"cn\":\"${cn}\",\"displayname\":\"${user.displayname}\", ...}'",
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update an account. Example: '{\"uid\":\"${task.accountName.toString().toLowerCase()}\", ...}'",
 		},
 		"reuse_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to reuse an account. Example: '{\"ATTRIBUTESTOCHECK\":{\"userAccountControl\":\"514\",...}}'",
@@ -305,9 +332,13 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Enforce tree deletion flag. Example: \"TRUE\"",
 		},
 		"advance_filter_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Advanced filter JSON configuration.",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_advance_filter_json"),
+			},
 		},
 		"filter": schema.StringAttribute{
 			Optional:    true,
@@ -320,6 +351,7 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "LDAP object filter. Example: \"(objectClass=inetorgperson)\"",
 		},
 		"update_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update a user. Example: '{\"mail\":\"${user.email}\", ...}'",
@@ -355,26 +387,37 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Number of digits required. Example: \"5\"",
 		},
 		"group_import_mapping": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON mapping for LDAP groups. Example: '{\"entitlementTypeName\":\"memberOf\", ...}'",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_group_import_mapping"),
+			},
 		},
 		"unlock_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to unlock accounts. Example: '{\"lockoutTime\":\"0\"}'",
 		},
 		"status_key_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for account status keys. Example: '{\"STATUS_ACTIVE\":[\"512\",\"544\"], ...}'",
+			Validators: []validator.String{
+				validators.JSONSchema("ad_status_key_json"),
+			},
 		},
 		"disable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to disable an account. Example: '{\"userAccountControl\":\"546\", ...}'",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for inline user data transformation.",
@@ -390,21 +433,29 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Organization attributes.",
 		},
 		"create_org_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for organization creation.",
 		},
 		"update_org_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for organization update.",
 		},
 		"config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for connection timeout configuration. Example: '{\"connectionTimeoutConfig\":{\"connectionTimeout\":10,\"readTimeout\":50,\"retryWait\":2,\"retryCount\":3}}'",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for PAM bootstrap configuration. Example: '{\"Connection\":\"AD\",...}'",
@@ -415,6 +466,7 @@ func ADConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Enable group management. Example: \"TRUE\"",
 		},
 		"org_import_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for organization import configuration.",
@@ -506,55 +558,55 @@ func (r *AdConnectionResource) BuildADConnector(plan *ADConnectorResourceModel,
 		ENTITLEMENT_ATTRIBUTE:       util.StringPointerOrEmpty(plan.EntitlementAttribute),
 		CHECKFORUNIQUE:              util.StringPointerOrEmpty(plan.CheckForUnique),
 		GroupSearchBaseDN:           util.StringPointerOrEmpty(plan.GroupSearchBaseDN),
-		CreateUpdateMappings:        util.StringPointerOrEmpty(plan.CreateUpdateMappings),
-		INCREMENTAL_CONFIG:          util.StringPointerOrEmpty(plan.IncrementalConfig),
+		CreateUpdateMappings:        util.StringPointerOrEmpty(plan.CreateUpdateMappings.StringValue),
+		INCREMENTAL_CONFIG:          util.StringPointerOrEmpty(plan.IncrementalConfig.StringValue),
 		MAX_CHANGENUMBER:            util.StringPointerOrEmpty(plan.MaxChangeNumber),
 		READ_OPERATIONAL_ATTRIBUTES: util.StringPointerOrEmpty(plan.ReadOperationalAttributes),
 		BASE:                        util.StringPointerOrEmpty(plan.Base),
 		DC_LOCATOR:                  util.StringPointerOrEmpty(plan.DcLocator),
-		STATUS_THRESHOLD_CONFIG:     util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		STATUS_THRESHOLD_CONFIG:     util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		REMOVEACCOUNTACTION:         util.StringPointerOrEmpty(plan.RemoveAccountAction),
 		ACCOUNT_ATTRIBUTE:           util.StringPointerOrEmpty(plan.AccountAttribute),
 		ACCOUNTNAMERULE:             util.StringPointerOrEmpty(plan.AccountNameRule),
 		ADVSEARCH:                   util.StringPointerOrEmpty(plan.Advsearch),
 		SETDEFAULTPAGESIZE:          util.StringPointerOrEmpty(plan.Setdefaultpagesize),
-		RESETANDCHANGEPASSWRDJSON:   util.StringPointerOrEmpty(plan.ResetAndChangePasswrdJson),
+		RESETANDCHANGEPASSWRDJSON:   util.StringPointerOrEmpty(plan.ResetAndChangePasswrdJson.StringValue),
 		REUSEINACTIVEACCOUNT:        util.StringPointerOrEmpty(plan.ReuseInactiveAccount),
-		IMPORTJSON:                  util.StringPointerOrEmpty(plan.ImportJson),
+		IMPORTJSON:                  util.StringPointerOrEmpty(plan.ImportJson.StringValue),
 		SUPPORTEMPTYSTRING:          util.StringPointerOrEmpty(plan.SupportEmptyString),
-		ENABLEACCOUNTJSON:           util.StringPointerOrEmpty(plan.EnableAccountJson),
+		ENABLEACCOUNTJSON:           util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
 		PAGE_SIZE:                   util.StringPointerOrEmpty(plan.PageSize),
 		USER_ATTRIBUTE:              util.StringPointerOrEmpty(plan.UserAttribute),
 		DEFAULT_USER_ROLE:           util.StringPointerOrEmpty(plan.DefaultUserRole),
 		SEARCHFILTER:                util.StringPointerOrEmpty(plan.Searchfilter),
-		ENDPOINTS_FILTER:            util.StringPointerOrEmpty(plan.EndpointsFilter),
-		CREATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UPDATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		REUSEACCOUNTJSON:            util.StringPointerOrEmpty(plan.ReuseAccountJson),
+		ENDPOINTS_FILTER:            util.StringPointerOrEmpty(plan.EndpointsFilter.StringValue),
+		CREATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UPDATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		REUSEACCOUNTJSON:            util.StringPointerOrEmpty(plan.ReuseAccountJson.StringValue),
 		ENFORCE_TREE_DELETION:       util.StringPointerOrEmpty(plan.EnforceTreeDeletion),
-		ADVANCE_FILTER_JSON:         util.StringPointerOrEmpty(plan.AdvanceFilterJson),
+		ADVANCE_FILTER_JSON:         util.StringPointerOrEmpty(plan.AdvanceFilterJson.StringValue),
 		FILTER:                      util.StringPointerOrEmpty(plan.Filter),
 		OBJECTFILTER:                util.StringPointerOrEmpty(plan.Objectfilter),
-		UPDATEUSERJSON:              util.StringPointerOrEmpty(plan.UpdateUserJson),
+		UPDATEUSERJSON:              util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
 		SETRANDOMPASSWORD:           util.StringPointerOrEmpty(plan.Setrandompassword),
 		PASSWORD_MIN_LENGTH:         util.StringPointerOrEmpty(plan.PasswordMinLength),
 		PASSWORD_MAX_LENGTH:         util.StringPointerOrEmpty(plan.PasswordMaxLength),
 		PASSWORD_NOOFCAPSALPHA:      util.StringPointerOrEmpty(plan.PasswordNoofcapsalpha),
 		PASSWORD_NOOFSPLCHARS:       util.StringPointerOrEmpty(plan.PasswordNoofsplchars),
 		PASSWORD_NOOFDIGITS:         util.StringPointerOrEmpty(plan.PasswordNoofdigits),
-		GroupImportMapping:          util.StringPointerOrEmpty(plan.GroupImportMapping),
-		UNLOCKACCOUNTJSON:           util.StringPointerOrEmpty(plan.UnlockAccountJson),
-		STATUSKEYJSON:               util.StringPointerOrEmpty(plan.StatusKeyJson),
-		DISABLEACCOUNTJSON:          util.StringPointerOrEmpty(plan.DisableAccountJson),
-		MODIFYUSERDATAJSON:          util.StringPointerOrEmpty(plan.ModifyUserdataJson),
+		GroupImportMapping:          util.StringPointerOrEmpty(plan.GroupImportMapping.StringValue),
+		UNLOCKACCOUNTJSON:           util.StringPointerOrEmpty(plan.UnlockAccountJson.StringValue),
+		STATUSKEYJSON:               util.StringPointerOrEmpty(plan.StatusKeyJson.StringValue),
+		DISABLEACCOUNTJSON:          util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
+		MODIFYUSERDATAJSON:          util.StringPointerOrEmpty(plan.ModifyUserdataJson.StringValue),
 		ORG_BASE:                    util.StringPointerOrEmpty(plan.OrgBase),
 		ORGANIZATION_ATTRIBUTE:      util.StringPointerOrEmpty(plan.OrganizationAttribute),
-		CREATEORGJSON:               util.StringPointerOrEmpty(plan.Createorgjson),
-		UPDATEORGJSON:               util.StringPointerOrEmpty(plan.Updateorgjson),
-		ConfigJSON:                  util.StringPointerOrEmpty(plan.ConfigJson),
-		PAM_CONFIG:                  util.StringPointerOrEmpty(plan.PamConfig),
+		CREATEORGJSON:               util.StringPointerOrEmpty(plan.Createorgjson.StringValue),
+		UPDATEORGJSON:               util.StringPointerOrEmpty(plan.Updateorgjson.StringValue),
+		ConfigJSON:                  util.StringPointerOrEmpty(plan.ConfigJson.StringValue),
+		PAM_CONFIG:                  util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 		ENABLEGROUPMANAGEMENT:       util.StringPointerOrEmpty(plan.EnableGroupManagement),
-		ORGIMPORTJSON:               util.StringPointerOrEmpty(plan.OrgImportJson),
+		ORGIMPORTJSON:               util.StringPointerOrEmpty(plan.OrgImportJson.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -578,55 +630,55 @@ func (r *AdConnectionResource) UpdateModelFromCreateResponse(plan *ADConnectorRe
 	plan.EntitlementAttribute = util.SafeStringDatasource(plan.EntitlementAttribute.ValueStringPointer())
 	plan.CheckForUnique = util.SafeStringDatasource(plan.CheckForUnique.ValueStringPointer())
 	plan.GroupSearchBaseDN = util.SafeStringDatasource(plan.GroupSearchBaseDN.ValueStringPointer())
-	plan.CreateUpdateMappings = util.SafeStringDatasource(plan.CreateUpdateMappings.ValueStringPointer())
-	plan.IncrementalConfig = util.SafeStringDatasource(plan.IncrementalConfig.ValueStringPointer())
+	plan.CreateUpdateMappings = customtypes.NewJSONStringPointerValue(plan.CreateUpdateMappings.ValueStringPointer())
+	plan.IncrementalConfig = customtypes.NewJSONStringPointerValue(plan.IncrementalConfig.ValueStringPointer())
 	plan.MaxChangeNumber = util.SafeStringDatasource(plan.MaxChangeNumber.ValueStringPointer())
 	plan.ReadOperationalAttributes = util.SafeStringDatasource(plan.ReadOperationalAttributes.ValueStringPointer())
 	plan.Base = util.SafeStringDatasource(plan.Base.ValueStringPointer())
 	plan.DcLocator = util.SafeStringDatasource(plan.DcLocator.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.RemoveAccountAction = util.SafeStringDatasource(plan.RemoveAccountAction.ValueStringPointer())
 	plan.AccountAttribute = util.SafeStringDatasource(plan.AccountAttribute.ValueStringPointer())
 	plan.AccountNameRule = util.SafeStringDatasource(plan.AccountNameRule.ValueStringPointer())
 	plan.Advsearch = util.SafeStringDatasource(plan.Advsearch.ValueStringPointer())
 	plan.Setdefaultpagesize = util.SafeStringDatasource(plan.Setdefaultpagesize.ValueStringPointer())
-	plan.ResetAndChangePasswrdJson = util.SafeStringDatasource(plan.ResetAndChangePasswrdJson.ValueStringPointer())
+	plan.ResetAndChangePasswrdJson = customtypes.NewJSONStringPointerValue(plan.ResetAndChangePasswrdJson.ValueStringPointer())
 	plan.ReuseInactiveAccount = util.SafeStringDatasource(plan.ReuseInactiveAccount.ValueStringPointer())
-	plan.ImportJson = util.SafeStringDatasource(plan.ImportJson.ValueStringPointer())
+	plan.ImportJson = customtypes.NewJSONStringPointerValue(plan.ImportJson.ValueStringPointer())
 	plan.SupportEmptyString = util.SafeStringDatasource(plan.SupportEmptyString.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
 	plan.PageSize = util.SafeStringDatasource(plan.PageSize.ValueStringPointer())
 	plan.UserAttribute = util.SafeStringDatasource(plan.UserAttribute.ValueStringPointer())
 	plan.DefaultUserRole = util.SafeStringDatasource(plan.DefaultUserRole.ValueStringPointer())
 	plan.Searchfilter = util.SafeStringDatasource(plan.Searchfilter.ValueStringPointer())
-	plan.EndpointsFilter = util.SafeStringDatasource(plan.EndpointsFilter.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.ReuseAccountJson = util.SafeStringDatasource(plan.ReuseAccountJson.ValueStringPointer())
+	plan.EndpointsFilter = customtypes.NewJSONStringPointerValue(plan.EndpointsFilter.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.ReuseAccountJson = customtypes.NewJSONStringPointerValue(plan.ReuseAccountJson.ValueStringPointer())
 	plan.EnforceTreeDeletion = util.SafeStringDatasource(plan.EnforceTreeDeletion.ValueStringPointer())
-	plan.AdvanceFilterJson = util.SafeStringDatasource(plan.AdvanceFilterJson.ValueStringPointer())
+	plan.AdvanceFilterJson = customtypes.NewJSONStringPointerValue(plan.AdvanceFilterJson.ValueStringPointer())
 	plan.Filter = util.SafeStringDatasource(plan.Filter.ValueStringPointer())
 	plan.Objectfilter = util.SafeStringDatasource(plan.Objectfilter.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
 	plan.Setrandompassword = util.SafeStringDatasource(plan.Setrandompassword.ValueStringPointer())
 	plan.PasswordMinLength = util.SafeStringDatasource(plan.PasswordMinLength.ValueStringPointer())
 	plan.PasswordMaxLength = util.SafeStringDatasource(plan.PasswordMaxLength.ValueStringPointer())
 	plan.PasswordNoofcapsalpha = util.SafeStringDatasource(plan.PasswordNoofcapsalpha.ValueStringPointer())
 	plan.PasswordNoofsplchars = util.SafeStringDatasource(plan.PasswordNoofsplchars.ValueStringPointer())
 	plan.PasswordNoofdigits = util.SafeStringDatasource(plan.PasswordNoofdigits.ValueStringPointer())
-	plan.GroupImportMapping = util.SafeStringDatasource(plan.GroupImportMapping.ValueStringPointer())
-	plan.UnlockAccountJson = util.SafeStringDatasource(plan.UnlockAccountJson.ValueStringPointer())
-	plan.StatusKeyJson = util.SafeStringDatasource(plan.StatusKeyJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.ModifyUserdataJson = util.SafeStringDatasource(plan.ModifyUserdataJson.ValueStringPointer())
+	plan.GroupImportMapping = customtypes.NewJSONStringPointerValue(plan.GroupImportMapping.ValueStringPointer())
+	plan.UnlockAccountJson = customtypes.NewJSONStringPointerValue(plan.UnlockAccountJson.ValueStringPointer())
+	plan.StatusKeyJson = customtypes.NewJSONStringPointerValue(plan.StatusKeyJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserdataJson.ValueStringPointer())
 	plan.OrgBase = util.SafeStringDatasource(plan.OrgBase.ValueStringPointer())
 	plan.OrganizationAttribute = util.SafeStringDatasource(plan.OrganizationAttribute.ValueStringPointer())
-	plan.Createorgjson = util.SafeStringDatasource(plan.Createorgjson.ValueStringPointer())
-	plan.Updateorgjson = util.SafeStringDatasource(plan.Updateorgjson.ValueStringPointer())
-	plan.ConfigJson = util.SafeStringDatasource(plan.ConfigJson.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.Createorgjson = customtypes.NewJSONStringPointerValue(plan.Createorgjson.ValueStringPointer())
+	plan.Updateorgjson = customtypes.NewJSONStringPointerValue(plan.Updateorgjson.ValueStringPointer())
+	plan.ConfigJson = customtypes.NewJSONStringPointerValue(plan.ConfigJson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 	plan.EnableGroupManagement = util.SafeStringDatasource(plan.EnableGroupManagement.ValueStringPointer())
-	plan.OrgImportJson = util.SafeStringDatasource(plan.OrgImportJson.ValueStringPointer())
+	plan.OrgImportJson = customtypes.NewJSONStringPointerValue(plan.OrgImportJson.ValueStringPointer())
 	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
 	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
 }
@@ -690,7 +742,7 @@ func (r *AdConnectionResource) CreateADConnection(ctx context.Context, plan *ADC
 	tflog.Debug(ctx, "Executing create operation")
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_ad_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -809,7 +861,7 @@ func (r *AdConnectionResource) UpdateADConnection(ctx context.Context, plan *ADC
 	tflog.Debug(logCtx, "Executing update operation")
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_ad_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -854,14 +906,14 @@ func (r *AdConnectionResource) UpdateModelFromReadResponse(state *ADConnectorRes
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.ADConnectionResponse.Emailtemplate)
 	state.URL = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.URL)
 	state.Advsearch = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ADVSEARCH)
-	state.CreateAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
-	state.DisableAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.DISABLEACCOUNTJSON)
+	state.CreateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
+	state.DisableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.DISABLEACCOUNTJSON)
 	state.GroupSearchBaseDN = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.GroupSearchBaseDN)
 	state.PasswordNoofsplchars = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.PASSWORD_NOOFSPLCHARS)
 	state.PasswordNoofdigits = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.PASSWORD_NOOFDIGITS)
-	state.StatusKeyJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.STATUSKEYJSON)
+	state.StatusKeyJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.STATUSKEYJSON)
 	state.Searchfilter = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.SEARCHFILTER)
-	state.ConfigJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ConfigJSON)
+	state.ConfigJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.ConfigJSON)
 	state.RemoveAccountAction = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.REMOVEACCOUNTACTION)
 	state.AccountAttribute = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ACCOUNT_ATTRIBUTE)
 	state.AccountNameRule = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ACCOUNTNAMERULE)
@@ -874,39 +926,40 @@ func (r *AdConnectionResource) UpdateModelFromReadResponse(state *ADConnectorRes
 	state.PasswordNoofcapsalpha = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.PASSWORD_NOOFCAPSALPHA)
 	state.Setdefaultpagesize = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.SETDEFAULTPAGESIZE)
 	state.ReuseInactiveAccount = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.REUSEINACTIVEACCOUNT)
-	state.ImportJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.IMPORTJSON)
-	state.CreateUpdateMappings = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.CreateUpdateMappings)
-	state.AdvanceFilterJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ADVANCE_FILTER_JSON)
-	state.PamConfig = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.ImportJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.IMPORTJSON)
+	state.CreateUpdateMappings = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.CreateUpdateMappings)
+	state.AdvanceFilterJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.ADVANCE_FILTER_JSON)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.PAM_CONFIG)
 	state.PageSize = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.PAGE_SIZE)
 	state.Base = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.BASE)
 	state.DcLocator = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.DC_LOCATOR)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
-	state.ResetAndChangePasswrdJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.RESETANDCHANGEPASSWRDJSON)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.ResetAndChangePasswrdJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.RESETANDCHANGEPASSWRDJSON)
 	state.SupportEmptyString = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.SUPPORTEMPTYSTRING)
 	state.ReadOperationalAttributes = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.READ_OPERATIONAL_ATTRIBUTES)
-	state.EnableAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
+	state.EnableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
 	state.UserAttribute = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.USER_ATTRIBUTE)
 	state.DefaultUserRole = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.DEFAULT_USER_ROLE)
-	state.EndpointsFilter = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
-	state.UpdateAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
-	state.ReuseAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.REUSEACCOUNTJSON)
+	state.EndpointsFilter = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
+	state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
+	state.ReuseAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.REUSEACCOUNTJSON)
 	state.EnforceTreeDeletion = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ENFORCE_TREE_DELETION)
 	state.Filter = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.FILTER)
 	state.Objectfilter = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.OBJECTFILTER)
-	state.UpdateUserJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.UPDATEUSERJSON)
-	state.GroupImportMapping = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.GroupImportMapping)
-	state.UnlockAccountJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.UNLOCKACCOUNTJSON)
-	state.ModifyUserdataJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.UpdateUserJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.UPDATEUSERJSON)
+	state.GroupImportMapping = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.GroupImportMapping)
+	state.UnlockAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.UNLOCKACCOUNTJSON)
+	state.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
 	state.OrgBase = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ORG_BASE)
 	state.OrganizationAttribute = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ORGANIZATION_ATTRIBUTE)
-	state.Createorgjson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.CREATEORGJSON)
-	state.Updateorgjson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.UPDATEORGJSON)
+	state.Createorgjson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.CREATEORGJSON)
+	state.Updateorgjson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.UPDATEORGJSON)
 	state.MaxChangeNumber = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.MAX_CHANGENUMBER)
-	state.IncrementalConfig = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.INCREMENTAL_CONFIG)
+	state.IncrementalConfig = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.INCREMENTAL_CONFIG)
 	state.CheckForUnique = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.CHECKFORUNIQUE)
 	state.EnableGroupManagement = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ENABLEGROUPMANAGEMENT)
-	state.OrgImportJson = util.SafeStringDatasource(apiResp.ADConnectionResponse.Connectionattributes.ORGIMPORTJSON)
+	state.OrgImportJson = customtypes.NewJSONStringPointerValue(apiResp.ADConnectionResponse.Connectionattributes.ORGIMPORTJSON)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.ADConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *AdConnectionResource) ValidateADConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -955,8 +1008,14 @@ func (r *AdConnectionResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateADConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateADConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "AD connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1011,7 +1070,9 @@ func (r *AdConnectionResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 	apiMessage := util.SafeDeref(apiResp.ADConnectionResponse.Msg)
 	if apiMessage == "success" {
 		state.Msg = types.StringValue("Connection Read Successful")
@@ -1104,8 +1165,14 @@ func (r *AdConnectionResource) Update(ctx context.Context, req resource.UpdateRe
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateADConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateADConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "AD connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1127,7 +1194,9 @@ func (r *AdConnectionResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
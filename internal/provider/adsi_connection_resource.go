@@ -16,6 +16,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -40,49 +42,49 @@ var adsiErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeA
 
 type ADSIConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                          types.String `tfsdk:"id"`
-	URL                         types.String `tfsdk:"url"`
-	Username                    types.String `tfsdk:"username"`
-	Password                    types.String `tfsdk:"password"`
-	PasswordWo                  types.String `tfsdk:"password_wo"`
-	ConnectionUrl               types.String `tfsdk:"connection_url"`
-	ProvisioningUrl             types.String `tfsdk:"provisioning_url"`
-	ForestList                  types.String `tfsdk:"forestlist"`
-	DefaultUserRole             types.String `tfsdk:"default_user_role"`
-	UpdateUserJson              types.String `tfsdk:"updateuserjson"`
-	EndpointsFilter             types.String `tfsdk:"endpoints_filter"`
-	SearchFilter                types.String `tfsdk:"searchfilter"`
-	ObjectFilter                types.String `tfsdk:"objectfilter"`
-	AccountAttribute            types.String `tfsdk:"account_attribute"`
-	StatusThresholdConfig       types.String `tfsdk:"status_threshold_config"`
-	EntitlementAttribute        types.String `tfsdk:"entitlement_attribute"`
-	UserAttribute               types.String `tfsdk:"user_attribute"`
-	GroupSearchBaseDN           types.String `tfsdk:"group_search_base_dn"`
-	CheckForUnique              types.String `tfsdk:"checkforunique"`
-	StatusKeyJson               types.String `tfsdk:"statuskeyjson"`
-	GroupImportMapping          types.String `tfsdk:"group_import_mapping"`
-	ImportNestedMembership      types.String `tfsdk:"import_nested_membership"`
-	PageSize                    types.String `tfsdk:"page_size"`
-	AccountNameRule             types.String `tfsdk:"accountnamerule"`
-	CreateAccountJson           types.String `tfsdk:"createaccountjson"`
-	UpdateAccountJson           types.String `tfsdk:"updateaccountjson"`
-	EnableAccountJson           types.String `tfsdk:"enableaccountjson"`
-	DisableAccountJson          types.String `tfsdk:"disableaccountjson"`
-	RemoveAccountJson           types.String `tfsdk:"removeaccountjson"`
-	AddAccessJson               types.String `tfsdk:"addaccessjson"`
-	RemoveAccessJson            types.String `tfsdk:"removeaccessjson"`
-	ResetAndChangePasswrdJson   types.String `tfsdk:"resetandchangepasswrdjson"`
-	CreateGroupJson             types.String `tfsdk:"creategroupjson"`
-	UpdateGroupJson             types.String `tfsdk:"updategroupjson"`
-	RemoveGroupJson             types.String `tfsdk:"removegroupjson"`
-	AddAccessEntitlementJson    types.String `tfsdk:"addaccessentitlementjson"`
-	CustomConfigJson            types.String `tfsdk:"customconfigjson"`
-	RemoveAccessEntitlementJson types.String `tfsdk:"removeaccessentitlementjson"`
-	CreateServiceAccountJson    types.String `tfsdk:"createserviceaccountjson"`
-	UpdateServiceAccountJson    types.String `tfsdk:"updateserviceaccountjson"`
-	RemoveServiceAccountJson    types.String `tfsdk:"removeserviceaccountjson"`
-	PamConfig                   types.String `tfsdk:"pam_config"`
-	ModifyUserDataJson          types.String `tfsdk:"modifyuserdatajson"`
+	ID                          types.String           `tfsdk:"id"`
+	URL                         types.String           `tfsdk:"url"`
+	Username                    types.String           `tfsdk:"username"`
+	Password                    types.String           `tfsdk:"password"`
+	PasswordWo                  types.String           `tfsdk:"password_wo"`
+	ConnectionUrl               types.String           `tfsdk:"connection_url"`
+	ProvisioningUrl             types.String           `tfsdk:"provisioning_url"`
+	ForestList                  types.String           `tfsdk:"forestlist"`
+	DefaultUserRole             types.String           `tfsdk:"default_user_role"`
+	UpdateUserJson              customtypes.JSONString `tfsdk:"updateuserjson"`
+	EndpointsFilter             customtypes.JSONString `tfsdk:"endpoints_filter"`
+	SearchFilter                types.String           `tfsdk:"searchfilter"`
+	ObjectFilter                types.String           `tfsdk:"objectfilter"`
+	AccountAttribute            types.String           `tfsdk:"account_attribute"`
+	StatusThresholdConfig       customtypes.JSONString `tfsdk:"status_threshold_config"`
+	EntitlementAttribute        types.String           `tfsdk:"entitlement_attribute"`
+	UserAttribute               types.String           `tfsdk:"user_attribute"`
+	GroupSearchBaseDN           types.String           `tfsdk:"group_search_base_dn"`
+	CheckForUnique              types.String           `tfsdk:"checkforunique"`
+	StatusKeyJson               customtypes.JSONString `tfsdk:"statuskeyjson"`
+	GroupImportMapping          customtypes.JSONString `tfsdk:"group_import_mapping"`
+	ImportNestedMembership      types.String           `tfsdk:"import_nested_membership"`
+	PageSize                    types.String           `tfsdk:"page_size"`
+	AccountNameRule             types.String           `tfsdk:"accountnamerule"`
+	CreateAccountJson           customtypes.JSONString `tfsdk:"createaccountjson"`
+	UpdateAccountJson           customtypes.JSONString `tfsdk:"updateaccountjson"`
+	EnableAccountJson           customtypes.JSONString `tfsdk:"enableaccountjson"`
+	DisableAccountJson          customtypes.JSONString `tfsdk:"disableaccountjson"`
+	RemoveAccountJson           customtypes.JSONString `tfsdk:"removeaccountjson"`
+	AddAccessJson               customtypes.JSONString `tfsdk:"addaccessjson"`
+	RemoveAccessJson            customtypes.JSONString `tfsdk:"removeaccessjson"`
+	ResetAndChangePasswrdJson   customtypes.JSONString `tfsdk:"resetandchangepasswrdjson"`
+	CreateGroupJson             customtypes.JSONString `tfsdk:"creategroupjson"`
+	UpdateGroupJson             customtypes.JSONString `tfsdk:"updategroupjson"`
+	RemoveGroupJson             customtypes.JSONString `tfsdk:"removegroupjson"`
+	AddAccessEntitlementJson    customtypes.JSONString `tfsdk:"addaccessentitlementjson"`
+	CustomConfigJson            customtypes.JSONString `tfsdk:"customconfigjson"`
+	RemoveAccessEntitlementJson customtypes.JSONString `tfsdk:"removeaccessentitlementjson"`
+	CreateServiceAccountJson    customtypes.JSONString `tfsdk:"createserviceaccountjson"`
+	UpdateServiceAccountJson    customtypes.JSONString `tfsdk:"updateserviceaccountjson"`
+	RemoveServiceAccountJson    customtypes.JSONString `tfsdk:"removeserviceaccountjson"`
+	PamConfig                   customtypes.JSONString `tfsdk:"pam_config"`
+	ModifyUserDataJson          customtypes.JSONString `tfsdk:"modifyuserdatajson"`
 }
 
 type AdsiConnectionResource struct {
@@ -157,11 +159,13 @@ func ADSIConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Default SAV Role to be assigned to all the new users that gets imported via User Import",
 		},
 		"updateuserjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the attribute Value which will be used to Update existing User",
 		},
 		"endpoints_filter": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Provide the configuration to create Child Endpoints and import associated accounts and entitlements",
@@ -182,9 +186,13 @@ func ADSIConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Map EIC and AD attributes for account import (AD attributes must be in lower case)",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Account status and threshold related config",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"entitlement_attribute": schema.StringAttribute{
 			Optional:    true,
@@ -207,11 +215,13 @@ func ADSIConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Evaluate the uniqueness of an attribute",
 		},
 		"statuskeyjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration to specify Users status",
 		},
 		"group_import_mapping": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Map AD group attribute to EIC entitlement attribute for import",
@@ -232,96 +242,115 @@ func ADSIConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Rule to generate account name.",
 		},
 		"createaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the attributes values which will be used to Create the New Account.",
 		},
 		"updateaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the attributes values which will be used to Update existing Account.",
 		},
 		"enableaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the actions and attribute updates to be performed for enabling an account.",
 		},
 		"disableaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the actions and attributes updates to be performed for disabling an account.",
 		},
 		"removeaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the actions to be performed for deleting an account.",
 		},
 		"addaccessjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to ADD Access (cross domain/forest group membership) to an account.",
 		},
 		"removeaccessjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to REMOVE Access (cross domain/forest group membership) to an account.",
 		},
 		"resetandchangepasswrdjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Reset and Change Password.",
 		},
 		"creategroupjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Create a Group",
 		},
 		"updategroupjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Update a Group",
 		},
 		"removegroupjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Delete a Group",
 		},
 		"addaccessentitlementjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Add nested group hierarchy",
 		},
 		"customconfigjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Custom configuration JSON",
 		},
 		"removeaccessentitlementjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration to Remove nested group hierarchy",
 		},
 		"createserviceaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the Field Value which will be used to Create the New Service Account.",
 		},
 		"updateserviceaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the Field Value which will be used to update the existing Service Account.",
 		},
 		"removeserviceaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify the actions to be performed while deleting a service account.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify Bootstrap Config.",
 		},
 		"modifyuserdatajson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specify this parameter to transform the data during user import.",
@@ -446,7 +475,7 @@ func (r *AdsiConnectionResource) CreateADSIConnection(ctx context.Context, plan
 	tflog.Debug(ctx, "Executing create operation")
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_adsi_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -509,40 +538,40 @@ func (r *AdsiConnectionResource) BuildADSIConnector(plan *ADSIConnectorResourceM
 		FORESTLIST:                  plan.ForestList.ValueString(),
 		PROVISIONING_URL:            util.StringPointerOrEmpty(plan.ProvisioningUrl),
 		DEFAULT_USER_ROLE:           util.StringPointerOrEmpty(plan.DefaultUserRole),
-		UPDATEUSERJSON:              util.StringPointerOrEmpty(plan.UpdateUserJson),
-		ENDPOINTS_FILTER:            util.StringPointerOrEmpty(plan.EndpointsFilter),
+		UPDATEUSERJSON:              util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
+		ENDPOINTS_FILTER:            util.StringPointerOrEmpty(plan.EndpointsFilter.StringValue),
 		SEARCHFILTER:                util.StringPointerOrEmpty(plan.SearchFilter),
 		OBJECTFILTER:                util.StringPointerOrEmpty(plan.ObjectFilter),
 		ACCOUNT_ATTRIBUTE:           util.StringPointerOrEmpty(plan.AccountAttribute),
-		STATUS_THRESHOLD_CONFIG:     util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		STATUS_THRESHOLD_CONFIG:     util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		ENTITLEMENT_ATTRIBUTE:       util.StringPointerOrEmpty(plan.EntitlementAttribute),
 		USER_ATTRIBUTE:              util.StringPointerOrEmpty(plan.UserAttribute),
 		GroupSearchBaseDN:           util.StringPointerOrEmpty(plan.GroupSearchBaseDN),
 		CHECKFORUNIQUE:              util.StringPointerOrEmpty(plan.CheckForUnique),
-		STATUSKEYJSON:               util.StringPointerOrEmpty(plan.StatusKeyJson),
-		GroupImportMapping:          util.StringPointerOrEmpty(plan.GroupImportMapping),
+		STATUSKEYJSON:               util.StringPointerOrEmpty(plan.StatusKeyJson.StringValue),
+		GroupImportMapping:          util.StringPointerOrEmpty(plan.GroupImportMapping.StringValue),
 		ImportNestedMembership:      util.StringPointerOrEmpty(plan.ImportNestedMembership),
 		PAGE_SIZE:                   util.StringPointerOrEmpty(plan.PageSize),
 		ACCOUNTNAMERULE:             util.StringPointerOrEmpty(plan.AccountNameRule),
-		CREATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UPDATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		ENABLEACCOUNTJSON:           util.StringPointerOrEmpty(plan.EnableAccountJson),
-		DISABLEACCOUNTJSON:          util.StringPointerOrEmpty(plan.DisableAccountJson),
-		REMOVEACCOUNTJSON:           util.StringPointerOrEmpty(plan.RemoveAccountJson),
-		ADDACCESSJSON:               util.StringPointerOrEmpty(plan.AddAccessJson),
-		REMOVEACCESSJSON:            util.StringPointerOrEmpty(plan.RemoveAccessJson),
-		RESETANDCHANGEPASSWRDJSON:   util.StringPointerOrEmpty(plan.ResetAndChangePasswrdJson),
-		CREATEGROUPJSON:             util.StringPointerOrEmpty(plan.CreateGroupJson),
-		UPDATEGROUPJSON:             util.StringPointerOrEmpty(plan.UpdateGroupJson),
-		REMOVEGROUPJSON:             util.StringPointerOrEmpty(plan.RemoveGroupJson),
-		ADDACCESSENTITLEMENTJSON:    util.StringPointerOrEmpty(plan.AddAccessEntitlementJson),
-		CUSTOMCONFIGJSON:            util.StringPointerOrEmpty(plan.CustomConfigJson),
-		REMOVEACCESSENTITLEMENTJSON: util.StringPointerOrEmpty(plan.RemoveAccessEntitlementJson),
-		CREATESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.CreateServiceAccountJson),
-		UPDATESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.UpdateServiceAccountJson),
-		REMOVESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.RemoveServiceAccountJson),
-		PAM_CONFIG:                  util.StringPointerOrEmpty(plan.PamConfig),
-		MODIFYUSERDATAJSON:          util.StringPointerOrEmpty(plan.ModifyUserDataJson),
+		CREATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UPDATEACCOUNTJSON:           util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		ENABLEACCOUNTJSON:           util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
+		DISABLEACCOUNTJSON:          util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
+		REMOVEACCOUNTJSON:           util.StringPointerOrEmpty(plan.RemoveAccountJson.StringValue),
+		ADDACCESSJSON:               util.StringPointerOrEmpty(plan.AddAccessJson.StringValue),
+		REMOVEACCESSJSON:            util.StringPointerOrEmpty(plan.RemoveAccessJson.StringValue),
+		RESETANDCHANGEPASSWRDJSON:   util.StringPointerOrEmpty(plan.ResetAndChangePasswrdJson.StringValue),
+		CREATEGROUPJSON:             util.StringPointerOrEmpty(plan.CreateGroupJson.StringValue),
+		UPDATEGROUPJSON:             util.StringPointerOrEmpty(plan.UpdateGroupJson.StringValue),
+		REMOVEGROUPJSON:             util.StringPointerOrEmpty(plan.RemoveGroupJson.StringValue),
+		ADDACCESSENTITLEMENTJSON:    util.StringPointerOrEmpty(plan.AddAccessEntitlementJson.StringValue),
+		CUSTOMCONFIGJSON:            util.StringPointerOrEmpty(plan.CustomConfigJson.StringValue),
+		REMOVEACCESSENTITLEMENTJSON: util.StringPointerOrEmpty(plan.RemoveAccessEntitlementJson.StringValue),
+		CREATESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.CreateServiceAccountJson.StringValue),
+		UPDATESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.UpdateServiceAccountJson.StringValue),
+		REMOVESERVICEACCOUNTJSON:    util.StringPointerOrEmpty(plan.RemoveServiceAccountJson.StringValue),
+		PAM_CONFIG:                  util.StringPointerOrEmpty(plan.PamConfig.StringValue),
+		MODIFYUSERDATAJSON:          util.StringPointerOrEmpty(plan.ModifyUserDataJson.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -564,40 +593,40 @@ func (r *AdsiConnectionResource) UpdateModelFromCreateResponse(plan *ADSIConnect
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
 	plan.ProvisioningUrl = util.SafeStringDatasource(plan.ProvisioningUrl.ValueStringPointer())
 	plan.DefaultUserRole = util.SafeStringDatasource(plan.DefaultUserRole.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
-	plan.EndpointsFilter = util.SafeStringDatasource(plan.EndpointsFilter.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
+	plan.EndpointsFilter = customtypes.NewJSONStringPointerValue(plan.EndpointsFilter.ValueStringPointer())
 	plan.SearchFilter = util.SafeStringDatasource(plan.SearchFilter.ValueStringPointer())
 	plan.ObjectFilter = util.SafeStringDatasource(plan.ObjectFilter.ValueStringPointer())
 	plan.AccountAttribute = util.SafeStringDatasource(plan.AccountAttribute.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.EntitlementAttribute = util.SafeStringDatasource(plan.EntitlementAttribute.ValueStringPointer())
 	plan.UserAttribute = util.SafeStringDatasource(plan.UserAttribute.ValueStringPointer())
 	plan.GroupSearchBaseDN = util.SafeStringDatasource(plan.GroupSearchBaseDN.ValueStringPointer())
 	plan.CheckForUnique = util.SafeStringDatasource(plan.CheckForUnique.ValueStringPointer())
-	plan.StatusKeyJson = util.SafeStringDatasource(plan.StatusKeyJson.ValueStringPointer())
-	plan.GroupImportMapping = util.SafeStringDatasource(plan.GroupImportMapping.ValueStringPointer())
+	plan.StatusKeyJson = customtypes.NewJSONStringPointerValue(plan.StatusKeyJson.ValueStringPointer())
+	plan.GroupImportMapping = customtypes.NewJSONStringPointerValue(plan.GroupImportMapping.ValueStringPointer())
 	plan.ImportNestedMembership = util.SafeStringDatasource(plan.ImportNestedMembership.ValueStringPointer())
 	plan.PageSize = util.SafeStringDatasource(plan.PageSize.ValueStringPointer())
 	plan.AccountNameRule = util.SafeStringDatasource(plan.AccountNameRule.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.RemoveAccountJson = util.SafeStringDatasource(plan.RemoveAccountJson.ValueStringPointer())
-	plan.AddAccessJson = util.SafeStringDatasource(plan.AddAccessJson.ValueStringPointer())
-	plan.RemoveAccessJson = util.SafeStringDatasource(plan.RemoveAccessJson.ValueStringPointer())
-	plan.ResetAndChangePasswrdJson = util.SafeStringDatasource(plan.ResetAndChangePasswrdJson.ValueStringPointer())
-	plan.CreateGroupJson = util.SafeStringDatasource(plan.CreateGroupJson.ValueStringPointer())
-	plan.UpdateGroupJson = util.SafeStringDatasource(plan.UpdateGroupJson.ValueStringPointer())
-	plan.RemoveGroupJson = util.SafeStringDatasource(plan.RemoveGroupJson.ValueStringPointer())
-	plan.AddAccessEntitlementJson = util.SafeStringDatasource(plan.AddAccessEntitlementJson.ValueStringPointer())
-	plan.CustomConfigJson = util.SafeStringDatasource(plan.CustomConfigJson.ValueStringPointer())
-	plan.RemoveAccessEntitlementJson = util.SafeStringDatasource(plan.RemoveAccessEntitlementJson.ValueStringPointer())
-	plan.CreateServiceAccountJson = util.SafeStringDatasource(plan.CreateServiceAccountJson.ValueStringPointer())
-	plan.UpdateServiceAccountJson = util.SafeStringDatasource(plan.UpdateServiceAccountJson.ValueStringPointer())
-	plan.RemoveServiceAccountJson = util.SafeStringDatasource(plan.RemoveServiceAccountJson.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
-	plan.ModifyUserDataJson = util.SafeStringDatasource(plan.ModifyUserDataJson.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.RemoveAccountJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccountJson.ValueStringPointer())
+	plan.AddAccessJson = customtypes.NewJSONStringPointerValue(plan.AddAccessJson.ValueStringPointer())
+	plan.RemoveAccessJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccessJson.ValueStringPointer())
+	plan.ResetAndChangePasswrdJson = customtypes.NewJSONStringPointerValue(plan.ResetAndChangePasswrdJson.ValueStringPointer())
+	plan.CreateGroupJson = customtypes.NewJSONStringPointerValue(plan.CreateGroupJson.ValueStringPointer())
+	plan.UpdateGroupJson = customtypes.NewJSONStringPointerValue(plan.UpdateGroupJson.ValueStringPointer())
+	plan.RemoveGroupJson = customtypes.NewJSONStringPointerValue(plan.RemoveGroupJson.ValueStringPointer())
+	plan.AddAccessEntitlementJson = customtypes.NewJSONStringPointerValue(plan.AddAccessEntitlementJson.ValueStringPointer())
+	plan.CustomConfigJson = customtypes.NewJSONStringPointerValue(plan.CustomConfigJson.ValueStringPointer())
+	plan.RemoveAccessEntitlementJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccessEntitlementJson.ValueStringPointer())
+	plan.CreateServiceAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateServiceAccountJson.ValueStringPointer())
+	plan.UpdateServiceAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateServiceAccountJson.ValueStringPointer())
+	plan.RemoveServiceAccountJson = customtypes.NewJSONStringPointerValue(plan.RemoveServiceAccountJson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
+	plan.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserDataJson.ValueStringPointer())
 
 	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
 	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
@@ -668,45 +697,46 @@ func (r *AdsiConnectionResource) UpdateModelFromReadResponse(state *ADSIConnecto
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Emailtemplate)
 	state.ImportNestedMembership = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ImportNestedMembership)
-	state.CreateAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
-	state.EndpointsFilter = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
-	state.DisableAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.DISABLEACCOUNTJSON)
-	state.RemoveAccessEntitlementJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCESSENTITLEMENTJSON)
+	state.CreateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
+	state.EndpointsFilter = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
+	state.DisableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.DISABLEACCOUNTJSON)
+	state.RemoveAccessEntitlementJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCESSENTITLEMENTJSON)
 	state.GroupSearchBaseDN = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.GroupSearchBaseDN)
-	state.StatusKeyJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.STATUSKEYJSON)
+	state.StatusKeyJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.STATUSKEYJSON)
 	state.DefaultUserRole = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.DEFAULT_USER_ROLE)
 	state.Username = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.USERNAME)
-	state.UpdateServiceAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATESERVICEACCOUNTJSON)
-	state.AddAccessJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ADDACCESSJSON)
-	state.CreateServiceAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CREATESERVICEACCOUNTJSON)
+	state.UpdateServiceAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATESERVICEACCOUNTJSON)
+	state.AddAccessJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.ADDACCESSJSON)
+	state.CreateServiceAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.CREATESERVICEACCOUNTJSON)
 	state.AccountNameRule = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ACCOUNTNAMERULE)
 	state.ConnectionUrl = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CONNECTION_URL)
 	state.AccountAttribute = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ACCOUNT_ATTRIBUTE)
-	state.PamConfig = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.PAM_CONFIG)
 	state.PageSize = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.PAGE_SIZE)
 	state.SearchFilter = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.SEARCHFILTER)
-	state.UpdateGroupJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEGROUPJSON)
-	state.CreateGroupJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CREATEGROUPJSON)
+	state.UpdateGroupJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEGROUPJSON)
+	state.CreateGroupJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.CREATEGROUPJSON)
 	state.EntitlementAttribute = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ENTITLEMENT_ATTRIBUTE)
 	state.CheckForUnique = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CHECKFORUNIQUE)
-	state.RemoveServiceAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVESERVICEACCOUNTJSON)
-	state.UpdateUserJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEUSERJSON)
+	state.RemoveServiceAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVESERVICEACCOUNTJSON)
+	state.UpdateUserJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEUSERJSON)
 	state.URL = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.URL)
-	state.CustomConfigJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.CUSTOMCONFIGJSON)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
-	state.GroupImportMapping = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.GroupImportMapping)
+	state.CustomConfigJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.CUSTOMCONFIGJSON)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.GroupImportMapping = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.GroupImportMapping)
 	state.ProvisioningUrl = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.PROVISIONING_URL)
-	state.RemoveGroupJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEGROUPJSON)
-	state.RemoveAccessJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCESSJSON)
-	state.ResetAndChangePasswrdJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.RESETANDCHANGEPASSWRDJSON)
+	state.RemoveGroupJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEGROUPJSON)
+	state.RemoveAccessJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCESSJSON)
+	state.ResetAndChangePasswrdJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.RESETANDCHANGEPASSWRDJSON)
 	state.UserAttribute = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.USER_ATTRIBUTE)
-	state.AddAccessEntitlementJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ADDACCESSENTITLEMENTJSON)
-	state.ModifyUserDataJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
-	state.EnableAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
+	state.AddAccessEntitlementJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.ADDACCESSENTITLEMENTJSON)
+	state.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.EnableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
 	state.ForestList = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.FORESTLIST)
 	state.ObjectFilter = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.OBJECTFILTER)
-	state.UpdateAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
-	state.RemoveAccountJson = util.SafeStringDatasource(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCOUNTJSON)
+	state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
+	state.RemoveAccountJson = customtypes.NewJSONStringPointerValue(apiResp.ADSIConnectionResponse.Connectionattributes.REMOVEACCOUNTJSON)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.ADSIConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *AdsiConnectionResource) UpdateADSIConnection(ctx context.Context, plan *ADSIConnectorResourceModel, config *ADSIConnectorResourceModel) (*openapi.CreateOrUpdateResponse, error) {
@@ -737,7 +767,7 @@ func (r *AdsiConnectionResource) UpdateADSIConnection(ctx context.Context, plan
 	tflog.Debug(logCtx, "Executing update operation")
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_adsi_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -819,8 +849,14 @@ func (r *AdsiConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateADSIConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateADSIConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "ADSI connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -875,7 +911,9 @@ func (r *AdsiConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.ADSIConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -969,8 +1007,14 @@ func (r *AdsiConnectionResource) Update(ctx context.Context, req resource.Update
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateADSIConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateADSIConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "ADSI connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -992,7 +1036,9 @@ func (r *AdsiConnectionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -4,7 +4,14 @@
 package provider
 
 import (
+	"math"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -13,15 +20,19 @@ type BaseConnectorResourceModel struct {
 	ConnectionKey  types.Int64  `tfsdk:"connection_key"`
 	ConnectionName types.String `tfsdk:"connection_name"`
 	// Description maps to connectionDescription in the API
-	Description        types.String `tfsdk:"description"`
-	DefaultSavRoles    types.String `tfsdk:"defaultsavroles"`
-	EmailTemplate      types.String `tfsdk:"email_template"`
-	VaultConnection    types.String `tfsdk:"vault_connection"`
-	VaultConfiguration types.String `tfsdk:"vault_configuration"`
-	SaveInVault        types.String `tfsdk:"save_in_vault"`
-	WriteOnlyVersion   types.String `tfsdk:"wo_version"`
-	Msg                types.String `tfsdk:"msg"`
-	ErrorCode          types.String `tfsdk:"error_code"`
+	Description        types.String           `tfsdk:"description"`
+	DefaultSavRoles    types.String           `tfsdk:"defaultsavroles"`
+	EmailTemplate      types.String           `tfsdk:"email_template"`
+	VaultConnection    types.String           `tfsdk:"vault_connection"`
+	VaultConfiguration customtypes.JSONString `tfsdk:"vault_configuration"`
+	SaveInVault        types.String           `tfsdk:"save_in_vault"`
+	WriteOnlyVersion   types.String           `tfsdk:"wo_version"`
+	Secrets            types.Map              `tfsdk:"secrets"`
+	Msg                types.String           `tfsdk:"msg"`
+	ErrorCode          types.String           `tfsdk:"error_code"`
+
+	// ConnectionTimeoutConfig maps to connectionTimeoutConfig in the API
+	ConnectionTimeoutConfig *ConnectionTimeoutConfig `tfsdk:"connection_timeout_config"`
 }
 
 func BaseConnectorResourceSchema() map[string]schema.Attribute {
@@ -54,6 +65,7 @@ func BaseConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Specifies the type of vault connection being used (e.g., 'Hashicorp'). Example: \"Hashicorp\"",
 		},
 		"vault_configuration": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Description: "JSON string specifying vault configuration.",
 		},
@@ -65,6 +77,33 @@ func BaseConnectorResourceSchema() map[string]schema.Attribute {
 			Optional:    true,
 			Description: "Add/change the value of this attribute to update the writeonly attributes like username, password etc in connection resources",
 		},
+		"secrets": schema.MapAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: "Secret values keyed by name (write-only). A `${secret.NAME}` placeholder in a JSON attribute is replaced by the value of NAME " +
+				"in the request sent to Saviynt only; state keeps the placeholder. Change wo_version to send new values.",
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(stringvalidator.RegexMatches(connectionSecretName, "must contain only letters, digits, underscores and hyphens")),
+			},
+		},
+		"connection_timeout_config": schema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Timeout and retry settings of the connection. Only the settings that are set are sent to Saviynt " +
+				"and checked for drift. Cannot be combined with connectionTimeoutConfig in config_json or with timeout settings in the " +
+				"custom_config_json of a Unix connection. The settings are sent as the connectionTimeoutConfig connection attribute, which " +
+				"the OpenAPI description of the connections API lists in the connection details but not in the create or update request; " +
+				"the connection is read back after it is saved and the apply fails when Saviynt did not apply the settings.",
+			Attributes: map[string]schema.Attribute{
+				"connection_timeout":          connectionTimeoutSetting("Connection timeout duration (in seconds). Example: 10"),
+				"read_timeout":                connectionTimeoutSetting("Read timeout duration (in seconds). Example: 60"),
+				"retry_wait":                  connectionTimeoutSetting("Wait time before retrying a failed connection (in seconds). Example: 2"),
+				"retry_wait_max_value":        connectionTimeoutSetting("Maximum wait time for retries (in seconds). Example: 100"),
+				"retry_count":                 connectionTimeoutSetting("Number of retry attempts allowed. Example: 3"),
+				"retry_failure_status_code":   connectionTimeoutSetting("HTTP status code of a failed call that is retried. Example: 429"),
+				"token_refresh_max_try_count": connectionTimeoutSetting("Maximum number of retries for token refresh. Example: 3"),
+			},
+		},
 		"msg": schema.StringAttribute{
 			Computed:    true,
 			Description: "A message indicating the outcome of the operation.",
@@ -75,3 +114,13 @@ func BaseConnectorResourceSchema() map[string]schema.Attribute {
 		},
 	}
 }
+
+func connectionTimeoutSetting(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.Int64{
+			int64validator.Between(0, math.MaxInt32),
+		},
+	}
+}
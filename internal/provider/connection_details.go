@@ -0,0 +1,421 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/connections"
+)
+
+const (
+	// ConnectionDetailsParallelism bounds the number of connection details requests in flight
+	ConnectionDetailsParallelism = 4
+	// maskedConnectionValue replaces the value of a connection attribute that holds a credential
+	maskedConnectionValue = "********"
+)
+
+// connectionSecretAttributes holds the names of the connection attributes that carry credentials,
+// lower case and without separators, as the resources mark them sensitive.
+var connectionSecretAttributes = map[string]bool{
+	"accesstoken":              true,
+	"accesstokens":             true,
+	"authcredentialvalue":      true,
+	"authtoken":                true,
+	"azuremgmtaccesstoken":     true,
+	"changepassjson":           true,
+	"clientsecret":             true,
+	"connectionjson":           true,
+	"passphrase":               true,
+	"password":                 true,
+	"pemkeyfile":               true,
+	"provpassword":             true,
+	"refreshtoken":             true,
+	"sshkey":                   true,
+	"sshpassthroughpassphrase": true,
+	"sshpassthroughpassword":   true,
+	"sshpassthroughsshkey":     true,
+	"windowsconnectorjson":     true,
+	"x509cert":                 true,
+	"x509key":                  true,
+}
+
+// connectionSecretFragments mark attribute names and JSON keys that hold credentials wherever they
+// appear in the name, for example "client_secret", "api_key" or an "Authorization" header.
+var connectionSecretFragments = []string{
+	"apikey", "authorization", "credential", "passphrase", "password", "passwd", "privatekey", "secret", "sshkey", "token",
+}
+
+// connectionNonSecretMarkers mark names that contain a fragment but describe a credential rather
+// than hold one, for example "token_url", "password_min_length" or "token_refresh_max_try_count".
+// Names with "json" hold JSON that is masked key by key instead.
+var connectionNonSecretMarkers = []string{
+	"count", "endpoint", "expiry", "field", "filter", "format", "json", "length", "mapping", "policy", "timeout", "type", "uri", "url",
+}
+
+// ConnectionDetails holds the attributes of one connection as returned by getConnectionDetails.
+// Only the block of the connector type of the connection is set; connections of a type the
+// provider has no model for have their attributes in RawAttributes instead.
+type ConnectionDetails struct {
+	AD            *ADConnectionAttributes          `tfsdk:"ad"`
+	ADSI          *ADSIConnectionAttributes        `tfsdk:"adsi"`
+	DB            *DBConnectionAttributes          `tfsdk:"db"`
+	EntraID       *EntraIdConnectionAttributes     `tfsdk:"entra_id"`
+	GithubRest    *GithubRestConnectionAttributes  `tfsdk:"github_rest"`
+	Okta          *OktaConnectionAttributes        `tfsdk:"okta"`
+	REST          *RESTConnectionAttributes        `tfsdk:"rest"`
+	Salesforce    *SalesforceConnectionAttributes  `tfsdk:"salesforce"`
+	SAP           *SapConnectionAttributes         `tfsdk:"sap"`
+	SFTP          *SFTPConnectionAttributes        `tfsdk:"sftp"`
+	Unix          *UnixConnectionAttributes        `tfsdk:"unix"`
+	Workday       *WorkdayConnectionAttributes     `tfsdk:"workday"`
+	WorkdaySOAP   *WorkdaySOAPConnectionAttributes `tfsdk:"workday_soap"`
+	RawAttributes types.Map                        `tfsdk:"raw_attributes"`
+}
+
+// ConnectionDetailsSchema returns the schema of the details block of a connection, built from the
+// connection_attributes blocks of the typed connection data sources.
+func ConnectionDetailsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		Description: "Connection attributes read from getConnectionDetails when include_details is true. Only the block of " +
+			"the connector type of the connection is set. Attributes that hold credentials are masked, and so are the credentials inside JSON attributes such as config_json, matched by names that contain password, secret, token, apikey, authorization or similar.",
+		Attributes: map[string]schema.Attribute{
+			"ad":           ADConnectorsDataSourceSchema()["connection_attributes"],
+			"adsi":         ADSIConnectorsDataSourceSchema()["connection_attributes"],
+			"db":           DBConnectorsDataSourceSchema()["connection_attributes"],
+			"entra_id":     EntraIDConnectorsDataSourceSchema()["connection_attributes"],
+			"github_rest":  GithubRestConnectorsDataSourceSchema()["connection_attributes"],
+			"okta":         OktaConnectorsDataSourceSchema()["connection_attributes"],
+			"rest":         RESTConnectorsDataSourceSchema()["connection_attributes"],
+			"salesforce":   SalesforceConnectorsDataSourceSchema()["connection_attributes"],
+			"sap":          SapConnectorsDataSourceSchema()["connection_attributes"],
+			"sftp":         SFTPConnectorsDataSourceSchema()["connection_attributes"],
+			"unix":         UnixConnectorsDataSourceSchema()["connection_attributes"],
+			"workday":      WorkdayConnectorsDataSourceSchema()["connection_attributes"],
+			"workday_soap": WorkdaySOAPConnectorsDataSourceSchema()["connection_attributes"],
+			"raw_attributes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Connection attributes of a connector type without a typed block, as strings. Values that are not strings hold their JSON encoding.",
+			},
+		},
+	}
+}
+
+// IsConnectionSecretAttribute reports whether the connection attribute or JSON key name holds a
+// credential: one of the sensitive resource attributes, or a name that contains a credential
+// fragment and no marker of a non-secret setting. Case, "_", "-" and spaces are ignored.
+func IsConnectionSecretAttribute(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(name))
+	if connectionSecretAttributes[normalized] {
+		return true
+	}
+	for _, marker := range connectionNonSecretMarkers {
+		if strings.Contains(normalized, marker) {
+			return false
+		}
+	}
+	for _, fragment := range connectionSecretFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskConnectionSecret masks a credential value that is set.
+func maskConnectionSecret(value types.String) types.String {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return value
+	}
+	return types.StringValue(maskedConnectionValue)
+}
+
+// maskConnectionAttributes masks the string attributes of a connection_attributes block: those
+// named as credentials entirely and the credentials inside JSON values key by key. attributes is
+// a pointer to the block and may be nil.
+func maskConnectionAttributes(attributes interface{}) {
+	value := reflect.ValueOf(attributes)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		text, ok := field.Interface().(types.String)
+		if !ok || !field.CanSet() {
+			continue
+		}
+		if IsConnectionSecretAttribute(value.Type().Field(i).Tag.Get("tfsdk")) {
+			field.Set(reflect.ValueOf(maskConnectionSecret(text)))
+		} else if !text.IsNull() && !text.IsUnknown() {
+			field.Set(reflect.ValueOf(types.StringValue(maskConnectionJSONSecrets(text.ValueString()))))
+		}
+	}
+}
+
+// maskConnectionJSONSecrets masks the values of the keys that hold credentials in a JSON object or
+// array, at any depth, so that arguments such as config_json and pam_config do not return the
+// credentials they carry. Text that is not JSON, or holds no credentials, is returned as is.
+func maskConnectionJSONSecrets(text string) string {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return text
+	}
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return text
+	}
+	if !maskConnectionJSONValue(value) {
+		return text
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return maskedConnectionValue
+	}
+	return string(encoded)
+}
+
+// maskConnectionJSONValue masks the credentials in a decoded JSON value in place and reports
+// whether any were found.
+func maskConnectionJSONValue(value interface{}) bool {
+	masked := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if IsConnectionSecretAttribute(key) {
+				if text, ok := ConnectionAttributeString(item); ok && text != "" {
+					v[key] = maskedConnectionValue
+					masked = true
+				}
+				continue
+			}
+			if maskConnectionJSONValue(item) {
+				masked = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if maskConnectionJSONValue(item) {
+				masked = true
+			}
+		}
+	}
+	return masked
+}
+
+// ReadConnectionDetails reads the details of a connection by name. The body of a connector type
+// the API client has no model for does not decode into any typed response, so it is returned raw.
+func (d *ConnectionsDataSource) ReadConnectionDetails(ctx context.Context, connectionName string) (*openapi.GetConnectionDetailsResponse, []byte, error) {
+	var apiResp *openapi.GetConnectionDetailsResponse
+	var raw []byte
+
+	err := d.provider.AuthenticatedAPICallWithRetry(ctx, "read_connection_details", func(token string) error {
+		apiResp, raw = nil, nil
+		connectionOps := d.connectionFactory.CreateConnectionOperations(d.client.APIBaseURL(), token)
+
+		reqParams := openapi.GetConnectionDetailsRequest{}
+		reqParams.SetConnectionname(connectionName)
+		resp, httpResp, err := connectionOps.GetConnectionDetailsDataSource(ctx, reqParams)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		var apiErr *openapi.GenericOpenAPIError
+		if err != nil && httpResp != nil && httpResp.StatusCode < 300 && errors.As(err, &apiErr) {
+			raw = apiErr.Body()
+			return nil
+		}
+		apiResp = resp
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read connection details: %w", err)
+	}
+	return apiResp, raw, nil
+}
+
+// MapConnectionDetails maps the details of a connection to the typed block of its connector type,
+// or to raw attributes when the response did not decode, with credentials masked.
+func MapConnectionDetails(ctx context.Context, apiResp *openapi.GetConnectionDetailsResponse, raw []byte) (*ConnectionDetails, error) {
+	details := &ConnectionDetails{RawAttributes: types.MapNull(types.StringType)}
+
+	if apiResp == nil {
+		attributes, err := rawConnectionAttributes(raw)
+		if err != nil {
+			return nil, err
+		}
+		rawAttributes, diags := types.MapValueFrom(ctx, types.StringType, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to map connection attributes")
+		}
+		details.RawAttributes = rawAttributes
+		return details, nil
+	}
+
+	switch {
+	case apiResp.ADConnectionResponse != nil:
+		var state ADConnectionDataSourceModel
+		(&AdConnectionsDataSource{}).MapADConnectionAttributes(&state, apiResp)
+		details.AD = state.ConnectionAttributes
+	case apiResp.ADSIConnectionResponse != nil:
+		var state ADSIConnectionDataSourceModel
+		(&AdsiConnectionsDataSource{}).MapADSIConnectionAttributes(&state, apiResp)
+		details.ADSI = state.ConnectionAttributes
+	case apiResp.DBConnectionResponse != nil:
+		var state DBConnectionDataSourceModel
+		(&DbConnectionsDataSource{}).MapDBConnectionAttributes(&state, apiResp)
+		details.DB = state.ConnectionAttributes
+	case apiResp.EntraIDConnectionResponse != nil:
+		var state EntraIdConnectionDataSourceModel
+		(&EntraIdConnectionDataSource{}).MapEntraIDConnectionAttributes(&state, apiResp)
+		details.EntraID = state.ConnectionAttributes
+	case apiResp.GithubRESTConnectionResponse != nil:
+		var state GithubRestConnectionDataSourceModel
+		(&GithubRestConnectionDataSource{}).MapGithubRestConnectionAttributes(&state, apiResp)
+		details.GithubRest = state.ConnectionAttributes
+	case apiResp.OktaConnectionResponse != nil:
+		var state OktaConnectionDataSourceModel
+		(&OktaConnectionsDataSource{}).MapOktaConnectionAttributes(&state, apiResp)
+		details.Okta = state.ConnectionAttributes
+	case apiResp.RESTConnectionResponse != nil:
+		var state RESTConnectionDataSourceModel
+		(&restConnectionDatasource{}).MapRESTConnectionAttributes(&state, apiResp)
+		details.REST = state.ConnectionAttributes
+	case apiResp.SalesforceConnectionResponse != nil:
+		var state SalesforceConnectionDataSourceModel
+		(&SalesforceConnectionDataSource{}).MapSalesforceConnectionAttributes(&state, apiResp)
+		details.Salesforce = state.ConnectionAttributes
+	case apiResp.SAPConnectionResponse != nil:
+		var state SapConnectionDataSourceModel
+		(&SapConnectionDataSource{}).MapSAPConnectionAttributes(&state, apiResp)
+		details.SAP = state.ConnectionAttributes
+	case apiResp.SFTPConnectionResponse != nil:
+		var state SFTPConnectionDataSourceModel
+		(&SftpConnectionsDataSource{}).MapSFTPConnectionAttributes(&state, apiResp.SFTPConnectionResponse.Connectionattributes)
+		details.SFTP = state.ConnectionAttributes
+	case apiResp.UNIXConnectionResponse != nil:
+		var state UnixConnectionDataSourceModel
+		(&UnixConnectionsDataSource{}).MapUnixConnectionAttributes(&state, apiResp)
+		details.Unix = state.ConnectionAttributes
+	case apiResp.WorkdayConnectionResponse != nil:
+		var state WorkdayConnectionDataSourceModel
+		(&WorkdayConnectionDataSource{}).MapWorkdayConnectionAttributes(&state, apiResp)
+		details.Workday = state.ConnectionAttributes
+	case apiResp.WorkdaySOAPConnectionResponse != nil:
+		var state WorkdaySOAPConnectionDataSourceModel
+		(&WorkdaySOAPConnectionDataSource{}).MapWorkdaySOAPConnectionAttributes(&state, apiResp)
+		details.WorkdaySOAP = state.ConnectionAttributes
+	}
+
+	for _, attributes := range []interface{}{details.AD, details.ADSI, details.DB, details.EntraID, details.GithubRest, details.Okta,
+		details.REST, details.Salesforce, details.SAP, details.SFTP, details.Unix, details.Workday, details.WorkdaySOAP} {
+		maskConnectionAttributes(attributes)
+	}
+	return details, nil
+}
+
+// rawConnectionAttributes returns the connection attributes of a getConnectionDetails response
+// body as strings, with credentials masked.
+func rawConnectionAttributes(body []byte) (map[string]string, error) {
+	var resp struct {
+		ErrorCode            json.RawMessage        `json:"errorcode"`
+		Msg                  string                 `json:"msg"`
+		Connectionattributes map[string]interface{} `json:"connectionattributes"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode connection details: %w", err)
+	}
+	if code := strings.Trim(string(resp.ErrorCode), `"`); code != "" && code != "0" {
+		return nil, fmt.Errorf("error code %s: %s", code, resp.Msg)
+	}
+
+	attributes := make(map[string]string, len(resp.Connectionattributes))
+	for name, value := range resp.Connectionattributes {
+		text, ok := ConnectionAttributeString(value)
+		if !ok {
+			continue
+		}
+		if text != "" && IsConnectionSecretAttribute(name) {
+			text = maskedConnectionValue
+		} else {
+			text = maskConnectionJSONSecrets(text)
+		}
+		attributes[name] = text
+	}
+	return attributes, nil
+}
+
+// ConnectionAttributeString returns a connection attribute value decoded from JSON as a string.
+// Values that are not strings are returned in their JSON encoding; null values are not returned.
+func ConnectionAttributeString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v), true
+		}
+		return string(encoded), true
+	}
+}
+
+// ReadAllConnectionDetails reads the details of every connection in results, with at most
+// ConnectionDetailsParallelism requests in flight, and sets them on the results. Connections whose
+// details cannot be read keep null details and are returned as failures.
+func (d *ConnectionsDataSource) ReadAllConnectionDetails(ctx context.Context, results []Connection) []string {
+	slots := make(chan struct{}, ConnectionDetailsParallelism)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failures []string
+
+	for i := range results {
+		connectionName := results[i].ConnectionName.ValueString()
+		if connectionName == "" {
+			continue
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int, connectionName string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			apiResp, raw, err := d.ReadConnectionDetails(ctx, connectionName)
+			var details *ConnectionDetails
+			if err == nil {
+				details, err = MapConnectionDetails(ctx, apiResp, raw)
+			}
+			if err != nil {
+				tflog.Warn(ctx, "Failed to read connection details", map[string]interface{}{
+					"connection_name": connectionName,
+					"error":           err.Error(),
+				})
+				mu.Lock()
+				failures = append(failures, fmt.Sprintf("%s: %s", connectionName, err))
+				mu.Unlock()
+				return
+			}
+			results[i].Details = details
+		}(i, connectionName)
+	}
+	wg.Wait()
+
+	sort.Strings(failures)
+	return failures
+}
@@ -0,0 +1,811 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_connection manages connections of any connector type in the Saviynt Security Manager,
+// for connector types that have no typed connection resource.
+// The resource implements the full Terraform lifecycle:
+//   - Create: provisions a new connection from connection_type, attributes and sensitive_attributes.
+//   - Read: fetches the attributes managed in state and reports drift on the non-secret ones.
+//   - Update: applies any configuration changes to an existing connection.
+//   - Import: brings an existing connection under Terraform management by its name.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/errorsutil"
+
+	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/connections"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConnectionResource{}
+var _ resource.ResourceWithImportState = &ConnectionResource{}
+var _ resource.ResourceWithValidateConfig = &ConnectionResource{}
+var _ resource.ResourceWithModifyPlan = &ConnectionResource{}
+
+// Initialize error codes for generic connection operations
+var genericConnErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeGeneric)
+
+// connectionBaseFields holds the lower case API names of the fields set from the base connector
+// attributes, which cannot be set through attributes or sensitive_attributes.
+var connectionBaseFields = map[string]bool{
+	"connectionname":          true,
+	"connectiontype":          true,
+	"connectiondescription":   true,
+	"defaultsavrole":          true,
+	"emailtemplate":           true,
+	"sslcertificate":          true,
+	"vaultconnection":         true,
+	"vaultconfiguration":      true,
+	"saveinvault":             true,
+	"connectiontimeoutconfig": true,
+}
+
+type ConnectionResourceModel struct {
+	BaseConnectorResourceModel
+	ID                  types.String `tfsdk:"id"`
+	ConnectionType      types.String `tfsdk:"connection_type"`
+	Attributes          types.Map    `tfsdk:"attributes"`
+	SensitiveAttributes types.Map    `tfsdk:"sensitive_attributes"`
+}
+
+type ConnectionResource struct {
+	client            client.SaviyntClientInterface
+	token             string
+	provider          client.SaviyntProviderInterface
+	connectionFactory client.ConnectionFactoryInterface
+}
+
+func NewConnectionResource() resource.Resource {
+	return &ConnectionResource{
+		connectionFactory: &client.DefaultConnectionFactory{},
+	}
+}
+
+func NewConnectionResourceWithFactory(factory client.ConnectionFactoryInterface) resource.Resource {
+	return &ConnectionResource{
+		connectionFactory: factory,
+	}
+}
+
+func (r *ConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "saviynt_connection"
+}
+
+func ConnectionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Resource ID.",
+		},
+		"connection_type": schema.StringAttribute{
+			Required:    true,
+			Description: "Connector type of the connection as Saviynt names it. Cannot be changed after creation; a change is rejected at plan time. Example: \"ServiceNow\"",
+		},
+		"attributes": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Connector attributes keyed by their API name, for example `URL` or `CONFIG_JSON`. The keys set here are read back " +
+				"and report drift. Removing a key stops managing it and leaves its value in Saviynt unchanged.",
+		},
+		"sensitive_attributes": schema.MapAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: "Connector attributes that hold credentials, keyed by their API name (write-only). They are sent on every create " +
+				"and update, are never stored in state and are not checked for drift. Change wo_version to apply a new value.",
+		},
+	}
+}
+
+func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.ConnectionDescription,
+		Attributes:  connectionsutil.MergeResourceAttributes(BaseConnectorResourceSchema(), ConnectionSchema()),
+	}
+}
+
+func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "configure", "")
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		opCtx.LogOperationEnd(ctx, "Connection resource configuration completed - no provider data")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		errorCode := genericConnErrorCodes.ProviderConfig()
+		opCtx.LogOperationError(ctx, "Provider configuration failed", errorCode,
+			fmt.Errorf("expected *SaviyntProvider, got different type"),
+			map[string]interface{}{"expected_type": "*SaviyntProvider"})
+
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrProviderConfig),
+			fmt.Sprintf("[%s] Expected *SaviyntProvider, got different type", errorCode),
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+
+	opCtx.LogOperationEnd(ctx, "Connection resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *ConnectionResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *ConnectionResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *ConnectionResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+// ValidateConfig rejects attribute keys that are set by other arguments or set twice, and warns
+// about credentials kept in attributes, where they are stored in state.
+func (r *ConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ConnectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes := map[string]types.String{}
+	if !config.Attributes.IsNull() && !config.Attributes.IsUnknown() {
+		resp.Diagnostics.Append(config.Attributes.ElementsAs(ctx, &attributes, false)...)
+	}
+	sensitive := map[string]types.String{}
+	if !config.SensitiveAttributes.IsNull() && !config.SensitiveAttributes.IsUnknown() {
+		resp.Diagnostics.Append(config.SensitiveAttributes.ElementsAs(ctx, &sensitive, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name := range attributes {
+		if connectionBaseFields[strings.ToLower(name)] {
+			resp.Diagnostics.AddAttributeError(path.Root("attributes").AtMapKey(name), "Reserved Connection Attribute",
+				fmt.Sprintf("%q is set from the connection arguments of the resource and cannot be set in attributes.", name))
+		}
+		if _, ok := sensitive[name]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("attributes").AtMapKey(name), "Duplicate Connection Attribute",
+				fmt.Sprintf("%q is set in both attributes and sensitive_attributes.", name))
+		} else if IsConnectionSecretAttribute(name) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("attributes").AtMapKey(name), "Credential In Attributes",
+				fmt.Sprintf("%q usually holds a credential. Values in attributes are stored in state; set it in sensitive_attributes instead.", name))
+		}
+	}
+	for name := range sensitive {
+		if connectionBaseFields[strings.ToLower(name)] {
+			resp.Diagnostics.AddAttributeError(path.Root("sensitive_attributes").AtMapKey(name), "Reserved Connection Attribute",
+				fmt.Sprintf("%q is set from the connection arguments of the resource and cannot be set in sensitive_attributes.", name))
+		}
+	}
+}
+
+// ModifyPlan rejects a change of connection_type at plan time. Saviynt cannot change the connector
+// type of a connection and connections cannot be deleted, so the connection cannot be replaced either.
+func (r *ConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planType, stateType types.String
+	var connectionName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("connection_type"), &planType)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("connection_type"), &stateType)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("connection_name"), &connectionName)...)
+	if resp.Diagnostics.HasError() || planType.IsUnknown() || stateType.IsNull() {
+		return
+	}
+	if !strings.EqualFold(planType.ValueString(), stateType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("connection_type"), "Connection Type Cannot Be Changed",
+			fmt.Sprintf("Cannot change the connection type of connection '%s' from '%s' to '%s'. Create a new connection instead.",
+				connectionName.ValueString(), stateType.ValueString(), planType.ValueString()))
+	}
+}
+
+// BuildGenericConnector builds the connector sent to Saviynt from the attributes of plan and the
+// sensitive attributes of config.
+func (r *ConnectionResource) BuildGenericConnector(ctx context.Context, plan *ConnectionResourceModel, config *ConnectionResourceModel) (openapi.GenericConnector, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := map[string]string{}
+	if !plan.Attributes.IsNull() && !plan.Attributes.IsUnknown() {
+		diags.Append(plan.Attributes.ElementsAs(ctx, &attributes, false)...)
+	}
+	if !config.SensitiveAttributes.IsNull() && !config.SensitiveAttributes.IsUnknown() {
+		sensitive := map[string]string{}
+		diags.Append(config.SensitiveAttributes.ElementsAs(ctx, &sensitive, false)...)
+		for name, value := range sensitive {
+			attributes[name] = value
+		}
+	}
+	if timeoutConfig := BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig); timeoutConfig != nil {
+		encoded, err := json.Marshal(timeoutConfig)
+		if err != nil {
+			diags.AddAttributeError(path.Root("connection_timeout_config"), "Invalid Connection Timeout Config", err.Error())
+		} else {
+			attributes["connectionTimeoutConfig"] = string(encoded)
+		}
+	}
+
+	conn := openapi.GenericConnector{
+		BaseConnector: openapi.BaseConnector{
+			//required field
+			Connectiontype: plan.ConnectionType.ValueString(),
+			ConnectionName: plan.ConnectionName.ValueString(),
+			//optional field
+			ConnectionDescription: util.StringPointerOrEmpty(plan.Description),
+			DefaultSavRole:        util.StringPointerOrEmpty(plan.DefaultSavRoles),
+			EmailTemplate:         util.StringPointerOrEmpty(plan.EmailTemplate),
+		},
+		Attributes: attributes,
+	}
+
+	if plan.VaultConnection.ValueString() != "" {
+		conn.BaseConnector.VaultConnection = util.SafeStringConnector(plan.VaultConnection.ValueString())
+		conn.BaseConnector.VaultConfiguration = util.SafeStringConnector(plan.VaultConfiguration.ValueString())
+		conn.BaseConnector.Saveinvault = util.SafeStringConnector(plan.SaveInVault.ValueString())
+	}
+
+	return conn, diags
+}
+
+// ResolveAttributeSecrets replaces the ${secret.NAME} placeholders in the attributes of plan with
+// the values in secrets. plan is a copy of the plan that is only used to build the request.
+func (r *ConnectionResource) ResolveAttributeSecrets(ctx context.Context, plan *ConnectionResourceModel, secrets types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Attributes.IsNull() || plan.Attributes.IsUnknown() {
+		return diags
+	}
+
+	values := map[string]string{}
+	if !secrets.IsNull() && !secrets.IsUnknown() {
+		diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+	}
+	attributes := map[string]string{}
+	diags.Append(plan.Attributes.ElementsAs(ctx, &attributes, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for name, value := range attributes {
+		resolved, missing := resolveSecretPlaceholders(value, values)
+		for _, secret := range missing {
+			diags.AddAttributeError(path.Root("attributes").AtMapKey(name), "Unknown Secret",
+				fmt.Sprintf("attributes[%q] refers to ${secret.%s}, but secrets has no value named %q.", name, secret, secret))
+		}
+		attributes[name] = resolved
+	}
+
+	value, mapDiags := types.MapValueFrom(ctx, types.StringType, attributes)
+	diags.Append(mapDiags...)
+	if !diags.HasError() {
+		plan.Attributes = value
+	}
+	return diags
+}
+
+func (r *ConnectionResource) UpdateModelFromCreateResponse(plan *ConnectionResourceModel, apiResp *openapi.CreateOrUpdateResponse) {
+	plan.ID = types.StringValue(fmt.Sprintf("%d", *apiResp.ConnectionKey))
+	plan.ConnectionKey = types.Int64Value(int64(*apiResp.ConnectionKey))
+	plan.Description = util.SafeStringDatasource(plan.Description.ValueStringPointer())
+	plan.DefaultSavRoles = util.SortedCommaSeparated(util.SafeStringDatasource(plan.DefaultSavRoles.ValueStringPointer()))
+	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
+	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
+	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
+}
+
+// CreateOrUpdateConnection sends the connection to Saviynt for the create or update operation.
+func (r *ConnectionResource) CreateOrUpdateConnection(ctx context.Context, operation string, plan *ConnectionResourceModel, config *ConnectionResourceModel) (*openapi.CreateOrUpdateResponse, error) {
+	connectionName := plan.ConnectionName.ValueString()
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, operation, connectionName)
+	logCtx := opCtx.AddContextToLogger(ctx)
+
+	failedCode := genericConnErrorCodes.CreateFailed()
+	if operation == "update" {
+		failedCode = genericConnErrorCodes.UpdateFailed()
+	}
+
+	conn, diags := r.BuildGenericConnector(ctx, plan, config)
+	if diags.HasError() {
+		err := fmt.Errorf("unable to read the connection attributes")
+		opCtx.LogOperationError(logCtx, "Failed to build connection request", failedCode, err)
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, failedCode, operation, connectionName, err)
+	}
+
+	tflog.Debug(logCtx, "Executing connection "+operation+" operation", map[string]interface{}{
+		"connection_type": conn.Connectiontype,
+		"attribute_count": len(conn.Attributes),
+	})
+	var apiResp *openapi.CreateOrUpdateResponse
+
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, operation+"_connection", func(token string) error {
+		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := connectionOps.CreateOrUpdateGenericConnection(ctx, conn)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		return err
+	})
+	if err != nil {
+		opCtx.LogOperationError(logCtx, "Failed to "+operation+" connection", failedCode, err)
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, failedCode, operation, connectionName, err)
+	}
+
+	if apiResp != nil && apiResp.ErrorCode != nil && *apiResp.ErrorCode != "0" {
+		apiErr := fmt.Errorf("API returned error code %s: %s", *apiResp.ErrorCode, errorsutil.SanitizeMessage(apiResp.Msg))
+		errorCode := genericConnErrorCodes.APIError()
+		opCtx.LogOperationError(logCtx, "Connection "+operation+" failed with API error", errorCode, apiErr,
+			map[string]interface{}{
+				"api_error_code": *apiResp.ErrorCode,
+				"message":        errorsutil.SanitizeMessage(apiResp.Msg),
+			})
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, errorCode, operation, connectionName, apiErr)
+	}
+	if apiResp == nil || apiResp.ConnectionKey == nil {
+		apiErr := fmt.Errorf("API returned no connection key")
+		errorCode := genericConnErrorCodes.APIError()
+		opCtx.LogOperationError(logCtx, "Connection "+operation+" returned no connection key", errorCode, apiErr)
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, errorCode, operation, connectionName, apiErr)
+	}
+
+	opCtx.LogOperationEnd(logCtx, "Connection "+operation+" completed successfully",
+		map[string]interface{}{"connection_key": *apiResp.ConnectionKey})
+
+	return apiResp, nil
+}
+
+// ReadConnection reads a connection of any connector type by name. A nil response with no error
+// means that the connection does not exist.
+func (r *ConnectionResource) ReadConnection(ctx context.Context, connectionName string) (*openapi.GenericConnectionResponse, error) {
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "read", connectionName)
+	logCtx := opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(logCtx, "Starting connection read operation")
+
+	var apiResp *openapi.GenericConnectionResponse
+	var finalHttpResp *http.Response
+
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "read_connection", func(token string) error {
+		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := connectionOps.GetGenericConnectionDetails(ctx, connectionName)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+	if err != nil && finalHttpResp != nil && finalHttpResp.StatusCode == 412 {
+		opCtx.LogOperationEnd(logCtx, "Connection not found")
+		return nil, nil
+	}
+	if err != nil {
+		errorCode := genericConnErrorCodes.ReadFailed()
+		opCtx.LogOperationError(logCtx, "Failed to read connection", errorCode, err)
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, errorCode, "read", connectionName, err)
+	}
+
+	if apiResp != nil && apiResp.Errorcode != nil && *apiResp.Errorcode != 0 {
+		apiErr := fmt.Errorf("API returned error code %d: %s", *apiResp.Errorcode, errorsutil.SanitizeMessage(apiResp.Msg))
+		errorCode := genericConnErrorCodes.APIError()
+		opCtx.LogOperationError(logCtx, "Connection read failed with API error", errorCode, apiErr,
+			map[string]interface{}{
+				"api_error_code": *apiResp.Errorcode,
+				"message":        errorsutil.SanitizeMessage(apiResp.Msg),
+			})
+		return nil, errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, errorCode, "read", connectionName, apiErr)
+	}
+
+	opCtx.LogOperationEnd(logCtx, "Connection read completed successfully")
+	return apiResp, nil
+}
+
+// UpdateModelFromReadResponse maps a connection read from Saviynt to the model. Only the attribute
+// keys already in the model are refreshed, and keys missing from the response are dropped so the
+// difference shows up as drift; when the model has no attributes, as after an import, every
+// non-secret attribute that has a value is taken. Keys that hold credentials keep their value, and
+// so do the strings of an attribute that hold a ${secret.NAME} placeholder.
+func (r *ConnectionResource) UpdateModelFromReadResponse(ctx context.Context, state *ConnectionResourceModel, apiResp *openapi.GenericConnectionResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ConnectionKey = util.SafeInt64(apiResp.Connectionkey)
+	state.ID = types.StringValue(fmt.Sprintf("%d", state.ConnectionKey.ValueInt64()))
+	state.ConnectionName = util.SafeStringDatasource(apiResp.Connectionname)
+	if apiResp.Connectiontype != nil && !strings.EqualFold(*apiResp.Connectiontype, state.ConnectionType.ValueString()) {
+		state.ConnectionType = types.StringValue(*apiResp.Connectiontype)
+	}
+	state.Description = util.SafeStringDatasource(apiResp.Description)
+	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.Defaultsavroles))
+	state.EmailTemplate = util.SafeStringDatasource(apiResp.Emailtemplate)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, DecodeConnectionTimeoutConfig(apiResp.Connectionattributes["connectionTimeoutConfig"]))
+
+	current := map[string]string{}
+	byLowerName := map[string]string{}
+	for name, value := range apiResp.Connectionattributes {
+		text, ok := ConnectionAttributeString(value)
+		if !ok {
+			continue
+		}
+		current[name] = text
+		byLowerName[strings.ToLower(name)] = name
+	}
+
+	attributes := map[string]string{}
+	if state.Attributes.IsNull() || state.Attributes.IsUnknown() {
+		for name, value := range current {
+			if value != "" && !IsConnectionSecretAttribute(name) && !connectionBaseFields[strings.ToLower(name)] {
+				attributes[name] = value
+			}
+		}
+		if len(attributes) == 0 {
+			return diags
+		}
+	} else {
+		prior := map[string]string{}
+		diags.Append(state.Attributes.ElementsAs(ctx, &prior, false)...)
+		for name, value := range prior {
+			if IsConnectionSecretAttribute(name) {
+				attributes[name] = value
+				continue
+			}
+			if text, ok := current[name]; ok {
+				attributes[name] = withSecretPlaceholders(value, text)
+			} else if actual, ok := byLowerName[strings.ToLower(name)]; ok {
+				attributes[name] = withSecretPlaceholders(value, current[actual])
+			}
+		}
+	}
+
+	value, mapDiags := types.MapValueFrom(ctx, types.StringType, attributes)
+	diags.Append(mapDiags...)
+	if !diags.HasError() {
+		state.Attributes = value
+	}
+	return diags
+}
+
+func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config ConnectionResourceModel
+
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "terraform_create", "")
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection resource creation")
+
+	// Extract plan from request
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.PlanExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get plan from request", errorCode,
+			fmt.Errorf("plan extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrPlanExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform plan from request", errorCode),
+		)
+		return
+	}
+
+	connectionName := plan.ConnectionName.ValueString()
+	// Update operation context with connection name
+	opCtx.ConnectionName = connectionName
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	//Extract config from request
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.ConfigExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get config from request", errorCode,
+			fmt.Errorf("config extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrConfigExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform configuration from request for connection '%s'", errorCode, connectionName),
+		)
+		return
+	}
+
+	// Check if connection already exists (idempotency check)
+	existing, err := r.ReadConnection(ctx, connectionName)
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Creation Failed", err.Error())
+		return
+	}
+	if existing != nil && existing.Errorcode != nil && *existing.Errorcode == 0 {
+		errorCode := genericConnErrorCodes.DuplicateName()
+		opCtx.LogOperationError(ctx, "Connection name already exists. Please import or use a different name", errorCode,
+			fmt.Errorf("duplicate connection name"))
+		resp.Diagnostics.AddError(
+			"Connection Creation Failed",
+			errorsutil.CreateStandardError(errorsutil.ConnectorTypeGeneric, errorCode, "create", connectionName, nil).Error(),
+		)
+		return
+	}
+
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	resp.Diagnostics.Append(r.ResolveAttributeSecrets(ctx, &requestPlan, config.Secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.CreateOrUpdateConnection(ctx, "create", &requestPlan, &requestConfig)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Connection creation failed", "", err)
+		resp.Diagnostics.AddError(
+			"Connection Creation Failed",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update model from create response
+	r.UpdateModelFromCreateResponse(&plan, apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	opCtx.LogOperationEnd(ctx, "Connection resource created successfully",
+		map[string]interface{}{"connection_key": plan.ConnectionKey.ValueInt64()})
+}
+
+func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ConnectionResourceModel
+
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "terraform_read", "")
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection resource read")
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.StateExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get state from request", errorCode,
+			fmt.Errorf("state extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrStateExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform state from request", errorCode),
+		)
+		return
+	}
+
+	connectionName := state.ConnectionName.ValueString()
+	// Update operation context with connection name
+	opCtx.ConnectionName = connectionName
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	apiResp, err := r.ReadConnection(ctx, connectionName)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Connection read failed", "", err)
+		resp.Diagnostics.AddError(
+			"Connection Read Failed",
+			err.Error(),
+		)
+		return
+	}
+	if apiResp == nil {
+		tflog.Warn(ctx, "Connection not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model from read response
+	prior := state
+	resp.Diagnostics.Append(r.UpdateModelFromReadResponse(ctx, &state, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepSecretPlaceholders(&prior, &state)
+
+	apiMessage := util.SafeDeref(apiResp.Msg)
+	if apiMessage == "success" {
+		state.Msg = types.StringValue("Connection Read Successful")
+	} else {
+		state.Msg = types.StringValue(apiMessage)
+	}
+	state.ErrorCode = util.Int32PtrToTFString(apiResp.Errorcode)
+
+	stateDiagnostics := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(stateDiagnostics...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.StateUpdate()
+		opCtx.LogOperationError(ctx, "Failed to set state", errorCode,
+			fmt.Errorf("state update failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrStateUpdate),
+			fmt.Sprintf("[%s] Unable to update Terraform state for connection '%s'", errorCode, connectionName),
+		)
+		return
+	}
+
+	opCtx.LogOperationEnd(ctx, "Connection resource read completed successfully")
+}
+
+func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config ConnectionResourceModel
+
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "terraform_update", "")
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection resource update")
+
+	// Extract state from request
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.StateExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get state from request", errorCode,
+			fmt.Errorf("state extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrStateExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform state from request", errorCode),
+		)
+		return
+	}
+
+	// Extract plan from request
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.PlanExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get plan from request", errorCode,
+			fmt.Errorf("plan extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrPlanExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform plan from request for connection '%s'", errorCode, state.ConnectionName.ValueString()),
+		)
+		return
+	}
+
+	//Extract config from request
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.ConfigExtraction()
+		opCtx.LogOperationError(ctx, "Failed to get config from request", errorCode,
+			fmt.Errorf("config extraction failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrConfigExtraction),
+			fmt.Sprintf("[%s] Unable to extract Terraform configuration from request for connection '%s'", errorCode, plan.ConnectionName.ValueString()),
+		)
+		return
+	}
+
+	// Validate that connection name and type cannot be updated
+	if plan.ConnectionName.ValueString() != state.ConnectionName.ValueString() {
+		errorCode := genericConnErrorCodes.NameImmutable()
+		opCtx.LogOperationError(ctx, "Connection name cannot be updated", errorCode,
+			fmt.Errorf("attempted to change connection name from '%s' to '%s'", state.ConnectionName.ValueString(), plan.ConnectionName.ValueString()),
+			map[string]interface{}{
+				"old_name": state.ConnectionName.ValueString(),
+				"new_name": plan.ConnectionName.ValueString(),
+			})
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorCode),
+			fmt.Sprintf("[%s] Cannot change connection name from '%s' to '%s'", errorCode, state.ConnectionName.ValueString(), plan.ConnectionName.ValueString()),
+		)
+		return
+	}
+	if !strings.EqualFold(plan.ConnectionType.ValueString(), state.ConnectionType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("connection_type"), "Connection Type Cannot Be Changed",
+			fmt.Sprintf("Cannot change the connection type of connection '%s' from '%s' to '%s'. Create a new connection instead.",
+				state.ConnectionName.ValueString(), state.ConnectionType.ValueString(), plan.ConnectionType.ValueString()))
+		return
+	}
+
+	connectionName := plan.ConnectionName.ValueString()
+	// Update operation context with connection name
+	opCtx.ConnectionName = connectionName
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	resp.Diagnostics.Append(r.ResolveAttributeSecrets(ctx, &requestPlan, config.Secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, err := r.CreateOrUpdateConnection(ctx, "update", &requestPlan, &requestConfig)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Connection update failed", "", err)
+		resp.Diagnostics.AddError(
+			"Connection Update Failed",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read the updated connection to get the latest state
+	getResp, err := r.ReadConnection(ctx, connectionName)
+	if err == nil && getResp == nil {
+		err = fmt.Errorf("connection '%s' was not found after the update", connectionName)
+	}
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Failed to read updated connection", "", err)
+		resp.Diagnostics.AddError(
+			"Connection Post-Update Read Failed",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update model from read response
+	prior := plan
+	resp.Diagnostics.Append(r.UpdateModelFromReadResponse(ctx, &plan, getResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepSecretPlaceholders(&prior, &plan)
+
+	plan.Msg = types.StringValue(util.SafeDeref(updateResp.Msg))
+	plan.ErrorCode = types.StringValue(util.SafeDeref(updateResp.ErrorCode))
+
+	stateUpdateDiagnostics := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(stateUpdateDiagnostics...)
+	if resp.Diagnostics.HasError() {
+		errorCode := genericConnErrorCodes.StateUpdate()
+		opCtx.LogOperationError(ctx, "Failed to update state after successful update", errorCode,
+			fmt.Errorf("state update failed"))
+		resp.Diagnostics.AddError(
+			errorsutil.GetErrorMessage(errorsutil.ErrStateUpdate),
+			fmt.Sprintf("[%s] Unable to update Terraform state after successful update for connection '%s'", errorCode, connectionName),
+		)
+		return
+	}
+
+	opCtx.LogOperationEnd(ctx, "Connection resource updated successfully",
+		map[string]interface{}{"connection_key": plan.ConnectionKey.ValueInt64()})
+}
+
+func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if os.Getenv("TF_ACC") == "1" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Delete Not Supported",
+		"Resource deletion is not supported by this provider. Please remove the resource manually if required, or contact your administrator.",
+	)
+}
+
+func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing a connection requires the connection name; connection_type and the attributes
+	// with a value are read from Saviynt
+	connectionName := req.ID
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "terraform_import", connectionName)
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection resource import")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("connection_name"), req, resp)
+
+	opCtx.LogOperationEnd(ctx, "Connection resource import completed successfully",
+		map[string]interface{}{"import_id": connectionName})
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectionSecretName matches the names allowed as keys of the secrets map of a connection.
+var connectionSecretName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// secretPlaceholder matches a ${secret.NAME} placeholder and captures NAME.
+var secretPlaceholder = regexp.MustCompile(`\$\{secret\.([A-Za-z0-9_-]+)\}`)
+
+var jsonStringType = reflect.TypeOf(customtypes.JSONString{})
+
+// resolveConnectionSecrets replaces the ${secret.NAME} placeholders in the JSON attributes of the
+// connection resource models with the values in secrets. The models are pointers to copies of the
+// plan and config that are only used to build the request, so state keeps the placeholders.
+// Placeholders of secrets that are not set are reported against the attribute that holds them.
+func resolveConnectionSecrets(ctx context.Context, secrets types.Map, models ...interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values := map[string]string{}
+	if !secrets.IsNull() && !secrets.IsUnknown() {
+		diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	reported := map[string]bool{}
+	for _, model := range models {
+		for name, field := range jsonAttributeFields(model) {
+			value := field.Interface().(customtypes.JSONString)
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			resolved, missing := resolveSecretPlaceholders(value.ValueString(), values)
+			for _, secret := range missing {
+				if reported[name+"/"+secret] {
+					continue
+				}
+				reported[name+"/"+secret] = true
+				diags.AddAttributeError(path.Root(name), "Unknown Secret",
+					fmt.Sprintf("%s refers to ${secret.%s}, but secrets has no value named %q.", name, secret, secret))
+			}
+			field.Set(reflect.ValueOf(customtypes.NewJSONStringValue(resolved)))
+		}
+	}
+	return diags
+}
+
+// connectionRequestModels returns copies of the plan and config of a connection resource with the
+// ${secret.NAME} placeholders replaced by the values in secrets. The copies are only used to build
+// the create or update request, so state keeps the placeholders.
+func connectionRequestModels[T any](ctx context.Context, plan, config T, secrets types.Map) (T, T, diag.Diagnostics) {
+	requestPlan, requestConfig := plan, config
+	diags := resolveConnectionSecrets(ctx, secrets, &requestPlan, &requestConfig)
+	return requestPlan, requestConfig, diags
+}
+
+// keepSecretPlaceholders puts the ${secret.NAME} placeholders of the JSON attributes of prior back
+// into the same attributes of model, which has just been read from Saviynt with the secrets in
+// them. prior and model are pointers to the same connection resource model type.
+func keepSecretPlaceholders(prior, model interface{}) {
+	priorFields := jsonAttributeFields(prior)
+	for name, field := range jsonAttributeFields(model) {
+		before := priorFields[name].Interface().(customtypes.JSONString)
+		after := field.Interface().(customtypes.JSONString)
+		if before.IsNull() || before.IsUnknown() || after.IsNull() || after.IsUnknown() {
+			continue
+		}
+		if kept := withSecretPlaceholders(before.ValueString(), after.ValueString()); kept != after.ValueString() {
+			field.Set(reflect.ValueOf(customtypes.NewJSONStringValue(kept)))
+		}
+	}
+}
+
+// jsonAttributeFields returns the JSON attributes of the model that model points to by their
+// attribute name, including those of embedded models.
+func jsonAttributeFields(model interface{}) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	collectJSONAttributeFields(reflect.ValueOf(model).Elem(), fields)
+	return fields
+}
+
+func collectJSONAttributeFields(value reflect.Value, fields map[string]reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		switch {
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			collectJSONAttributeFields(value.Field(i), fields)
+		case field.Type == jsonStringType:
+			if name := field.Tag.Get("tfsdk"); name != "" && name != "-" {
+				fields[name] = value.Field(i)
+			}
+		}
+	}
+}
+
+// resolveSecretPlaceholders replaces the placeholders in value with the values in secrets and
+// returns the names of the secrets that are not set. In a JSON document the values are escaped
+// for a JSON string, where the placeholders are expected to be.
+func resolveSecretPlaceholders(value string, secrets map[string]string) (string, []string) {
+	escape := json.Valid([]byte(value))
+	var missing []string
+	resolved := secretPlaceholder.ReplaceAllStringFunc(value, func(placeholder string) string {
+		name := secretPlaceholder.FindStringSubmatch(placeholder)[1]
+		secret, ok := secrets[name]
+		if !ok {
+			if !containsSecretName(missing, name) {
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		if escape {
+			return escapeJSONStringContent(secret)
+		}
+		return secret
+	})
+	return resolved, missing
+}
+
+func containsSecretName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeJSONStringContent returns s encoded as the content of a JSON string, without the quotes.
+func escapeJSONStringContent(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	encoded := strings.TrimSuffix(buf.String(), "\n")
+	return encoded[1 : len(encoded)-1]
+}
+
+// withSecretPlaceholders returns the value read from Saviynt as it should be kept in state, given
+// the template the configuration sent. Strings of the template that hold a placeholder come back
+// with the secret in them, so they are taken from the template and never checked for drift. The
+// template itself is returned when the rest of the document is unchanged; otherwise the read
+// value is returned with those strings replaced. When either value is not valid JSON the template
+// is returned, as the secrets cannot be told apart from the rest of the value.
+func withSecretPlaceholders(template, actual string) string {
+	if !secretPlaceholder.MatchString(template) {
+		return actual
+	}
+
+	var templateDoc, actualDoc interface{}
+	if decodeSecretTemplateJSON(template, &templateDoc) != nil || decodeSecretTemplateJSON(actual, &actualDoc) != nil {
+		return template
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(maskSecretStrings(templateDoc, actualDoc)); err != nil {
+		return template
+	}
+	masked := strings.TrimSuffix(buf.String(), "\n")
+	if customtypes.JSONSemanticEqual(template, masked) {
+		return template
+	}
+	return masked
+}
+
+func decodeSecretTemplateJSON(s string, target *interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after the JSON document")
+	}
+	return nil
+}
+
+// maskSecretStrings replaces the strings of actual that hold a placeholder at the same place in
+// template with the string of template.
+func maskSecretStrings(template, actual interface{}) interface{} {
+	switch t := template.(type) {
+	case string:
+		if _, ok := actual.(string); ok && secretPlaceholder.MatchString(t) {
+			return t
+		}
+	case map[string]interface{}:
+		if a, ok := actual.(map[string]interface{}); ok {
+			for key, value := range a {
+				if templateValue, ok := t[key]; ok {
+					a[key] = maskSecretStrings(templateValue, value)
+				}
+			}
+		}
+	case []interface{}:
+		if a, ok := actual.([]interface{}); ok {
+			for i := 0; i < len(a) && i < len(t); i++ {
+				a[i] = maskSecretStrings(t[i], a[i])
+			}
+		}
+	}
+	return actual
+}
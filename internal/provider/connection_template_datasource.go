@@ -0,0 +1,509 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_connection_template reads an existing connection of the Saviynt Security Manager and
+// returns its non-secret attributes as a map keyed by the arguments of the matching connection
+// resource, so that near-identical connections can be stamped out from a golden connection.
+// The data source supports a single Read operation; overrides replace or remove selected keys.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/errorsutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/connections"
+)
+
+var _ datasource.DataSource = &ConnectionTemplateDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectionTemplateDataSource{}
+
+// ConnectionTemplateDataSource implements the datasource.DataSource interface for connection templates
+type ConnectionTemplateDataSource struct {
+	client            client.SaviyntClientInterface
+	token             string
+	provider          client.SaviyntProviderInterface
+	connectionFactory client.ConnectionFactoryInterface
+}
+
+type ConnectionTemplateDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ConnectionName types.String `tfsdk:"connection_name"`
+	Overrides      types.Map    `tfsdk:"overrides"`
+	ConnectionType types.String `tfsdk:"connection_type"`
+	ResourceType   types.String `tfsdk:"resource_type"`
+	Attributes     types.Map    `tfsdk:"attributes"`
+}
+
+func NewConnectionTemplateDataSource() datasource.DataSource {
+	return &ConnectionTemplateDataSource{
+		connectionFactory: &client.DefaultConnectionFactory{},
+	}
+}
+
+// NewConnectionTemplateDataSourceWithFactory creates a new connection template data source with custom factory
+// Used primarily for testing with mock factories
+func NewConnectionTemplateDataSourceWithFactory(factory client.ConnectionFactoryInterface) datasource.DataSource {
+	return &ConnectionTemplateDataSource{
+		connectionFactory: factory,
+	}
+}
+
+// SetClient sets the client for testing purposes
+func (d *ConnectionTemplateDataSource) SetClient(client client.SaviyntClientInterface) {
+	d.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (d *ConnectionTemplateDataSource) SetToken(token string) {
+	d.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (d *ConnectionTemplateDataSource) SetProvider(provider client.SaviyntProviderInterface) {
+	d.provider = provider
+}
+
+func (d *ConnectionTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_template"
+}
+
+func (d *ConnectionTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.ConnectionTemplateDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the data source.",
+			},
+			"connection_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the connection to use as the template.",
+			},
+			"overrides": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Values that replace the attributes of the same key. A null value removes the key. Keys must be " +
+					"arguments of resource_type that do not hold credentials.",
+			},
+			"connection_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Connector type of the connection.",
+			},
+			"resource_type": schema.StringAttribute{
+				Computed: true,
+				Description: "Connection resource that attributes is meant for, for example `saviynt_rest_connection_resource`. " +
+					"Connector types without a typed resource return `saviynt_connection`.",
+			},
+			"attributes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Non-secret attributes of the connection that have a value, with the overrides applied. Keys are the " +
+					"arguments of resource_type; for `saviynt_connection` they are the API names used in its attributes. Attributes " +
+					"that hold credentials and connection_name are left out, and credentials inside JSON values such as config_json " +
+					"and pam_config are masked; set them again before the JSON is used.",
+			},
+		},
+	}
+}
+
+func (d *ConnectionTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting connection template datasource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	d.client = &client.SaviyntClientWrapper{Client: prov.client}
+	d.token = prov.accessToken
+	d.provider = &client.SaviyntProviderWrapper{Provider: prov}
+	tflog.Debug(ctx, "Connection template datasource configured successfully")
+}
+
+func (d *ConnectionTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConnectionTemplateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionName := state.ConnectionName.ValueString()
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "template", connectionName)
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection template datasource read")
+
+	details := &ConnectionsDataSource{client: d.client, provider: d.provider, connectionFactory: d.connectionFactory}
+	apiResp, raw, err := details.ReadConnectionDetails(ctx, connectionName)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Failed to read connection", genericConnErrorCodes.ReadFailed(), err)
+		resp.Diagnostics.AddError(
+			"Connection Template Read Failed",
+			fmt.Sprintf("Failed to read connection %s: %s", connectionName, err.Error()),
+		)
+		return
+	}
+
+	template, err := BuildConnectionTemplate(ctx, apiResp, raw)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Failed to map connection", genericConnErrorCodes.APIError(), err)
+		resp.Diagnostics.AddError(
+			"Connection Template Read Failed",
+			fmt.Sprintf("Failed to map connection %s: %s", connectionName, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(template.ApplyOverrides(ctx, state.Overrides)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := types.MapValueFrom(ctx, types.StringType, template.Attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("connection-template-" + connectionName)
+	state.ConnectionType = types.StringValue(template.ConnectionType)
+	state.ResourceType = types.StringValue(template.ResourceType)
+	state.Attributes = attributes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	opCtx.LogOperationEnd(ctx, "Connection template datasource read completed successfully", map[string]interface{}{
+		"resource_type":   template.ResourceType,
+		"attribute_count": len(template.Attributes),
+	})
+}
+
+// ConnectionTemplate holds the non-secret attributes of a connection keyed by the arguments of the
+// connection resource that can create a copy of it.
+type ConnectionTemplate struct {
+	ConnectionType string
+	ResourceType   string
+	Attributes     map[string]string
+	// arguments holds the arguments of ResourceType that overrides may set; nil allows any key
+	// that does not hold a credential.
+	arguments map[string]bool
+}
+
+// BuildConnectionTemplate maps the details of a connection to the arguments of the typed connection
+// resource of its connector type, through the same mapping the resource reads its state with.
+// Connections of a connector type without a typed resource are mapped by their API names for
+// saviynt_connection.
+func BuildConnectionTemplate(ctx context.Context, apiResp *openapi.GetConnectionDetailsResponse, raw []byte) (*ConnectionTemplate, error) {
+	if apiResp == nil {
+		typed, err := decodeTypedConnectionResponse(raw)
+		if err != nil {
+			return nil, err
+		}
+		if typed == nil {
+			return buildGenericConnectionTemplate(raw)
+		}
+		apiResp = typed
+	}
+
+	var res resource.Resource
+	var model interface{}
+	var connectionType *string
+	switch {
+	case apiResp.ADConnectionResponse != nil:
+		var state ADConnectorResourceModel
+		(&AdConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &AdConnectionResource{}, &state, apiResp.ADConnectionResponse.Connectiontype
+	case apiResp.ADSIConnectionResponse != nil:
+		var state ADSIConnectorResourceModel
+		(&AdsiConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &AdsiConnectionResource{}, &state, apiResp.ADSIConnectionResponse.Connectiontype
+	case apiResp.DBConnectionResponse != nil:
+		var state DBConnectorResourceModel
+		(&DBConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &DBConnectionResource{}, &state, apiResp.DBConnectionResponse.Connectiontype
+	case apiResp.EntraIDConnectionResponse != nil:
+		var state EntraIdConnectorResourceModel
+		(&EntraIdConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &EntraIdConnectionResource{}, &state, apiResp.EntraIDConnectionResponse.Connectiontype
+	case apiResp.GithubRESTConnectionResponse != nil:
+		var state GithubRestConnectorResourceModel
+		(&GithubRestConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &GithubRestConnectionResource{}, &state, apiResp.GithubRESTConnectionResponse.Connectiontype
+	case apiResp.OktaConnectionResponse != nil:
+		var state OktaConnectorResourceModel
+		(&OktaConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &OktaConnectionResource{}, &state, apiResp.OktaConnectionResponse.Connectiontype
+	case apiResp.RESTConnectionResponse != nil:
+		var state RestConnectorResourceModel
+		(&RestConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &RestConnectionResource{}, &state, apiResp.RESTConnectionResponse.Connectiontype
+	case apiResp.SalesforceConnectionResponse != nil:
+		var state SalesforceConnectorResourceModel
+		(&SalesforceConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &SalesforceConnectionResource{}, &state, apiResp.SalesforceConnectionResponse.Connectiontype
+	case apiResp.SAPConnectionResponse != nil:
+		var state SapConnectorResourceModel
+		(&SapConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &SapConnectionResource{}, &state, apiResp.SAPConnectionResponse.Connectiontype
+	case apiResp.SFTPConnectionResponse != nil:
+		var state SFTPConnectorResourceModel
+		(&SftpConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &SftpConnectionResource{}, &state, apiResp.SFTPConnectionResponse.Connectiontype
+	case apiResp.UNIXConnectionResponse != nil:
+		var state UnixConnectorResourceModel
+		(&UnixConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &UnixConnectionResource{}, &state, apiResp.UNIXConnectionResponse.Connectiontype
+	case apiResp.WorkdayConnectionResponse != nil:
+		var state WorkdayConnectorResourceModel
+		(&WorkdayConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &WorkdayConnectionResource{}, &state, apiResp.WorkdayConnectionResponse.Connectiontype
+	case apiResp.WorkdaySOAPConnectionResponse != nil:
+		var state WorkdaySOAPConnectorResourceModel
+		(&WorkdaySOAPConnectionResource{}).UpdateModelFromReadResponse(&state, apiResp)
+		res, model, connectionType = &WorkdaySOAPConnectionResource{}, &state, apiResp.WorkdaySOAPConnectionResponse.Connectiontype
+	default:
+		return nil, fmt.Errorf("the connection response holds no connection")
+	}
+
+	var metadata resource.MetadataResponse
+	res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "saviynt"}, &metadata)
+	var resourceSchema resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	template := &ConnectionTemplate{
+		ConnectionType: util.SafeDeref(connectionType),
+		ResourceType:   metadata.TypeName,
+		Attributes:     map[string]string{},
+		arguments:      map[string]bool{},
+	}
+	values := connectionModelValues(model)
+	for name, attribute := range resourceSchema.Schema.Attributes {
+		if !attribute.IsRequired() && !attribute.IsOptional() || attribute.IsSensitive() || attribute.IsWriteOnly() || name == "connection_name" {
+			continue
+		}
+		template.arguments[name] = true
+		if text, ok := connectionTemplateValue(values[name]); ok {
+			template.Attributes[name] = maskConnectionJSONSecrets(text)
+		}
+	}
+	return template, nil
+}
+
+// decodeTypedConnectionResponse decodes a getConnectionDetails response body that matched none or
+// several of the typed responses into the typed response of its connector type, so that a golden
+// connection of a typed connector type still maps to its typed resource. It returns nil when the
+// connector type has no typed resource.
+func decodeTypedConnectionResponse(raw []byte) (*openapi.GetConnectionDetailsResponse, error) {
+	var header struct {
+		Connectionkey        json.RawMessage `json:"connectionkey"`
+		Connectiontype       string          `json:"connectiontype"`
+		Connectionattributes json.RawMessage `json:"connectionattributes"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode connection details: %w", err)
+	}
+	// The typed resources read the connection key and attributes without checking for them.
+	if len(header.Connectionkey) == 0 || len(header.Connectionattributes) == 0 ||
+		string(header.Connectionkey) == "null" || string(header.Connectionattributes) == "null" {
+		return nil, nil
+	}
+
+	apiResp := &openapi.GetConnectionDetailsResponse{}
+	var target interface{}
+	switch strings.ToLower(header.Connectiontype) {
+	case "ad":
+		apiResp.ADConnectionResponse = &openapi.ADConnectionResponse{}
+		target = apiResp.ADConnectionResponse
+	case "adsi":
+		apiResp.ADSIConnectionResponse = &openapi.ADSIConnectionResponse{}
+		target = apiResp.ADSIConnectionResponse
+	case "db":
+		apiResp.DBConnectionResponse = &openapi.DBConnectionResponse{}
+		target = apiResp.DBConnectionResponse
+	case "azuread":
+		apiResp.EntraIDConnectionResponse = &openapi.EntraIDConnectionResponse{}
+		target = apiResp.EntraIDConnectionResponse
+	case "githubrest":
+		apiResp.GithubRESTConnectionResponse = &openapi.GithubRESTConnectionResponse{}
+		target = apiResp.GithubRESTConnectionResponse
+	case "okta":
+		apiResp.OktaConnectionResponse = &openapi.OktaConnectionResponse{}
+		target = apiResp.OktaConnectionResponse
+	case "rest":
+		apiResp.RESTConnectionResponse = &openapi.RESTConnectionResponse{}
+		target = apiResp.RESTConnectionResponse
+	case "salesforce":
+		apiResp.SalesforceConnectionResponse = &openapi.SalesforceConnectionResponse{}
+		target = apiResp.SalesforceConnectionResponse
+	case "sap":
+		apiResp.SAPConnectionResponse = &openapi.SAPConnectionResponse{}
+		target = apiResp.SAPConnectionResponse
+	case "sftpfiletransfer":
+		apiResp.SFTPConnectionResponse = &openapi.SFTPConnectionResponse{}
+		target = apiResp.SFTPConnectionResponse
+	case "unix":
+		apiResp.UNIXConnectionResponse = &openapi.UNIXConnectionResponse{}
+		target = apiResp.UNIXConnectionResponse
+	case "workday":
+		apiResp.WorkdayConnectionResponse = &openapi.WorkdayConnectionResponse{}
+		target = apiResp.WorkdayConnectionResponse
+	case "workday-soap":
+		apiResp.WorkdaySOAPConnectionResponse = &openapi.WorkdaySOAPConnectionResponse{}
+		target = apiResp.WorkdaySOAPConnectionResponse
+	default:
+		return nil, nil
+	}
+	// A connection whose attributes do not fit the typed response is mapped by its API names.
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, nil
+	}
+	return apiResp, nil
+}
+
+// buildGenericConnectionTemplate maps a getConnectionDetails response body that did not decode into
+// a typed response to the attributes of saviynt_connection.
+func buildGenericConnectionTemplate(raw []byte) (*ConnectionTemplate, error) {
+	var generic struct {
+		ErrorCode            json.RawMessage        `json:"errorcode"`
+		Msg                  string                 `json:"msg"`
+		Connectiontype       string                 `json:"connectiontype"`
+		Connectionattributes map[string]interface{} `json:"connectionattributes"`
+	}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("failed to decode connection details: %w", err)
+	}
+	if code := strings.Trim(string(generic.ErrorCode), `"`); code != "" && code != "0" {
+		return nil, fmt.Errorf("error code %s: %s", code, generic.Msg)
+	}
+
+	template := &ConnectionTemplate{
+		ConnectionType: generic.Connectiontype,
+		ResourceType:   "saviynt_connection",
+		Attributes:     map[string]string{},
+	}
+	for name, value := range generic.Connectionattributes {
+		text, ok := ConnectionAttributeString(value)
+		if !ok || text == "" || IsConnectionSecretAttribute(name) || connectionBaseFields[strings.ToLower(name)] {
+			continue
+		}
+		template.Attributes[name] = maskConnectionJSONSecrets(text)
+	}
+	return template, nil
+}
+
+// ApplyOverrides sets the overrides on the attributes of the template. Keys that are not arguments
+// of the resource type, or that hold credentials, are reported against the override.
+func (t *ConnectionTemplate) ApplyOverrides(ctx context.Context, overrides types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if overrides.IsNull() || overrides.IsUnknown() {
+		return diags
+	}
+
+	values := map[string]types.String{}
+	diags.Append(overrides.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch {
+		case t.arguments != nil && !t.arguments[name]:
+			diags.AddAttributeError(path.Root("overrides").AtMapKey(name), "Unknown Override",
+				fmt.Sprintf("%q is not an argument of %s that can be set from a template.", name, t.ResourceType))
+			continue
+		case t.arguments == nil && connectionBaseFields[strings.ToLower(name)]:
+			diags.AddAttributeError(path.Root("overrides").AtMapKey(name), "Reserved Connection Attribute",
+				fmt.Sprintf("%q is set from the connection arguments of %s and cannot be overridden.", name, t.ResourceType))
+			continue
+		case t.arguments == nil && IsConnectionSecretAttribute(name):
+			diags.AddAttributeError(path.Root("overrides").AtMapKey(name), "Credential In Overrides",
+				fmt.Sprintf("%q usually holds a credential. Set it in sensitive_attributes of %s instead.", name, t.ResourceType))
+			continue
+		}
+		if value := values[name]; value.IsNull() || value.IsUnknown() {
+			delete(t.Attributes, name)
+		} else {
+			t.Attributes[name] = value.ValueString()
+		}
+	}
+	return diags
+}
+
+// connectionModelValues returns the values of the connection resource model that model points to
+// by their attribute name, including those of embedded models.
+func connectionModelValues(model interface{}) map[string]attr.Value {
+	values := map[string]attr.Value{}
+	collectConnectionModelValues(reflect.ValueOf(model).Elem(), values)
+	return values
+}
+
+func collectConnectionModelValues(value reflect.Value, values map[string]attr.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectConnectionModelValues(value.Field(i), values)
+			continue
+		}
+		name := field.Tag.Get("tfsdk")
+		if name == "" || name == "-" {
+			continue
+		}
+		if v, ok := value.Field(i).Interface().(attr.Value); ok {
+			values[name] = v
+		}
+	}
+}
+
+// connectionTemplateValue returns a value of a connection resource model as a string, or false
+// when it is not set or is not a single value.
+func connectionTemplateValue(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	switch v := value.(type) {
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10), true
+	case types.Bool:
+		return strconv.FormatBool(v.ValueBool()), true
+	case interface{ ValueString() string }:
+		text := v.ValueString()
+		return text, text != ""
+	}
+	return "", false
+}
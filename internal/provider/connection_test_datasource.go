@@ -0,0 +1,347 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_connection_test runs the Test Connection check of the Saviynt Security Manager for a saved
+// connection of any connector type. The data source supports a single Read operation and reports whether
+// Saviynt could reach the target system, how long the check took and the message Saviynt returned, so
+// that bad credentials fail the plan through a precondition instead of a failed import job.
+//
+// Saviynt returns the saved credentials of a connection masked. The test runs with the attributes sent
+// in the request, and it is not documented that Saviynt falls back to the saved credentials, so the
+// credentials must be set in sensitive_attributes.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/errorsutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/connections"
+)
+
+var _ datasource.DataSource = &ConnectionTestDataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectionTestDataSource{}
+
+// ConnectionTestDataSource implements the datasource.DataSource interface for the connection test
+type ConnectionTestDataSource struct {
+	client            client.SaviyntClientInterface
+	token             string
+	provider          client.SaviyntProviderInterface
+	connectionFactory client.ConnectionFactoryInterface
+}
+
+type ConnectionTestDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ConnectionName      types.String `tfsdk:"connection_name"`
+	SensitiveAttributes types.Map    `tfsdk:"sensitive_attributes"`
+	ConnectionType      types.String `tfsdk:"connection_type"`
+	Success             types.Bool   `tfsdk:"success"`
+	LatencyMs           types.Int64  `tfsdk:"latency_ms"`
+	Message             types.String `tfsdk:"message"`
+	ErrorCode           types.String `tfsdk:"error_code"`
+}
+
+func NewConnectionTestDataSource() datasource.DataSource {
+	return &ConnectionTestDataSource{
+		connectionFactory: &client.DefaultConnectionFactory{},
+	}
+}
+
+// NewConnectionTestDataSourceWithFactory creates a new connection test data source with custom factory
+// Used primarily for testing with mock factories
+func NewConnectionTestDataSourceWithFactory(factory client.ConnectionFactoryInterface) datasource.DataSource {
+	return &ConnectionTestDataSource{
+		connectionFactory: factory,
+	}
+}
+
+// SetClient sets the client for testing purposes
+func (d *ConnectionTestDataSource) SetClient(client client.SaviyntClientInterface) {
+	d.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (d *ConnectionTestDataSource) SetToken(token string) {
+	d.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (d *ConnectionTestDataSource) SetProvider(provider client.SaviyntProviderInterface) {
+	d.provider = provider
+}
+
+func (d *ConnectionTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_test"
+}
+
+func (d *ConnectionTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.ConnectionTestDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the data source.",
+			},
+			"connection_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the connection to test. The connection must already be saved in Saviynt.",
+			},
+			"sensitive_attributes": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Credentials sent with the test, keyed by their API attribute name, for example `PASSWORD`. Saviynt returns saved credentials masked, so every credential saved on the connection must be set here, or the read fails and lists the missing ones. Values are redacted from output but stored in state like every data source argument.",
+			},
+			"connection_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Connector type of the connection.",
+			},
+			"success": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Saviynt reached the target system with the saved connection settings and the credentials in sensitive_attributes.",
+			},
+			"latency_ms": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Time the Test Connection call took, in milliseconds.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Message returned by Saviynt for the test, which explains a failure.",
+			},
+			"error_code": schema.StringAttribute{
+				Computed:    true,
+				Description: "Error code returned by Saviynt, where '0' signifies success.",
+			},
+		},
+	}
+}
+
+func (d *ConnectionTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting connection test datasource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	d.client = &client.SaviyntClientWrapper{Client: prov.client}
+	d.token = prov.accessToken
+	d.provider = &client.SaviyntProviderWrapper{Provider: prov}
+	tflog.Debug(ctx, "Connection test datasource configured successfully")
+}
+
+func (d *ConnectionTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConnectionTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionName := state.ConnectionName.ValueString()
+	opCtx := errorsutil.CreateOperationContext(errorsutil.ConnectorTypeGeneric, "test", connectionName)
+	ctx = opCtx.AddContextToLogger(ctx)
+
+	opCtx.LogOperationStart(ctx, "Starting connection test datasource read")
+
+	connection, err := d.readConnection(ctx, connectionName)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Failed to read connection", genericConnErrorCodes.ReadFailed(), err)
+		resp.Diagnostics.AddError(
+			"Connection Test Failed",
+			fmt.Sprintf("Failed to read connection %s: %s", connectionName, err.Error()),
+		)
+		return
+	}
+	if connection == nil {
+		resp.Diagnostics.AddError(
+			"No Data Found",
+			fmt.Sprintf("Connection %s was not found.", connectionName),
+		)
+		return
+	}
+
+	credentials := map[string]string{}
+	if !state.SensitiveAttributes.IsNull() && !state.SensitiveAttributes.IsUnknown() {
+		resp.Diagnostics.Append(state.SensitiveAttributes.ElementsAs(ctx, &credentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	connectionType := util.SafeDeref(connection.Connectiontype)
+	connector, missing := BuildConnectionTestConnector(connectionName, connectionType, connection.Connectionattributes, credentials)
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sensitive_attributes"),
+			"Connection Credentials Required",
+			fmt.Sprintf("Connection %s of type %s has saved credentials that Saviynt returns masked: %s. Test Connection runs with the "+
+				"attributes in the request, and it is not documented that Saviynt falls back to the saved credentials, so set them in "+
+				"sensitive_attributes.", connectionName, connectionType, strings.Join(missing, ", ")),
+		)
+		return
+	}
+	result, latency, err := d.TestConnection(ctx, connector)
+	if err != nil {
+		opCtx.LogOperationError(ctx, "Failed to run the connection test", genericConnErrorCodes.APIError(), err)
+		resp.Diagnostics.AddError(
+			"Connection Test Failed",
+			fmt.Sprintf("Failed to run the connection test for %s: %s", connectionName, err.Error()),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("connection-test-" + connectionName)
+	state.ConnectionType = util.SafeStringDatasource(connection.Connectiontype)
+	state.Success = types.BoolValue(result.ErrorCode != nil && *result.ErrorCode == "0")
+	state.LatencyMs = types.Int64Value(latency.Milliseconds())
+	state.Message = util.SafeStringDatasource(result.Msg)
+	state.ErrorCode = util.SafeStringDatasource(result.ErrorCode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	opCtx.LogOperationEnd(ctx, "Connection test datasource read completed successfully", map[string]interface{}{
+		"success":    state.Success.ValueBool(),
+		"latency_ms": state.LatencyMs.ValueInt64(),
+		"message":    errorsutil.SanitizeMessage(result.Msg),
+	})
+}
+
+// readConnection reads the saved connection by name. A nil response with no error means that the
+// connection does not exist.
+func (d *ConnectionTestDataSource) readConnection(ctx context.Context, connectionName string) (*openapi.GenericConnectionResponse, error) {
+	var apiResp *openapi.GenericConnectionResponse
+	var finalHttpResp *http.Response
+
+	err := d.provider.AuthenticatedAPICallWithRetry(ctx, "read_connection", func(token string) error {
+		connectionOps := d.connectionFactory.CreateConnectionOperations(d.client.APIBaseURL(), token)
+		resp, httpResp, err := connectionOps.GetGenericConnectionDetails(ctx, connectionName)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+	if err != nil && finalHttpResp != nil && finalHttpResp.StatusCode == 412 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if apiResp != nil && apiResp.Errorcode != nil && *apiResp.Errorcode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", *apiResp.Errorcode, errorsutil.SanitizeMessage(apiResp.Msg))
+	}
+	return apiResp, nil
+}
+
+// TestConnection runs the Test Connection check for connector and returns the response of Saviynt
+// with the time the call took. A check that fails is returned as a response with a non-zero error
+// code, also when Saviynt reports it with an HTTP error status.
+func (d *ConnectionTestDataSource) TestConnection(ctx context.Context, connector openapi.GenericConnector) (*openapi.CreateOrUpdateResponse, time.Duration, error) {
+	var apiResp *openapi.CreateOrUpdateResponse
+	var latency time.Duration
+
+	err := d.provider.AuthenticatedAPICallWithRetry(ctx, "test_connection", func(token string) error {
+		connectionOps := d.connectionFactory.CreateConnectionOperations(d.client.APIBaseURL(), token)
+		start := time.Now()
+		resp, httpResp, err := connectionOps.TestGenericConnection(ctx, connector)
+		latency = time.Since(start)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		if err != nil && httpResp != nil {
+			if failed := connectionTestFailure(err, httpResp); failed != nil {
+				apiResp = failed
+				return nil
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, latency, err
+	}
+	if apiResp == nil {
+		return nil, latency, fmt.Errorf("API returned an empty response")
+	}
+	return apiResp, latency, nil
+}
+
+// connectionTestFailure returns the response in the body of a Test Connection call that Saviynt
+// answered with an HTTP error status, or nil when the body holds no test result.
+func connectionTestFailure(err error, httpResp *http.Response) *openapi.CreateOrUpdateResponse {
+	var apiErr *openapi.GenericOpenAPIError
+	if !errors.As(err, &apiErr) || len(apiErr.Body()) == 0 {
+		return nil
+	}
+	var failed openapi.CreateOrUpdateResponse
+	if json.Unmarshal(apiErr.Body(), &failed) != nil || (failed.Msg == nil && failed.ErrorCode == nil) {
+		return nil
+	}
+	if failed.ErrorCode == nil || *failed.ErrorCode == "0" {
+		code := fmt.Sprintf("%d", httpResp.StatusCode)
+		failed.ErrorCode = &code
+	}
+	return &failed
+}
+
+// BuildConnectionTestConnector builds the connector sent to Test Connection from the saved
+// attributes of the connection and the credentials, which are matched to the saved attributes
+// ignoring case. Saved credentials are read back masked and are replaced by the credentials; the
+// names of the saved credentials that have no value in credentials are returned as missing.
+func BuildConnectionTestConnector(connectionName, connectionType string, attributes map[string]interface{}, credentials map[string]string) (connector openapi.GenericConnector, missing []string) {
+	credentialNames := mapKeys(credentials)
+	values := map[string]string{}
+	for name, value := range attributes {
+		text, ok := ConnectionAttributeString(value)
+		if !ok || text == "" || connectionBaseFields[strings.ToLower(name)] || containsFold(credentialNames, name) {
+			continue
+		}
+		if IsConnectionSecretAttribute(name) {
+			missing = append(missing, name)
+			continue
+		}
+		values[name] = text
+	}
+	for name, value := range credentials {
+		values[name] = value
+	}
+	sort.Strings(missing)
+	return *openapi.NewGenericConnector(connectionName, connectionType, values), missing
+}
+
+// mapKeys returns the keys of values.
+func mapKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -4,8 +4,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"terraform-provider-Saviynt/util"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi "github.com/saviynt/saviynt-api-go-client/connections"
 )
 
 type ConnectionTimeoutConfig struct {
@@ -29,3 +38,151 @@ func ConnectionTimeoutConfigeSchema() map[string]schema.Attribute {
 		"retry_failure_status_code":   schema.Int64Attribute{Computed: true},
 	}
 }
+
+// BuildConnectionTimeoutConfig returns the timeout settings of a connection resource to send to
+// Saviynt, or nil when the resource does not set them.
+func BuildConnectionTimeoutConfig(config *ConnectionTimeoutConfig) *openapi.ConnectionTimeoutConfig {
+	if config == nil {
+		return nil
+	}
+	return &openapi.ConnectionTimeoutConfig{
+		RetryWait:               connectionTimeoutSettingValue(config.RetryWait),
+		TokenRefreshMaxTryCount: connectionTimeoutSettingValue(config.TokenRefreshMaxTryCount),
+		RetryWaitMaxValue:       connectionTimeoutSettingValue(config.RetryWaitMaxValue),
+		RetryCount:              connectionTimeoutSettingValue(config.RetryCount),
+		ReadTimeout:             connectionTimeoutSettingValue(config.ReadTimeout),
+		ConnectionTimeout:       connectionTimeoutSettingValue(config.ConnectionTimeout),
+		RetryFailureStatusCode:  connectionTimeoutSettingValue(config.RetryFailureStatusCode),
+	}
+}
+
+func connectionTimeoutSettingValue(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := int32(value.ValueInt64())
+	return &v
+}
+
+// ReadConnectionTimeoutConfig returns the timeout settings read from Saviynt as they should be kept
+// in the state of a connection resource. Only the settings that prior sets are refreshed, so that
+// the defaults of Saviynt do not show up as drift; settings missing from actual become null.
+func ReadConnectionTimeoutConfig(prior *ConnectionTimeoutConfig, actual *openapi.ConnectionTimeoutConfig) *ConnectionTimeoutConfig {
+	if prior == nil {
+		return nil
+	}
+	if actual == nil {
+		actual = &openapi.ConnectionTimeoutConfig{}
+	}
+	return &ConnectionTimeoutConfig{
+		RetryWait:               readConnectionTimeoutSetting(prior.RetryWait, actual.RetryWait),
+		TokenRefreshMaxTryCount: readConnectionTimeoutSetting(prior.TokenRefreshMaxTryCount, actual.TokenRefreshMaxTryCount),
+		RetryWaitMaxValue:       readConnectionTimeoutSetting(prior.RetryWaitMaxValue, actual.RetryWaitMaxValue),
+		RetryCount:              readConnectionTimeoutSetting(prior.RetryCount, actual.RetryCount),
+		ReadTimeout:             readConnectionTimeoutSetting(prior.ReadTimeout, actual.ReadTimeout),
+		ConnectionTimeout:       readConnectionTimeoutSetting(prior.ConnectionTimeout, actual.ConnectionTimeout),
+		RetryFailureStatusCode:  readConnectionTimeoutSetting(prior.RetryFailureStatusCode, actual.RetryFailureStatusCode),
+	}
+}
+
+func readConnectionTimeoutSetting(prior types.Int64, actual *int32) types.Int64 {
+	if prior.IsNull() {
+		return prior
+	}
+	return util.SafeInt64(actual)
+}
+
+// DecodeConnectionTimeoutConfig decodes the connectionTimeoutConfig attribute of a connection read
+// without a typed model, which holds either an object or a JSON string.
+func DecodeConnectionTimeoutConfig(value interface{}) *openapi.ConnectionTimeoutConfig {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		data = []byte(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		data = encoded
+	}
+	var config openapi.ConnectionTimeoutConfig
+	if json.Unmarshal(data, &config) != nil {
+		return nil
+	}
+	return &config
+}
+
+// ConfigJSONTimeoutConflict returns a validator for config_json that rejects a connectionTimeoutConfig
+// key when connection_timeout_config is set as well, as both set the timeout settings of the connection.
+func ConfigJSONTimeoutConflict() validator.String {
+	return configJSONTimeoutConflict{}
+}
+
+// CustomConfigJSONTimeoutConflict returns a validator for the custom_config_json of a Unix connection,
+// which holds the timeout settings as top level keys, that rejects those keys when
+// connection_timeout_config is set as well.
+func CustomConfigJSONTimeoutConflict() validator.String {
+	return configJSONTimeoutConflict{topLevel: true}
+}
+
+// connectionTimeoutConfigKeys are the keys of a connectionTimeoutConfig object
+var connectionTimeoutConfigKeys = []string{
+	"connectionTimeout", "readTimeout", "writeTimeout", "retryWait", "retryWaitMaxValue", "retryCount",
+	"tokenRefreshMaxTryCount", "retryFailureStatusCode",
+}
+
+// configJSONTimeoutConflict looks for a connectionTimeoutConfig key, or for the keys of
+// connectionTimeoutConfig at the top level of the document when topLevel is set.
+type configJSONTimeoutConflict struct {
+	topLevel bool
+}
+
+func (v configJSONTimeoutConflict) Description(_ context.Context) string {
+	if v.topLevel {
+		return "Value must not set timeout settings when connection_timeout_config is set."
+	}
+	return "Value must not set connectionTimeoutConfig when connection_timeout_config is set."
+}
+
+func (v configJSONTimeoutConflict) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v configJSONTimeoutConflict) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	// Invalid JSON is reported by the JSON schema validator
+	var document map[string]json.RawMessage
+	if json.Unmarshal([]byte(req.ConfigValue.ValueString()), &document) != nil {
+		return
+	}
+	var keys []string
+	if v.topLevel {
+		for _, key := range connectionTimeoutConfigKeys {
+			if _, ok := document[key]; ok {
+				keys = append(keys, key)
+			}
+		}
+	} else if _, ok := document["connectionTimeoutConfig"]; ok {
+		keys = []string{"connectionTimeoutConfig"}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	var timeoutConfig types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_timeout_config"), &timeoutConfig)...)
+	if resp.Diagnostics.HasError() || timeoutConfig.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Conflicting Timeout Configuration",
+		fmt.Sprintf("%s sets %s while connection_timeout_config is set as well. Both set the timeout "+
+			"settings of the connection, so set them in only one of the two.", req.Path, strings.Join(keys, ", ")),
+	)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigJSONTimeoutConflict(t *testing.T) {
+	timeoutType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"retry_count": tftypes.Number}}
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"config_json":               tftypes.String,
+		"connection_timeout_config": timeoutType,
+	}}
+	configSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"config_json": schema.StringAttribute{Optional: true},
+		"connection_timeout_config": schema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: map[string]schema.Attribute{"retry_count": schema.Int64Attribute{Optional: true}},
+		},
+	}}
+	config := func(value string, timeoutSet bool) tfsdk.Config {
+		timeout := tftypes.NewValue(timeoutType, nil)
+		if timeoutSet {
+			timeout = tftypes.NewValue(timeoutType, map[string]tftypes.Value{"retry_count": tftypes.NewValue(tftypes.Number, 3)})
+		}
+		return tfsdk.Config{
+			Schema: configSchema,
+			Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+				"config_json":               tftypes.NewValue(tftypes.String, value),
+				"connection_timeout_config": timeout,
+			}),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		validator  validator.String
+		value      string
+		timeoutSet bool
+		wantError  bool
+	}{
+		{"nested key without connection_timeout_config", ConfigJSONTimeoutConflict(), `{"connectionTimeoutConfig":{"retryCount":3}}`, false, false},
+		{"nested key with connection_timeout_config", ConfigJSONTimeoutConflict(), `{"connectionTimeoutConfig":{"retryCount":3}}`, true, true},
+		{"other keys with connection_timeout_config", ConfigJSONTimeoutConflict(), `{"apiRateLimitConfig":{}}`, true, false},
+		{"top level keys are not checked in config_json", ConfigJSONTimeoutConflict(), `{"retryCount":3}`, true, false},
+		{"top level key without connection_timeout_config", CustomConfigJSONTimeoutConflict(), `{"retryCount":3}`, false, false},
+		{"top level key with connection_timeout_config", CustomConfigJSONTimeoutConflict(), `{"readTimeout":50,"retryCount":3}`, true, true},
+		{"other top level keys with connection_timeout_config", CustomConfigJSONTimeoutConflict(), `{"custom":1}`, true, false},
+		{"invalid JSON", CustomConfigJSONTimeoutConflict(), `{"retryCount":`, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("config_json"),
+				ConfigValue: types.StringValue(tt.value),
+				Config:      config(tt.value, tt.timeoutSet),
+			}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("errors = %v, want error %t", resp.Diagnostics.Errors(), tt.wantError)
+			}
+		})
+	}
+}
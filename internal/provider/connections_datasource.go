@@ -8,11 +8,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openapi "github.com/saviynt/saviynt-api-go-client/connections"
@@ -40,17 +44,20 @@ type ConnectionsDataSourceModel struct {
 	ConnectionType types.String `tfsdk:"connection_type"`
 	Max            types.String `tfsdk:"max"`
 	Authenticate   types.Bool   `tfsdk:"authenticate"`
+	FetchAll       types.Bool   `tfsdk:"fetch_all"`
+	IncludeDetails types.Bool   `tfsdk:"include_details"`
 }
 
 type Connection struct {
-	ConnectionName        types.String `tfsdk:"connectionname"`
-	ConnectionType        types.String `tfsdk:"connectiontype"`
-	ConnectionDescription types.String `tfsdk:"connectiondescription"`
-	Status                types.Int32  `tfsdk:"status"`
-	CreatedBy             types.String `tfsdk:"createdby"`
-	CreatedOn             types.String `tfsdk:"createdon"`
-	UpdatedBy             types.String `tfsdk:"updatedby"`
-	UpdatedOn             types.String `tfsdk:"updatedon"`
+	ConnectionName        types.String       `tfsdk:"connectionname"`
+	ConnectionType        types.String       `tfsdk:"connectiontype"`
+	ConnectionDescription types.String       `tfsdk:"connectiondescription"`
+	Status                types.Int32        `tfsdk:"status"`
+	CreatedBy             types.String       `tfsdk:"createdby"`
+	CreatedOn             types.String       `tfsdk:"createdon"`
+	UpdatedBy             types.String       `tfsdk:"updatedby"`
+	UpdatedOn             types.String       `tfsdk:"updatedon"`
+	Details               *ConnectionDetails `tfsdk:"details"`
 }
 
 // NewConnectionsDataSource creates a new connections data source with default factory
@@ -113,6 +120,18 @@ func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.Schem
 				Optional:    true,
 				Description: "Offset for pagination",
 			},
+			"fetch_all": schema.BoolAttribute{
+				Optional:    true,
+				Description: FetchAllDescription("connections", "total_count", "display_count"),
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("offset")),
+				},
+			},
+			"include_details": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Read the attributes of every connection returned, with up to %d requests in parallel, "+
+					"and set them in the details block of each result. Requires authenticate to be true.", ConnectionDetailsParallelism),
+			},
 			"display_count": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Number of records returned in the response",
@@ -170,6 +189,7 @@ func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.Schem
 							Computed:    true,
 							Description: "Timestamp when the connection was last updated",
 						},
+						"details": ConnectionDetailsSchema(),
 					},
 				},
 			},
@@ -223,7 +243,13 @@ func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Execute API call to get connections
-	apiResp, err := d.ReadConnectionsDetails(ctx, &state)
+	var apiResp *openapi.GetConnectionsResponse
+	var err error
+	if fetchAllEnabled(state.FetchAll) {
+		apiResp, err = d.ReadAllConnections(ctx, &state)
+	} else {
+		apiResp, err = d.ReadConnectionsDetails(ctx, &state)
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to read connections details", map[string]interface{}{"error": err.Error()})
 		resp.Diagnostics.AddError(
@@ -239,6 +265,18 @@ func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Handle authentication logic
 	d.HandleConnectionsAuthenticationLogic(&state, resp)
 
+	// Expand results with their connection details
+	if !state.IncludeDetails.IsNull() && state.IncludeDetails.ValueBool() && state.Results != nil {
+		failures := d.ReadAllConnectionDetails(ctx, state.Results)
+		if len(failures) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Connection Details Not Read",
+				fmt.Sprintf("Details of %d of %d connections could not be read and are left null:\n%s",
+					len(failures), len(state.Results), summarizeBulkValues(failures)),
+			)
+		}
+	}
+
 	// Set final state
 	stateDiagnostics := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(stateDiagnostics...)
@@ -303,6 +341,54 @@ func (d *ConnectionsDataSource) ReadConnectionsDetails(ctx context.Context, stat
 	return apiResp, nil
 }
 
+// ReadAllConnections reads every page of connections matching the filters of state and returns
+// them as a single response
+func (d *ConnectionsDataSource) ReadAllConnections(ctx context.Context, state *ConnectionsDataSourceModel) (*openapi.GetConnectionsResponse, error) {
+	pageSize, err := fetchAllPageSize(state.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pages use the max and offset fields of the get connections request in the OpenAPI description
+	var firstResp *openapi.GetConnectionsResponse
+	connections, total, err := FetchAllPages(ctx, pageSize, FetchAllParallelism,
+		func(ctx context.Context, offset, max int) (Page[openapi.GetConnectionsResponseConnectionListInner], error) {
+			pageState := *state
+			pageState.Offset = types.StringValue(strconv.Itoa(offset))
+			pageState.Max = types.StringValue(strconv.Itoa(max))
+			resp, err := d.ReadConnectionsDetails(ctx, &pageState)
+			if err != nil || resp == nil {
+				return Page[openapi.GetConnectionsResponseConnectionListInner]{}, err
+			}
+			if offset == 0 {
+				firstResp = resp
+			}
+			total := -1
+			if resp.TotalCount != nil {
+				total = int(*resp.TotalCount)
+			}
+			return Page[openapi.GetConnectionsResponseConnectionListInner]{Items: resp.ConnectionList, Total: total}, nil
+		},
+		func(c openapi.GetConnectionsResponseConnectionListInner) string {
+			return util.SafeDeref(c.CONNECTIONNAME)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	allResp := openapi.GetConnectionsResponse{ConnectionList: connections}
+	if firstResp != nil {
+		allResp.Msg = firstResp.Msg
+		allResp.ErrorCode = firstResp.ErrorCode
+	}
+	allResp.SetDisplayCount(int32(len(connections)))
+	allResp.SetTotalCount(int32(total))
+
+	tflog.Debug(ctx, "Read all connection pages", map[string]interface{}{"count": len(connections), "total": total})
+	return &allResp, nil
+}
+
 // UpdateModelFromConnectionsResponse maps API response data to the Terraform state model
 func (d *ConnectionsDataSource) UpdateModelFromConnectionsResponse(state *ConnectionsDataSourceModel, apiResp *openapi.GetConnectionsResponse) {
 	// Set ID for the datasource
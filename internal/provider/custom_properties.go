@@ -0,0 +1,400 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"terraform-provider-Saviynt/internal/provider/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// CustomPropertiesSchema returns the custom_properties attribute of a resource whose object has
+// count custom properties.
+func CustomPropertiesSchema(count int) schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("Custom properties keyed by their number from 1 to %d, for example { \"3\" = \"Finance\" }. "+
+			"Keys can also be written as \"custom_property3\" or \"customproperty3\". An empty string clears a property and "+
+			"properties removed from the map are cleared in Saviynt. When the attribute is not set, custom properties are "+
+			"read from Saviynt and left unchanged.", count),
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Map{
+			validators.CustomPropertyKeys(count),
+			mapvalidator.ValueStringsAre(validators.NoWhitespaceOnly()),
+		},
+	}
+}
+
+// CustomPropertiesDataSourceSchema returns the computed custom_properties attribute of a data source.
+func CustomPropertiesDataSourceSchema() datasourceschema.MapAttribute {
+	return datasourceschema.MapAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "Custom properties that have a value, keyed by their number.",
+	}
+}
+
+// CustomPropertyLabelsSchema returns the custom_properties attribute of a resource whose custom
+// properties can also be set by the label configured for them in Saviynt. source names where the
+// labels are configured and is used in the description.
+func CustomPropertyLabelsSchema(count int, source string) schema.MapAttribute {
+	attribute := CustomPropertiesSchema(count)
+	attribute.Description += fmt.Sprintf(" A key can also be the label of the custom property configured on %s, "+
+		"for example { cost_center = \"1234\" } for a property labelled \"Cost Center\". Labels are matched ignoring case, "+
+		"spaces and punctuation and are resolved to the property number at plan time.", source)
+	attribute.Validators = []validator.Map{
+		validators.CustomPropertyKeysOrLabels(count),
+		mapvalidator.ValueStringsAre(validators.NoWhitespaceOnly()),
+	}
+	return attribute
+}
+
+// CustomPropertyLabels maps custom property indexes to the labels configured for them in Saviynt.
+type CustomPropertyLabels map[int]string
+
+// CustomPropertyLabelKey returns the form in which custom property labels are compared: lower case
+// with every run of characters other than letters and digits replaced by a single underscore.
+func CustomPropertyLabelKey(label string) string {
+	var b strings.Builder
+	separator := false
+	for _, r := range strings.ToLower(label) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if separator && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			separator = false
+			b.WriteRune(r)
+			continue
+		}
+		separator = true
+	}
+	return b.String()
+}
+
+// Index returns the index of a custom_properties key, which is either a property number or one of
+// the labels. When two properties have the same label the lowest index wins.
+func (l CustomPropertyLabels) Index(key string) (int, bool) {
+	if index, ok := validators.CustomPropertyIndex(key); ok {
+		return index, true
+	}
+	want := CustomPropertyLabelKey(key)
+	if want == "" {
+		return 0, false
+	}
+	for _, index := range l.indexes() {
+		if CustomPropertyLabelKey(l[index]) == want {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+func (l CustomPropertyLabels) indexes() []int {
+	indexes := make([]int, 0, len(l))
+	for index, label := range l {
+		if strings.TrimSpace(label) != "" {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// String lists the labels with their property number, for use in error messages.
+func (l CustomPropertyLabels) String() string {
+	indexes := l.indexes()
+	if len(indexes) == 0 {
+		return "none"
+	}
+	labels := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		labels = append(labels, fmt.Sprintf("%q (%d)", l[index], index))
+	}
+	return strings.Join(labels, ", ")
+}
+
+// CustomPropertyLabelKeys returns the keys of custom_properties maps that are not property numbers
+// and have to be resolved through the configured labels.
+func CustomPropertyLabelKeys(properties ...types.Map) []string {
+	var keys []string
+	for _, m := range properties {
+		if m.IsNull() || m.IsUnknown() {
+			continue
+		}
+		for key := range m.Elements() {
+			if _, ok := validators.CustomPropertyIndex(key); !ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ResolveCustomPropertyLabels checks that every label key of a planned custom_properties map
+// matches a configured label and that no two keys set the same property. source names where the
+// labels are configured and is used in error messages.
+func ResolveCustomPropertyLabels(properties types.Map, labels CustomPropertyLabels, count int, attributePath path.Path, source string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if properties.IsNull() || properties.IsUnknown() {
+		return diags
+	}
+
+	elements := properties.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seen := make(map[int]string)
+	for _, key := range keys {
+		index, ok := labels.Index(key)
+		if !ok {
+			diags.AddAttributeError(
+				attributePath.AtMapKey(key),
+				"Unknown Custom Property Label",
+				fmt.Sprintf("No custom property of %s is labelled %q. Configured labels: %s.", source, key, labels),
+			)
+			continue
+		}
+		if index < 1 || index > count {
+			diags.AddAttributeError(
+				attributePath.AtMapKey(key),
+				"Invalid Custom Property",
+				fmt.Sprintf("The label %q of %s belongs to custom property %d, but only properties 1 to %d can be set.", key, source, index, count),
+			)
+			continue
+		}
+		if other, exists := seen[index]; exists {
+			diags.AddAttributeError(
+				attributePath.AtMapKey(key),
+				"Duplicate Custom Property",
+				fmt.Sprintf("%q and %q both set custom property %d.", other, key, index),
+			)
+			continue
+		}
+		seen[index] = key
+	}
+	return diags
+}
+
+// CustomPropertyValues returns the values of a custom_properties map by property index. Keys that
+// are not property numbers are resolved through labels, which may be nil.
+func CustomPropertyValues(ctx context.Context, properties types.Map, labels CustomPropertyLabels) (map[int]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[int]string)
+	if properties.IsNull() || properties.IsUnknown() {
+		return values, diags
+	}
+
+	var elements map[string]string
+	diags.Append(properties.ElementsAs(ctx, &elements, false)...)
+	for key, value := range elements {
+		index, ok := labels.Index(key)
+		if !ok {
+			diags.AddError("Invalid Custom Property", fmt.Sprintf("%q is not a custom property or a configured custom property label.", key))
+			continue
+		}
+		values[index] = value
+	}
+	return values, diags
+}
+
+// CustomPropertyChanges returns the custom properties to send to Saviynt for a plan. Properties that
+// are in state but no longer in the plan are cleared. Nothing is sent when custom_properties is not
+// configured.
+func CustomPropertyChanges(ctx context.Context, plan, state types.Map, labels CustomPropertyLabels) (map[int]string, diag.Diagnostics) {
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil, nil
+	}
+	changes, diags := CustomPropertyValues(ctx, plan, labels)
+	previous, stateDiags := CustomPropertyValues(ctx, state, labels)
+	diags.Append(stateDiags...)
+	for index := range previous {
+		if _, ok := changes[index]; !ok {
+			changes[index] = ""
+		}
+	}
+	return changes, diags
+}
+
+// SetCustomPropertyFields sets the CustompropertyN or CustomPropertyN *string fields of an API
+// request to the given values.
+func SetCustomPropertyFields(request interface{}, values map[int]string) {
+	target := reflect.ValueOf(request).Elem()
+	for index, value := range values {
+		field := customPropertyField(target, index)
+		if !field.IsValid() || !field.CanSet() {
+			continue
+		}
+		v := value
+		field.Set(reflect.ValueOf(&v))
+	}
+}
+
+// CustomPropertyFields reads the CustompropertyN or CustomPropertyN *string fields of an API
+// response for the properties 1 to count.
+func CustomPropertyFields(response interface{}, count int) map[int]*string {
+	source := reflect.ValueOf(response)
+	if source.Kind() == reflect.Ptr {
+		source = source.Elem()
+	}
+	values := make(map[int]*string, count)
+	for index := 1; index <= count; index++ {
+		field := customPropertyField(source, index)
+		if !field.IsValid() {
+			continue
+		}
+		if value, ok := field.Interface().(*string); ok {
+			values[index] = value
+		}
+	}
+	return values
+}
+
+func customPropertyField(v reflect.Value, index int) reflect.Value {
+	for _, format := range []string{"Customproperty%d", "CustomProperty%d"} {
+		field := v.FieldByName(fmt.Sprintf(format, index))
+		if field.IsValid() && field.Type() == reflect.TypeOf((*string)(nil)) {
+			return field
+		}
+	}
+	return reflect.Value{}
+}
+
+// CustomPropertiesValue builds the custom_properties map from the values returned by Saviynt.
+// Properties without a value are left out, except where prior holds an empty string for them, and
+// keys keep the spelling used in prior, including labels resolved through labels, so that the result
+// matches the configuration.
+func CustomPropertiesValue(ctx context.Context, values map[int]*string, prior types.Map, labels CustomPropertyLabels) (types.Map, diag.Diagnostics) {
+	priorKeys := make(map[int]string)
+	priorEmpty := make(map[int]bool)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var elements map[string]string
+		if diags := prior.ElementsAs(ctx, &elements, false); !diags.HasError() {
+			for key, value := range elements {
+				if index, ok := labels.Index(key); ok {
+					priorKeys[index] = key
+					priorEmpty[index] = value == ""
+				}
+			}
+		}
+	}
+
+	properties := make(map[string]string)
+	for index, value := range values {
+		key, ok := priorKeys[index]
+		if !ok {
+			key = strconv.Itoa(index)
+		}
+		if value != nil && *value != "" {
+			properties[key] = *value
+		} else if priorEmpty[index] {
+			properties[key] = ""
+		}
+	}
+	return types.MapValueFrom(ctx, types.StringType, properties)
+}
+
+// CustomPropertiesDataSourceValue builds the computed custom_properties map of a data source.
+func CustomPropertiesDataSourceValue(ctx context.Context, values map[int]*string) (types.Map, diag.Diagnostics) {
+	return CustomPropertiesValue(ctx, values, types.MapNull(types.StringType), nil)
+}
+
+// CustomPropertiesStateUpgrader upgrades state of schema version 0, which had one attribute per
+// custom property named by format (for example "customproperty%d"), to the custom_properties map.
+// current is the current schema of the resource. Properties with an empty value and attributes
+// that no longer exist in current are not carried over, and a warning names them.
+func CustomPropertiesStateUpgrader(current schema.Schema, format string, count int) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The saved state does not contain JSON data and cannot be upgraded.",
+				)
+				return
+			}
+
+			var raw map[string]interface{}
+			if err := json.Unmarshal(req.RawState.JSON, &raw); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Failed to parse the saved state: %s", err))
+				return
+			}
+
+			properties := make(map[string]string)
+			var empty []string
+			for index := 1; index <= count; index++ {
+				key := fmt.Sprintf(format, index)
+				if value, ok := raw[key].(string); ok {
+					if value != "" {
+						properties[strconv.Itoa(index)] = value
+					} else {
+						empty = append(empty, key)
+					}
+				}
+				delete(raw, key)
+			}
+			raw["custom_properties"] = properties
+
+			// Drop attributes that were removed from the schema since the state was written
+			var removed []string
+			for key, value := range raw {
+				if _, ok := current.Attributes[key]; !ok {
+					if value != nil {
+						removed = append(removed, key)
+					}
+					delete(raw, key)
+				}
+			}
+
+			if len(empty) > 0 {
+				resp.Diagnostics.AddWarning(
+					"Empty Custom Properties Not Carried Over",
+					fmt.Sprintf("The saved state held an empty value for %s. custom_properties only holds properties that have "+
+						"a value, so these are left out; set them to \"\" in custom_properties to keep clearing them.", strings.Join(empty, ", ")),
+				)
+			}
+			if len(removed) > 0 {
+				sort.Strings(removed)
+				resp.Diagnostics.AddWarning(
+					"Attributes Removed From State",
+					fmt.Sprintf("The saved state held values for %s, which are no longer attributes of this resource. "+
+						"The values are dropped from state and are not changed in Saviynt.", strings.Join(removed, ", ")),
+				)
+			}
+
+			data, err := json.Marshal(raw)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Failed to write the upgraded state: %s", err))
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: data}
+		},
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestCustomPropertiesStateUpgrader(t *testing.T) {
+	current := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                schema.StringAttribute{Computed: true},
+			"name":              schema.StringAttribute{Required: true},
+			"custom_properties": CustomPropertiesSchema(3),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		format       string
+		state        string
+		want         map[string]interface{}
+		wantWarnings []string
+	}{
+		{
+			name:   "entitlement properties",
+			format: "customproperty%d",
+			state:  `{"id":"1","name":"ent","customproperty1":"Finance","customproperty3":"Tier 1"}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "ent",
+				"custom_properties": map[string]interface{}{"1": "Finance", "3": "Tier 1"},
+			},
+		},
+		{
+			name:   "endpoint and role properties",
+			format: "custom_property%d",
+			state:  `{"id":"1","name":"role","custom_property2":"Sales","custom_property3":null}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "role",
+				"custom_properties": map[string]interface{}{"2": "Sales"},
+			},
+		},
+		{
+			name:   "no properties",
+			format: "custom_property%d",
+			state:  `{"id":"1","name":"role"}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "role",
+				"custom_properties": map[string]interface{}{},
+			},
+		},
+		{
+			name:   "empty values are warned about",
+			format: "customproperty%d",
+			state:  `{"id":"1","name":"ent","customproperty1":"","customproperty2":"HR","customproperty3":""}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "ent",
+				"custom_properties": map[string]interface{}{"2": "HR"},
+			},
+			wantWarnings: []string{"Empty Custom Properties Not Carried Over"},
+		},
+		{
+			name:   "properties above the count are removed attributes",
+			format: "custom_property%d",
+			state:  `{"id":"1","name":"role","custom_property4":"Legacy"}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "role",
+				"custom_properties": map[string]interface{}{},
+			},
+			wantWarnings: []string{"Attributes Removed From State"},
+		},
+		{
+			name:   "removed attributes with a value are warned about",
+			format: "custom_property%d",
+			state:  `{"id":"1","name":"role","old_flag":"true","old_list":null,"custom_property1":""}`,
+			want: map[string]interface{}{
+				"id":                "1",
+				"name":              "role",
+				"custom_properties": map[string]interface{}{},
+			},
+			wantWarnings: []string{"Empty Custom Properties Not Carried Over", "Attributes Removed From State"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrader := CustomPropertiesStateUpgrader(current, tt.format, 3)
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.state)}}
+			resp := &resource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			var warnings []string
+			for _, d := range resp.Diagnostics.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+			if resp.DynamicValue == nil {
+				t.Fatal("no upgraded state")
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &got); err != nil {
+				t.Fatalf("upgraded state is not JSON: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("upgraded state = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomPropertiesStateUpgraderInvalidState(t *testing.T) {
+	upgrader := CustomPropertiesStateUpgrader(schema.Schema{}, "customproperty%d", 3)
+	for name, raw := range map[string]*tfprotov6.RawState{
+		"missing": nil,
+		"no JSON": {},
+		"invalid": {JSON: []byte("{")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{RawState: raw}, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestCustomPropertyChanges(t *testing.T) {
+	ctx := context.Background()
+	mapOf := func(elements map[string]string) types.Map {
+		if elements == nil {
+			return types.MapNull(types.StringType)
+		}
+		m, diags := types.MapValueFrom(ctx, types.StringType, elements)
+		if diags.HasError() {
+			t.Fatalf("building map: %v", diags)
+		}
+		return m
+	}
+	labels := CustomPropertyLabels{2: "Cost Center"}
+
+	tests := []struct {
+		name  string
+		plan  types.Map
+		state types.Map
+		want  map[int]string
+	}{
+		{"not configured", mapOf(nil), mapOf(map[string]string{"1": "a"}), nil},
+		{"new properties", mapOf(map[string]string{"1": "a", "custom_property3": "c"}), mapOf(nil), map[int]string{1: "a", 3: "c"}},
+		{"removed properties are cleared", mapOf(map[string]string{"1": "a"}), mapOf(map[string]string{"1": "x", "customproperty3": "c"}), map[int]string{1: "a", 3: ""}},
+		{"labels", mapOf(map[string]string{"cost_center": "1234"}), mapOf(map[string]string{"2": "9"}), map[int]string{2: "1234"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := CustomPropertyChanges(ctx, tt.plan, tt.state, labels)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CustomPropertyChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
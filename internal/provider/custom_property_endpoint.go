@@ -1,56 +0,0 @@
-// Copyright (c) 2025 Saviynt Inc.
-// SPDX-License-Identifier: MPL-2.0
-
-package provider
-
-import (
-	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-type CustomPropertyModel struct {
-	CustomProperty1  types.String `tfsdk:"custom_property1"`
-	CustomProperty2  types.String `tfsdk:"custom_property2"`
-	CustomProperty3  types.String `tfsdk:"custom_property3"`
-	CustomProperty4  types.String `tfsdk:"custom_property4"`
-	CustomProperty5  types.String `tfsdk:"custom_property5"`
-	CustomProperty6  types.String `tfsdk:"custom_property6"`
-	CustomProperty7  types.String `tfsdk:"custom_property7"`
-	CustomProperty8  types.String `tfsdk:"custom_property8"`
-	CustomProperty9  types.String `tfsdk:"custom_property9"`
-	CustomProperty10 types.String `tfsdk:"custom_property10"`
-	CustomProperty11 types.String `tfsdk:"custom_property11"`
-	CustomProperty12 types.String `tfsdk:"custom_property12"`
-	CustomProperty13 types.String `tfsdk:"custom_property13"`
-	CustomProperty14 types.String `tfsdk:"custom_property14"`
-	CustomProperty15 types.String `tfsdk:"custom_property15"`
-	CustomProperty16 types.String `tfsdk:"custom_property16"`
-	CustomProperty17 types.String `tfsdk:"custom_property17"`
-	CustomProperty18 types.String `tfsdk:"custom_property18"`
-	CustomProperty19 types.String `tfsdk:"custom_property19"`
-	CustomProperty20 types.String `tfsdk:"custom_property20"`
-	CustomProperty21 types.String `tfsdk:"custom_property21"`
-	CustomProperty22 types.String `tfsdk:"custom_property22"`
-	CustomProperty23 types.String `tfsdk:"custom_property23"`
-	CustomProperty24 types.String `tfsdk:"custom_property24"`
-	CustomProperty25 types.String `tfsdk:"custom_property25"`
-	CustomProperty26 types.String `tfsdk:"custom_property26"`
-	CustomProperty27 types.String `tfsdk:"custom_property27"`
-	CustomProperty28 types.String `tfsdk:"custom_property28"`
-	CustomProperty29 types.String `tfsdk:"custom_property29"`
-	CustomProperty30 types.String `tfsdk:"custom_property30"`
-	CustomProperty31 types.String `tfsdk:"custom_property31"`
-	CustomProperty32 types.String `tfsdk:"custom_property32"`
-	CustomProperty33 types.String `tfsdk:"custom_property33"`
-	CustomProperty34 types.String `tfsdk:"custom_property34"`
-	CustomProperty35 types.String `tfsdk:"custom_property35"`
-	CustomProperty36 types.String `tfsdk:"custom_property36"`
-	CustomProperty37 types.String `tfsdk:"custom_property37"`
-	CustomProperty38 types.String `tfsdk:"custom_property38"`
-	CustomProperty39 types.String `tfsdk:"custom_property39"`
-	CustomProperty40 types.String `tfsdk:"custom_property40"`
-	CustomProperty41 types.String `tfsdk:"custom_property41"`
-	CustomProperty42 types.String `tfsdk:"custom_property42"`
-	CustomProperty43 types.String `tfsdk:"custom_property43"`
-	CustomProperty44 types.String `tfsdk:"custom_property44"`
-	CustomProperty45 types.String `tfsdk:"custom_property45"`
-}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package customtypes holds the custom attribute types of the provider.
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = JSONStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = JSONString{}
+)
+
+// JSONStringType is a string type for attributes that hold a JSON document. Values of the type
+// are semantically equal when they decode to the same JSON, so whitespace and key order
+// differences between the configuration and the value returned by Saviynt do not cause a diff.
+type JSONStringType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t JSONStringType) String() string {
+	return "customtypes.JSONStringType"
+}
+
+// ValueType returns the Value type.
+func (t JSONStringType) ValueType(ctx context.Context) attr.Value {
+	return JSONString{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t JSONStringType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t JSONStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONString{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t JSONStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// JSONString is the value of a JSONStringType attribute.
+type JSONString struct {
+	basetypes.StringValue
+}
+
+// Type returns a JSONStringType.
+func (v JSONString) Type(ctx context.Context) attr.Type {
+	return JSONStringType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v JSONString) Equal(o attr.Value) bool {
+	other, ok := o.(JSONString)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values decode to the same JSON. Values that are not
+// valid JSON, such as an empty string, are only equal when the strings are equal.
+func (v JSONString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	return JSONSemanticEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// JSONSemanticEqual reports whether a and b decode to the same JSON. When either one is not
+// valid JSON the strings are compared as they are.
+func JSONSemanticEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var left, right interface{}
+	if err := decodeJSON(a, &left); err != nil {
+		return false
+	}
+	if err := decodeJSON(b, &right); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// decodeJSON decodes a single JSON document, keeping numbers as written so that large integers
+// are compared exactly.
+func decodeJSON(s string, target *interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after the JSON document")
+	}
+	*target = normalizeNumbers(*target)
+	return nil
+}
+
+// jsonNumber is the exact rational form of a JSON number, kept apart from strings.
+type jsonNumber string
+
+// normalizeNumbers replaces the numbers in value with their exact rational form, so that 1, 1.0
+// and 1e0 compare equal.
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(v.String()); ok {
+			return jsonNumber(r.RatString())
+		}
+		return jsonNumber(v.String())
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeNumbers(item)
+		}
+	}
+	return value
+}
+
+// NewJSONStringNull creates a JSONString with a null value.
+func NewJSONStringNull() JSONString {
+	return JSONString{StringValue: basetypes.NewStringNull()}
+}
+
+// NewJSONStringUnknown creates a JSONString with an unknown value.
+func NewJSONStringUnknown() JSONString {
+	return JSONString{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewJSONStringValue creates a JSONString with a known value.
+func NewJSONStringValue(value string) JSONString {
+	return JSONString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewJSONStringPointerValue creates a JSONString with a null value if nil or a known value.
+func NewJSONStringPointerValue(value *string) JSONString {
+	return JSONString{StringValue: basetypes.NewStringPointerValue(value)}
+}
@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
 	"terraform-provider-Saviynt/util/errorsutil"
@@ -39,44 +41,44 @@ var dbErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeDB)
 
 type DBConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                     types.String `tfsdk:"id"`
-	URL                    types.String `tfsdk:"url"`
-	Username               types.String `tfsdk:"username"`
-	Password               types.String `tfsdk:"password"`
-	PasswordWo             types.String `tfsdk:"password_wo"`
-	DriverName             types.String `tfsdk:"driver_name"`
-	ConnectionProperties   types.String `tfsdk:"connection_properties"`
-	PasswordMinLength      types.String `tfsdk:"password_min_length"`
-	PasswordMaxLength      types.String `tfsdk:"password_max_length"`
-	PasswordNoOfCapsAlpha  types.String `tfsdk:"password_no_of_caps_alpha"`
-	PasswordNoOfDigits     types.String `tfsdk:"password_no_of_digits"`
-	PasswordNoOfSplChars   types.String `tfsdk:"password_no_of_spl_chars"`
-	CreateAccountJson      types.String `tfsdk:"create_account_json"`
-	UpdateAccountJson      types.String `tfsdk:"update_account_json"`
-	GrantAccessJson        types.String `tfsdk:"grant_access_json"`
-	RevokeAccessJson       types.String `tfsdk:"revoke_access_json"`
-	ChangePassJson         types.String `tfsdk:"change_pass_json"`
-	ChangePassJsonWO       types.String `tfsdk:"change_pass_json_wo"`
-	DeleteAccountJson      types.String `tfsdk:"delete_account_json"`
-	EnableAccountJson      types.String `tfsdk:"enable_account_json"`
-	DisableAccountJson     types.String `tfsdk:"disable_account_json"`
-	AccountExistsJson      types.String `tfsdk:"account_exists_json"`
-	UpdateUserJson         types.String `tfsdk:"update_user_json"`
-	AccountsImport         types.String `tfsdk:"accounts_import"`
-	EntitlementValueImport types.String `tfsdk:"entitlement_value_import"`
-	RoleOwnerImport        types.String `tfsdk:"role_owner_import"`
-	RolesImport            types.String `tfsdk:"roles_import"`
-	SystemImport           types.String `tfsdk:"system_import"`
-	UserImport             types.String `tfsdk:"user_import"`
-	ModifyUserDataJson     types.String `tfsdk:"modify_user_data_json"`
-	StatusThresholdConfig  types.String `tfsdk:"status_threshold_config"`
-	MaxPaginationSize      types.String `tfsdk:"max_pagination_size"`
-	CliCommandJson         types.String `tfsdk:"cli_command_json"`
+	ID                     types.String           `tfsdk:"id"`
+	URL                    types.String           `tfsdk:"url"`
+	Username               types.String           `tfsdk:"username"`
+	Password               types.String           `tfsdk:"password"`
+	PasswordWo             types.String           `tfsdk:"password_wo"`
+	DriverName             types.String           `tfsdk:"driver_name"`
+	ConnectionProperties   customtypes.JSONString `tfsdk:"connection_properties"`
+	PasswordMinLength      types.String           `tfsdk:"password_min_length"`
+	PasswordMaxLength      types.String           `tfsdk:"password_max_length"`
+	PasswordNoOfCapsAlpha  types.String           `tfsdk:"password_no_of_caps_alpha"`
+	PasswordNoOfDigits     types.String           `tfsdk:"password_no_of_digits"`
+	PasswordNoOfSplChars   types.String           `tfsdk:"password_no_of_spl_chars"`
+	CreateAccountJson      customtypes.JSONString `tfsdk:"create_account_json"`
+	UpdateAccountJson      customtypes.JSONString `tfsdk:"update_account_json"`
+	GrantAccessJson        customtypes.JSONString `tfsdk:"grant_access_json"`
+	RevokeAccessJson       customtypes.JSONString `tfsdk:"revoke_access_json"`
+	ChangePassJson         customtypes.JSONString `tfsdk:"change_pass_json"`
+	ChangePassJsonWO       types.String           `tfsdk:"change_pass_json_wo"`
+	DeleteAccountJson      customtypes.JSONString `tfsdk:"delete_account_json"`
+	EnableAccountJson      customtypes.JSONString `tfsdk:"enable_account_json"`
+	DisableAccountJson     customtypes.JSONString `tfsdk:"disable_account_json"`
+	AccountExistsJson      customtypes.JSONString `tfsdk:"account_exists_json"`
+	UpdateUserJson         customtypes.JSONString `tfsdk:"update_user_json"`
+	AccountsImport         types.String           `tfsdk:"accounts_import"`
+	EntitlementValueImport types.String           `tfsdk:"entitlement_value_import"`
+	RoleOwnerImport        types.String           `tfsdk:"role_owner_import"`
+	RolesImport            types.String           `tfsdk:"roles_import"`
+	SystemImport           types.String           `tfsdk:"system_import"`
+	UserImport             types.String           `tfsdk:"user_import"`
+	ModifyUserDataJson     customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	StatusThresholdConfig  customtypes.JSONString `tfsdk:"status_threshold_config"`
+	MaxPaginationSize      types.String           `tfsdk:"max_pagination_size"`
+	CliCommandJson         customtypes.JSONString `tfsdk:"cli_command_json"`
 	//TER-176
-	CreateEntitlementJson types.String `tfsdk:"create_entitlement_json"`
-	DeleteEntitlementJson types.String `tfsdk:"delete_entitlement_json"`
-	EntitlementExistJson  types.String `tfsdk:"entitlement_exist_json"`
-	UpdateEntitlementJson types.String `tfsdk:"update_entitlement_json"`
+	CreateEntitlementJson customtypes.JSONString `tfsdk:"create_entitlement_json"`
+	DeleteEntitlementJson customtypes.JSONString `tfsdk:"delete_entitlement_json"`
+	EntitlementExistJson  customtypes.JSONString `tfsdk:"entitlement_exist_json"`
+	UpdateEntitlementJson customtypes.JSONString `tfsdk:"update_entitlement_json"`
 }
 
 type DBConnectionResource struct {
@@ -138,6 +140,7 @@ func DBConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Driver name for the connection",
 		},
 		"connection_properties": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Properties that need to be added when connecting to the database",
@@ -168,26 +171,31 @@ func DBConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Specify the number of special characters required for the random password",
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to create a new account (e.g., randomPassword, task, user, accountName, role, endpoint, etc.)",
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to update an existing account",
 		},
 		"grant_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to provide access",
 		},
 		"revoke_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to revoke access",
 		},
 		"change_pass_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "JSON to specify the queries/stored procedures used to change a password",
@@ -204,26 +212,31 @@ func DBConnectorResourceSchema() map[string]schema.Attribute {
 			},
 		},
 		"delete_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to delete an account",
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to enable an account",
 		},
 		"disable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to disable an account",
 		},
 		"account_exists_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the query used to check whether an account exists",
 		},
 		"update_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the queries/stored procedures used to update user information",
@@ -259,14 +272,19 @@ func DBConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "User Import XML file content",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Property for MODIFYUSERDATAJSON",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration for status and threshold (e.g., statusColumn, activeStatus, accountThresholdValue, etc.)",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"max_pagination_size": schema.StringAttribute{
 			Optional:    true,
@@ -274,27 +292,32 @@ func DBConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Defines the maximum number of records to be processed per page",
 		},
 		"cli_command_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify commands executable on the target server",
 		},
 		//TER-176
 		"create_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: " JSON to specify the Queries/stored procedures which will be used to Create the New Entitlements. Objects Exposed - (entitlementMgmtObj, task, user, endpoint and all the objects defined in Dynamic Attributes).",
 		},
 		"delete_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: " JSON to specify the Queries/stored procedures which will be used to Delete the Entitlements. Objects Exposed - (entitlementMgmtObj, task, user, endpoint and all the objects defined in Dynamic Attributes).",
 		},
 		"entitlement_exist_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to specify the Query which will be used to check whether an entitlement exists. Objects Exposed - (entitlementMgmtObj, task, user, endpoint and all the objects defined in Dynamic Attributes).",
 		},
 		"update_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: " JSON to specify the Queries/stored procedures which will be used to Update the Entitlements. Objects Exposed - (entitlementMgmtObj, task, user, endpoint and all the objects defined in Dynamic Attributes).",
@@ -407,8 +430,14 @@ func (r *DBConnectionResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateDBConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateDBConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "DB connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -493,7 +522,7 @@ func (r *DBConnectionResource) CreateDBConnection(ctx context.Context, plan *DBC
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_db_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, dbConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, dbConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -558,7 +587,7 @@ func (r *DBConnectionResource) BuildDBConnector(plan *DBConnectorResourceModel,
 		DRIVERNAME: plan.DriverName.ValueString(),
 
 		// Optional configuration fields
-		CONNECTIONPROPERTIES:   util.StringPointerOrEmpty(plan.ConnectionProperties),
+		CONNECTIONPROPERTIES:   util.StringPointerOrEmpty(plan.ConnectionProperties.StringValue),
 		PASSWORD_MIN_LENGTH:    util.StringPointerOrEmpty(plan.PasswordMinLength),
 		PASSWORD_MAX_LENGTH:    util.StringPointerOrEmpty(plan.PasswordMaxLength),
 		PASSWORD_NOOFCAPSALPHA: util.StringPointerOrEmpty(plan.PasswordNoOfCapsAlpha),
@@ -566,17 +595,17 @@ func (r *DBConnectionResource) BuildDBConnector(plan *DBConnectorResourceModel,
 		PASSWORD_NOOFSPLCHARS:  util.StringPointerOrEmpty(plan.PasswordNoOfSplChars),
 
 		// Account management JSON configurations
-		CREATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UPDATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		GRANTACCESSJSON:    util.StringPointerOrEmpty(plan.GrantAccessJson),
-		REVOKEACCESSJSON:   util.StringPointerOrEmpty(plan.RevokeAccessJson),
+		CREATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UPDATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		GRANTACCESSJSON:    util.StringPointerOrEmpty(plan.GrantAccessJson.StringValue),
+		REVOKEACCESSJSON:   util.StringPointerOrEmpty(plan.RevokeAccessJson.StringValue),
 		CHANGEPASSJSON:     util.StringPointerOrEmpty(types.StringValue(changePassJson)),
-		DELETEACCOUNTJSON:  util.StringPointerOrEmpty(plan.DeleteAccountJson),
-		ENABLEACCOUNTJSON:  util.StringPointerOrEmpty(plan.EnableAccountJson),
-		DISABLEACCOUNTJSON: util.StringPointerOrEmpty(plan.DisableAccountJson),
-		ACCOUNTEXISTSJSON:  util.StringPointerOrEmpty(plan.AccountExistsJson),
-		UPDATEUSERJSON:     util.StringPointerOrEmpty(plan.UpdateUserJson),
-		MODIFYUSERDATAJSON: util.StringPointerOrEmpty(plan.ModifyUserDataJson),
+		DELETEACCOUNTJSON:  util.StringPointerOrEmpty(plan.DeleteAccountJson.StringValue),
+		ENABLEACCOUNTJSON:  util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
+		DISABLEACCOUNTJSON: util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
+		ACCOUNTEXISTSJSON:  util.StringPointerOrEmpty(plan.AccountExistsJson.StringValue),
+		UPDATEUSERJSON:     util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
+		MODIFYUSERDATAJSON: util.StringPointerOrEmpty(plan.ModifyUserDataJson.StringValue),
 
 		// Import configurations
 		ACCOUNTSIMPORT:         util.StringPointerOrEmpty(plan.AccountsImport),
@@ -587,15 +616,15 @@ func (r *DBConnectionResource) BuildDBConnector(plan *DBConnectorResourceModel,
 		USERIMPORT:             util.StringPointerOrEmpty(plan.UserImport),
 
 		// Additional configurations
-		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		MAX_PAGINATION_SIZE:     util.StringPointerOrEmpty(plan.MaxPaginationSize),
-		CLI_COMMAND_JSON:        util.StringPointerOrEmpty(plan.CliCommandJson),
+		CLI_COMMAND_JSON:        util.StringPointerOrEmpty(plan.CliCommandJson.StringValue),
 
 		// Entitlement management (TER-176)
-		CREATEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.CreateEntitlementJson),
-		DELETEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.DeleteEntitlementJson),
-		ENTITLEMENTEXISTJSON:  util.StringPointerOrEmpty(plan.EntitlementExistJson),
-		UPDATEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.UpdateEntitlementJson),
+		CREATEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.CreateEntitlementJson.StringValue),
+		DELETEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.DeleteEntitlementJson.StringValue),
+		ENTITLEMENTEXISTJSON:  util.StringPointerOrEmpty(plan.EntitlementExistJson.StringValue),
+		UPDATEENTITLEMENTJSON: util.StringPointerOrEmpty(plan.UpdateEntitlementJson.StringValue),
 	}
 
 	// Handle vault configuration
@@ -619,35 +648,35 @@ func (r *DBConnectionResource) UpdateModelFromCreateResponse(plan *DBConnectorRe
 	plan.Description = util.SafeStringDatasource(plan.Description.ValueStringPointer())
 	plan.DefaultSavRoles = util.SortedCommaSeparated(util.SafeStringDatasource(plan.DefaultSavRoles.ValueStringPointer()))
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
-	plan.ConnectionProperties = util.SafeStringDatasource(plan.ConnectionProperties.ValueStringPointer())
+	plan.ConnectionProperties = customtypes.NewJSONStringPointerValue(plan.ConnectionProperties.ValueStringPointer())
 	plan.PasswordMinLength = util.SafeStringDatasource(plan.PasswordMinLength.ValueStringPointer())
 	plan.PasswordMaxLength = util.SafeStringDatasource(plan.PasswordMaxLength.ValueStringPointer())
 	plan.PasswordNoOfCapsAlpha = util.SafeStringDatasource(plan.PasswordNoOfCapsAlpha.ValueStringPointer())
 	plan.PasswordNoOfDigits = util.SafeStringDatasource(plan.PasswordNoOfDigits.ValueStringPointer())
 	plan.PasswordNoOfSplChars = util.SafeStringDatasource(plan.PasswordNoOfSplChars.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.GrantAccessJson = util.SafeStringDatasource(plan.GrantAccessJson.ValueStringPointer())
-	plan.RevokeAccessJson = util.SafeStringDatasource(plan.RevokeAccessJson.ValueStringPointer())
-	plan.DeleteAccountJson = util.SafeStringDatasource(plan.DeleteAccountJson.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.AccountExistsJson = util.SafeStringDatasource(plan.AccountExistsJson.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
-	plan.ModifyUserDataJson = util.SafeStringDatasource(plan.ModifyUserDataJson.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.GrantAccessJson = customtypes.NewJSONStringPointerValue(plan.GrantAccessJson.ValueStringPointer())
+	plan.RevokeAccessJson = customtypes.NewJSONStringPointerValue(plan.RevokeAccessJson.ValueStringPointer())
+	plan.DeleteAccountJson = customtypes.NewJSONStringPointerValue(plan.DeleteAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.AccountExistsJson = customtypes.NewJSONStringPointerValue(plan.AccountExistsJson.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
+	plan.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserDataJson.ValueStringPointer())
 	plan.AccountsImport = util.SafeStringDatasource(plan.AccountsImport.ValueStringPointer())
 	plan.EntitlementValueImport = util.SafeStringDatasource(plan.EntitlementValueImport.ValueStringPointer())
 	plan.RoleOwnerImport = util.SafeStringDatasource(plan.RoleOwnerImport.ValueStringPointer())
 	plan.RolesImport = util.SafeStringDatasource(plan.RolesImport.ValueStringPointer())
 	plan.SystemImport = util.SafeStringDatasource(plan.SystemImport.ValueStringPointer())
 	plan.UserImport = util.SafeStringDatasource(plan.UserImport.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.MaxPaginationSize = util.SafeStringDatasource(plan.MaxPaginationSize.ValueStringPointer())
-	plan.CliCommandJson = util.SafeStringDatasource(plan.CliCommandJson.ValueStringPointer())
-	plan.CreateEntitlementJson = util.SafeStringDatasource(plan.CreateEntitlementJson.ValueStringPointer())
-	plan.DeleteEntitlementJson = util.SafeStringDatasource(plan.DeleteEntitlementJson.ValueStringPointer())
-	plan.EntitlementExistJson = util.SafeStringDatasource(plan.EntitlementExistJson.ValueStringPointer())
-	plan.UpdateEntitlementJson = util.SafeStringDatasource(plan.UpdateEntitlementJson.ValueStringPointer())
+	plan.CliCommandJson = customtypes.NewJSONStringPointerValue(plan.CliCommandJson.ValueStringPointer())
+	plan.CreateEntitlementJson = customtypes.NewJSONStringPointerValue(plan.CreateEntitlementJson.ValueStringPointer())
+	plan.DeleteEntitlementJson = customtypes.NewJSONStringPointerValue(plan.DeleteEntitlementJson.ValueStringPointer())
+	plan.EntitlementExistJson = customtypes.NewJSONStringPointerValue(plan.EntitlementExistJson.ValueStringPointer())
+	plan.UpdateEntitlementJson = customtypes.NewJSONStringPointerValue(plan.UpdateEntitlementJson.ValueStringPointer())
 }
 
 func (r *DBConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -687,7 +716,9 @@ func (r *DBConnectionResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.DBConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -795,7 +826,7 @@ func (r *DBConnectionResource) UpdateModelFromReadResponse(state *DBConnectorRes
 		state.DriverName = util.SafeStringDatasource(attrs.DRIVERNAME)
 
 		// Optional configuration fields
-		state.ConnectionProperties = util.SafeStringDatasource(attrs.CONNECTIONPROPERTIES)
+		state.ConnectionProperties = customtypes.NewJSONStringPointerValue(attrs.CONNECTIONPROPERTIES)
 		state.PasswordMinLength = util.SafeStringDatasource(attrs.PASSWORD_MIN_LENGTH)
 		state.PasswordMaxLength = util.SafeStringDatasource(attrs.PASSWORD_MAX_LENGTH)
 		state.PasswordNoOfCapsAlpha = util.SafeStringDatasource(attrs.PASSWORD_NOOFCAPSALPHA)
@@ -803,16 +834,16 @@ func (r *DBConnectionResource) UpdateModelFromReadResponse(state *DBConnectorRes
 		state.PasswordNoOfSplChars = util.SafeStringDatasource(attrs.PASSWORD_NOOFSPLCHARS)
 
 		// Account management JSON configurations
-		state.CreateAccountJson = util.SafeStringDatasource(attrs.CREATEACCOUNTJSON)
-		state.UpdateAccountJson = util.SafeStringDatasource(attrs.UPDATEACCOUNTJSON)
-		state.GrantAccessJson = util.SafeStringDatasource(attrs.GRANTACCESSJSON)
-		state.RevokeAccessJson = util.SafeStringDatasource(attrs.REVOKEACCESSJSON)
-		state.DeleteAccountJson = util.SafeStringDatasource(attrs.DELETEACCOUNTJSON)
-		state.EnableAccountJson = util.SafeStringDatasource(attrs.ENABLEACCOUNTJSON)
-		state.DisableAccountJson = util.SafeStringDatasource(attrs.DISABLEACCOUNTJSON)
-		state.AccountExistsJson = util.SafeStringDatasource(attrs.ACCOUNTEXISTSJSON)
-		state.UpdateUserJson = util.SafeStringDatasource(attrs.UPDATEUSERJSON)
-		state.ModifyUserDataJson = util.SafeStringDatasource(attrs.MODIFYUSERDATAJSON)
+		state.CreateAccountJson = customtypes.NewJSONStringPointerValue(attrs.CREATEACCOUNTJSON)
+		state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(attrs.UPDATEACCOUNTJSON)
+		state.GrantAccessJson = customtypes.NewJSONStringPointerValue(attrs.GRANTACCESSJSON)
+		state.RevokeAccessJson = customtypes.NewJSONStringPointerValue(attrs.REVOKEACCESSJSON)
+		state.DeleteAccountJson = customtypes.NewJSONStringPointerValue(attrs.DELETEACCOUNTJSON)
+		state.EnableAccountJson = customtypes.NewJSONStringPointerValue(attrs.ENABLEACCOUNTJSON)
+		state.DisableAccountJson = customtypes.NewJSONStringPointerValue(attrs.DISABLEACCOUNTJSON)
+		state.AccountExistsJson = customtypes.NewJSONStringPointerValue(attrs.ACCOUNTEXISTSJSON)
+		state.UpdateUserJson = customtypes.NewJSONStringPointerValue(attrs.UPDATEUSERJSON)
+		state.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(attrs.MODIFYUSERDATAJSON)
 
 		// Import configurations
 		state.AccountsImport = util.SafeStringDatasource(attrs.ACCOUNTSIMPORT)
@@ -823,15 +854,16 @@ func (r *DBConnectionResource) UpdateModelFromReadResponse(state *DBConnectorRes
 		state.UserImport = util.SafeStringDatasource(attrs.USERIMPORT)
 
 		// Additional configurations
-		state.StatusThresholdConfig = util.SafeStringDatasource(attrs.STATUS_THRESHOLD_CONFIG)
+		state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(attrs.STATUS_THRESHOLD_CONFIG)
 		state.MaxPaginationSize = util.SafeStringDatasource(attrs.MAX_PAGINATION_SIZE)
-		state.CliCommandJson = util.SafeStringDatasource(attrs.CLI_COMMAND_JSON)
+		state.CliCommandJson = customtypes.NewJSONStringPointerValue(attrs.CLI_COMMAND_JSON)
 
 		// Entitlement management (TER-176)
-		state.CreateEntitlementJson = util.SafeStringDatasource(attrs.CREATEENTITLEMENTJSON)
-		state.DeleteEntitlementJson = util.SafeStringDatasource(attrs.DELETEENTITLEMENTJSON)
-		state.EntitlementExistJson = util.SafeStringDatasource(attrs.ENTITLEMENTEXISTJSON)
-		state.UpdateEntitlementJson = util.SafeStringDatasource(attrs.UPDATEENTITLEMENTJSON)
+		state.CreateEntitlementJson = customtypes.NewJSONStringPointerValue(attrs.CREATEENTITLEMENTJSON)
+		state.DeleteEntitlementJson = customtypes.NewJSONStringPointerValue(attrs.DELETEENTITLEMENTJSON)
+		state.EntitlementExistJson = customtypes.NewJSONStringPointerValue(attrs.ENTITLEMENTEXISTJSON)
+		state.UpdateEntitlementJson = customtypes.NewJSONStringPointerValue(attrs.UPDATEENTITLEMENTJSON)
+		state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, attrs.ConnectionTimeoutConfig)
 	}
 }
 
@@ -914,8 +946,14 @@ func (r *DBConnectionResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateDBConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateDBConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "DB connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -936,7 +974,9 @@ func (r *DBConnectionResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -988,7 +1028,7 @@ func (r *DBConnectionResource) UpdateDBConnection(ctx context.Context, plan *DBC
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_db_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, dbConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, dbConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
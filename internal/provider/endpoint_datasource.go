@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
 
@@ -74,6 +75,7 @@ type EndpointsDataSourceModel struct {
 	Owner          types.String `tfsdk:"owner"`
 	FilterCriteria types.Map    `tfsdk:"filter_criteria"`
 	Max            types.String `tfsdk:"max"`
+	FetchAll       types.Bool   `tfsdk:"fetch_all"`
 }
 
 type Endpoint struct {
@@ -122,7 +124,7 @@ type Endpoint struct {
 	ServiceAccountAccessQuery           types.String `tfsdk:"service_account_access_query"`
 	UserAccountCorrelationRule          types.String `tfsdk:"user_account_correlation_rule"`
 	StatusConfig                        types.String `tfsdk:"status_config"`
-	CustomPropertyModel
+	CustomProperties                    types.Map    `tfsdk:"custom_properties"`
 	AccountCustomPropertyLabelModel
 	CustomPropertyLabelModel
 }
@@ -315,13 +317,7 @@ func ResultSchema() map[string]schema.Attribute {
 		},
 	}
 
-	for i := 1; i <= 45; i++ {
-		key := fmt.Sprintf("custom_property%d", i)
-		attrs[key] = schema.StringAttribute{
-			Computed:    true,
-			Description: fmt.Sprintf("Custom property %d value for the endpoint.", i),
-		}
-	}
+	attrs["custom_properties"] = CustomPropertiesDataSourceSchema()
 
 	for i := 1; i <= 30; i++ {
 		key := fmt.Sprintf("account_custom_property_%d_label", i)
@@ -380,6 +376,12 @@ func (d *endpointsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			"max": schema.StringAttribute{
 				Optional: true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Optional: true,
+				Description: "Read every endpoint that matches the filters. The endpoints API cannot skip records, so after the first " +
+					"request of 'max' endpoints (default 100) a second request asks for the total reported by Saviynt, and the read fails " +
+					"when fewer are returned. 'total_count' holds the total and 'display_count' the number of records returned.",
+			},
 			"message": schema.StringAttribute{
 				Computed:    true,
 				Description: "API response message",
@@ -453,7 +455,13 @@ func (d *endpointsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Execute API call to get endpoints details
-	apiResp, err := d.ReadEndpointsDetails(ctx, &state)
+	var apiResp *openapi.GetEndpoints200Response
+	var err error
+	if fetchAllEnabled(state.FetchAll) {
+		apiResp, err = d.ReadAllEndpoints(ctx, &state)
+	} else {
+		apiResp, err = d.ReadEndpointsDetails(ctx, &state)
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to read endpoints details", map[string]interface{}{"error": err.Error()})
 		resp.Diagnostics.AddError(
@@ -486,6 +494,12 @@ func (d *endpointsDataSource) Read(ctx context.Context, req datasource.ReadReque
 // ReadEndpointsDetails retrieves endpoints details from Saviynt API
 // Handles comprehensive filtering using factory pattern with standardized error handling
 func (d *endpointsDataSource) ReadEndpointsDetails(ctx context.Context, state *EndpointsDataSourceModel) (*openapi.GetEndpoints200Response, error) {
+	// Build request with filters
+	return d.ReadEndpointsWithRequest(ctx, d.BuildEndpointsRequest(ctx, state))
+}
+
+// ReadEndpointsWithRequest executes a get endpoints request
+func (d *endpointsDataSource) ReadEndpointsWithRequest(ctx context.Context, req openapi.GetEndpointsRequest) (*openapi.GetEndpoints200Response, error) {
 	tflog.Debug(ctx, "Starting endpoints API call")
 
 	var apiResp *openapi.GetEndpoints200Response
@@ -495,9 +509,6 @@ func (d *endpointsDataSource) ReadEndpointsDetails(ctx context.Context, state *E
 	err := d.provider.AuthenticatedAPICallWithRetry(ctx, "read_endpoints_datasource", func(token string) error {
 		endpointOps := d.endpointFactory.CreateEndpointOperations(d.client.APIBaseURL(), token)
 
-		// Build request with filters
-		req := d.BuildEndpointsRequest(ctx, state)
-
 		resp, httpResp, err := endpointOps.GetEndpoints(ctx, req)
 		finalHttpResp = httpResp
 		if httpResp != nil && httpResp.StatusCode == 401 {
@@ -528,6 +539,40 @@ func (d *endpointsDataSource) ReadEndpointsDetails(ctx context.Context, state *E
 	return apiResp, nil
 }
 
+// ReadAllEndpoints reads every endpoint matching the filters of state. The get endpoints API has
+// max but no offset, so the first request learns totalCount and a second one asks for all of them.
+func (d *endpointsDataSource) ReadAllEndpoints(ctx context.Context, state *EndpointsDataSourceModel) (*openapi.GetEndpoints200Response, error) {
+	pageSize, err := fetchAllPageSize(state.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	req := d.BuildEndpointsRequest(ctx, state)
+	req.SetMax(strconv.Itoa(pageSize))
+	allResp, err := d.ReadEndpointsWithRequest(ctx, req)
+	if err != nil || allResp == nil {
+		return allResp, err
+	}
+	if allResp.TotalCount == nil {
+		return nil, fmt.Errorf("the endpoints API returned no totalCount, so it is unknown whether every endpoint was read")
+	}
+	total := int(*allResp.TotalCount)
+	if len(allResp.Endpoints) < total {
+		req.SetMax(strconv.Itoa(total))
+		allResp, err = d.ReadEndpointsWithRequest(ctx, req)
+		if err != nil || allResp == nil {
+			return allResp, err
+		}
+	}
+	if len(allResp.Endpoints) < total {
+		return nil, fmt.Errorf("the endpoints API returned %d of %d endpoints with max set to %d", len(allResp.Endpoints), total, total)
+	}
+	allResp.SetDisplayCount(int32(len(allResp.Endpoints)))
+
+	tflog.Debug(ctx, "Read all endpoints", map[string]interface{}{"count": len(allResp.Endpoints), "total": total})
+	return allResp, nil
+}
+
 // BuildEndpointsRequest constructs the API request with all filters from state
 func (d *endpointsDataSource) BuildEndpointsRequest(ctx context.Context, state *EndpointsDataSourceModel) openapi.GetEndpointsRequest {
 	req := openapi.GetEndpointsRequest{}
@@ -688,53 +733,7 @@ func (d *endpointsDataSource) MapEndpointsData(state *EndpointsDataSourceModel,
 
 // MapCustomProperties maps custom properties and labels from API response to endpoint state
 func (d *endpointsDataSource) MapCustomProperties(endpointState *Endpoint, item *openapi.GetEndpoints200ResponseEndpointsInner) {
-	endpointState.CustomPropertyModel = CustomPropertyModel{
-		CustomProperty1:  util.SafeString(item.CustomProperty1),
-		CustomProperty2:  util.SafeString(item.CustomProperty2),
-		CustomProperty3:  util.SafeString(item.CustomProperty3),
-		CustomProperty4:  util.SafeString(item.CustomProperty4),
-		CustomProperty5:  util.SafeString(item.CustomProperty5),
-		CustomProperty6:  util.SafeString(item.CustomProperty6),
-		CustomProperty7:  util.SafeString(item.CustomProperty7),
-		CustomProperty8:  util.SafeString(item.CustomProperty8),
-		CustomProperty9:  util.SafeString(item.CustomProperty9),
-		CustomProperty10: util.SafeString(item.CustomProperty10),
-		CustomProperty11: util.SafeString(item.CustomProperty11),
-		CustomProperty12: util.SafeString(item.CustomProperty12),
-		CustomProperty13: util.SafeString(item.CustomProperty13),
-		CustomProperty14: util.SafeString(item.CustomProperty14),
-		CustomProperty15: util.SafeString(item.CustomProperty15),
-		CustomProperty16: util.SafeString(item.CustomProperty16),
-		CustomProperty17: util.SafeString(item.CustomProperty17),
-		CustomProperty18: util.SafeString(item.CustomProperty18),
-		CustomProperty19: util.SafeString(item.CustomProperty19),
-		CustomProperty20: util.SafeString(item.CustomProperty20),
-		CustomProperty21: util.SafeString(item.CustomProperty21),
-		CustomProperty22: util.SafeString(item.CustomProperty22),
-		CustomProperty23: util.SafeString(item.CustomProperty23),
-		CustomProperty24: util.SafeString(item.CustomProperty24),
-		CustomProperty25: util.SafeString(item.CustomProperty25),
-		CustomProperty26: util.SafeString(item.CustomProperty26),
-		CustomProperty27: util.SafeString(item.CustomProperty27),
-		CustomProperty28: util.SafeString(item.CustomProperty28),
-		CustomProperty29: util.SafeString(item.CustomProperty29),
-		CustomProperty30: util.SafeString(item.CustomProperty30),
-		CustomProperty31: util.SafeString(item.Customproperty31),
-		CustomProperty32: util.SafeString(item.Customproperty32),
-		CustomProperty33: util.SafeString(item.Customproperty33),
-		CustomProperty34: util.SafeString(item.Customproperty34),
-		CustomProperty35: util.SafeString(item.Customproperty35),
-		CustomProperty36: util.SafeString(item.Customproperty36),
-		CustomProperty37: util.SafeString(item.Customproperty37),
-		CustomProperty38: util.SafeString(item.Customproperty38),
-		CustomProperty39: util.SafeString(item.Customproperty39),
-		CustomProperty40: util.SafeString(item.Customproperty40),
-		CustomProperty41: util.SafeString(item.Customproperty41),
-		CustomProperty42: util.SafeString(item.Customproperty42),
-		CustomProperty43: util.SafeString(item.Customproperty43),
-		CustomProperty44: util.SafeString(item.Customproperty44),
-		CustomProperty45: util.SafeString(item.Customproperty45),
-	}
+	endpointState.CustomProperties, _ = CustomPropertiesDataSourceValue(context.Background(), CustomPropertyFields(item, endpointCustomPropertyCount))
 
 	endpointState.AccountCustomPropertyLabelModel = AccountCustomPropertyLabelModel{
 		AccountCustomProperty1Label:  util.SafeString(item.AccountCustomProperty1Label),
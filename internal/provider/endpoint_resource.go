@@ -15,9 +15,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
 	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/endpointsutil"
@@ -35,85 +35,41 @@ import (
 )
 
 type EndpointResourceModel struct {
-	ID                                      types.String `tfsdk:"id"`
-	EndpointName                            types.String `tfsdk:"endpoint_name"`
-	DisplayName                             types.String `tfsdk:"display_name"`
-	SecuritySystem                          types.String `tfsdk:"security_system"`
-	Description                             types.String `tfsdk:"description"`
-	OwnerType                               types.String `tfsdk:"owner_type"`
-	Owner                                   types.String `tfsdk:"owner"`
-	ResourceOwnerType                       types.String `tfsdk:"resource_owner_type"`
-	ResourceOwner                           types.String `tfsdk:"resource_owner"`
-	AccessQuery                             types.String `tfsdk:"access_query"`
-	EnableCopyAccess                        types.String `tfsdk:"enable_copy_access"`
-	CreateEntTaskforRemoveAcc               types.String `tfsdk:"create_ent_task_for_remove_acc"`
-	DisableNewAccountRequestIfAccountExists types.String `tfsdk:"disable_new_account_request_if_account_exists"`
-	DisableRemoveAccount                    types.String `tfsdk:"disable_remove_account"`
-	DisableModifyAccount                    types.String `tfsdk:"disable_modify_account"`
-	OutOfBandAction                         types.String `tfsdk:"out_of_band_action"`
-	UserAccountCorrelationRule              types.String `tfsdk:"user_account_correlation_rule"`
-	ConnectionConfig                        types.String `tfsdk:"connection_config"`
-	Requestable                             types.Bool   `tfsdk:"requestable"`
-	ParentAccountPattern                    types.String `tfsdk:"parent_account_pattern"`
-	ServiceAccountNameRule                  types.String `tfsdk:"service_account_name_rule"`
-	ServiceAccountAccessQuery               types.String `tfsdk:"service_account_access_query"`
-	ChangePasswordAccessQuery               types.String `tfsdk:"change_password_access_query"`
-	BlockInflightRequest                    types.String `tfsdk:"block_inflight_request"`
-	AccountNameRule                         types.String `tfsdk:"account_name_rule"`
-	AllowChangePasswordSQLQuery             types.String `tfsdk:"allow_change_password_sql_query"`
-	AccountNameValidatorRegex               types.String `tfsdk:"account_name_validator_regex"`
-	StatusConfig                            types.String `tfsdk:"status_config"`
-	PluginConfigs                           types.String `tfsdk:"plugin_configs"`
-	PrimaryAccountType                      types.String `tfsdk:"primary_account_type"`
-	AccountTypeNoPasswordChange             types.String `tfsdk:"account_type_no_password_change"`
-	EndpointConfig                          types.String `tfsdk:"endpoint_config"`
-	AllowRemoveAllRoleOnRequest             types.Bool   `tfsdk:"allow_remove_all_role_on_request"`
-
-	CustomProperty1              types.String `tfsdk:"custom_property1"`
-	CustomProperty2              types.String `tfsdk:"custom_property2"`
-	CustomProperty3              types.String `tfsdk:"custom_property3"`
-	CustomProperty4              types.String `tfsdk:"custom_property4"`
-	CustomProperty5              types.String `tfsdk:"custom_property5"`
-	CustomProperty6              types.String `tfsdk:"custom_property6"`
-	CustomProperty7              types.String `tfsdk:"custom_property7"`
-	CustomProperty8              types.String `tfsdk:"custom_property8"`
-	CustomProperty9              types.String `tfsdk:"custom_property9"`
-	CustomProperty10             types.String `tfsdk:"custom_property10"`
-	CustomProperty11             types.String `tfsdk:"custom_property11"`
-	CustomProperty12             types.String `tfsdk:"custom_property12"`
-	CustomProperty13             types.String `tfsdk:"custom_property13"`
-	CustomProperty14             types.String `tfsdk:"custom_property14"`
-	CustomProperty15             types.String `tfsdk:"custom_property15"`
-	CustomProperty16             types.String `tfsdk:"custom_property16"`
-	CustomProperty17             types.String `tfsdk:"custom_property17"`
-	CustomProperty18             types.String `tfsdk:"custom_property18"`
-	CustomProperty19             types.String `tfsdk:"custom_property19"`
-	CustomProperty20             types.String `tfsdk:"custom_property20"`
-	CustomProperty21             types.String `tfsdk:"custom_property21"`
-	CustomProperty22             types.String `tfsdk:"custom_property22"`
-	CustomProperty23             types.String `tfsdk:"custom_property23"`
-	CustomProperty24             types.String `tfsdk:"custom_property24"`
-	CustomProperty25             types.String `tfsdk:"custom_property25"`
-	CustomProperty26             types.String `tfsdk:"custom_property26"`
-	CustomProperty27             types.String `tfsdk:"custom_property27"`
-	CustomProperty28             types.String `tfsdk:"custom_property28"`
-	CustomProperty29             types.String `tfsdk:"custom_property29"`
-	CustomProperty30             types.String `tfsdk:"custom_property30"`
-	CustomProperty31             types.String `tfsdk:"custom_property31"`
-	CustomProperty32             types.String `tfsdk:"custom_property32"`
-	CustomProperty33             types.String `tfsdk:"custom_property33"`
-	CustomProperty34             types.String `tfsdk:"custom_property34"`
-	CustomProperty35             types.String `tfsdk:"custom_property35"`
-	CustomProperty36             types.String `tfsdk:"custom_property36"`
-	CustomProperty37             types.String `tfsdk:"custom_property37"`
-	CustomProperty38             types.String `tfsdk:"custom_property38"`
-	CustomProperty39             types.String `tfsdk:"custom_property39"`
-	CustomProperty40             types.String `tfsdk:"custom_property40"`
-	CustomProperty41             types.String `tfsdk:"custom_property41"`
-	CustomProperty42             types.String `tfsdk:"custom_property42"`
-	CustomProperty43             types.String `tfsdk:"custom_property43"`
-	CustomProperty44             types.String `tfsdk:"custom_property44"`
-	CustomProperty45             types.String `tfsdk:"custom_property45"`
+	ID                                      types.String           `tfsdk:"id"`
+	EndpointName                            types.String           `tfsdk:"endpoint_name"`
+	DisplayName                             types.String           `tfsdk:"display_name"`
+	SecuritySystem                          types.String           `tfsdk:"security_system"`
+	Description                             types.String           `tfsdk:"description"`
+	OwnerType                               types.String           `tfsdk:"owner_type"`
+	Owner                                   types.String           `tfsdk:"owner"`
+	ResourceOwnerType                       types.String           `tfsdk:"resource_owner_type"`
+	ResourceOwner                           types.String           `tfsdk:"resource_owner"`
+	AccessQuery                             types.String           `tfsdk:"access_query"`
+	EnableCopyAccess                        types.String           `tfsdk:"enable_copy_access"`
+	CreateEntTaskforRemoveAcc               types.String           `tfsdk:"create_ent_task_for_remove_acc"`
+	DisableNewAccountRequestIfAccountExists types.String           `tfsdk:"disable_new_account_request_if_account_exists"`
+	DisableRemoveAccount                    types.String           `tfsdk:"disable_remove_account"`
+	DisableModifyAccount                    types.String           `tfsdk:"disable_modify_account"`
+	OutOfBandAction                         types.String           `tfsdk:"out_of_band_action"`
+	UserAccountCorrelationRule              types.String           `tfsdk:"user_account_correlation_rule"`
+	ConnectionConfig                        customtypes.JSONString `tfsdk:"connection_config"`
+	Requestable                             types.Bool             `tfsdk:"requestable"`
+	ParentAccountPattern                    types.String           `tfsdk:"parent_account_pattern"`
+	ServiceAccountNameRule                  types.String           `tfsdk:"service_account_name_rule"`
+	ServiceAccountAccessQuery               types.String           `tfsdk:"service_account_access_query"`
+	ChangePasswordAccessQuery               types.String           `tfsdk:"change_password_access_query"`
+	BlockInflightRequest                    types.String           `tfsdk:"block_inflight_request"`
+	AccountNameRule                         types.String           `tfsdk:"account_name_rule"`
+	AllowChangePasswordSQLQuery             types.String           `tfsdk:"allow_change_password_sql_query"`
+	AccountNameValidatorRegex               types.String           `tfsdk:"account_name_validator_regex"`
+	StatusConfig                            customtypes.JSONString `tfsdk:"status_config"`
+	PluginConfigs                           customtypes.JSONString `tfsdk:"plugin_configs"`
+	PrimaryAccountType                      types.String           `tfsdk:"primary_account_type"`
+	AccountTypeNoPasswordChange             types.String           `tfsdk:"account_type_no_password_change"`
+	EndpointConfig                          customtypes.JSONString `tfsdk:"endpoint_config"`
+	AllowRemoveAllRoleOnRequest             types.Bool             `tfsdk:"allow_remove_all_role_on_request"`
+
+	CustomProperties             types.Map    `tfsdk:"custom_properties"`
 	AccountCustomProperty1Label  types.String `tfsdk:"account_custom_property_1_label"`
 	AccountCustomProperty2Label  types.String `tfsdk:"account_custom_property_2_label"`
 	AccountCustomProperty3Label  types.String `tfsdk:"account_custom_property_3_label"`
@@ -179,6 +135,7 @@ type EndpointResourceModel struct {
 	RequestableRoleTypes         types.List   `tfsdk:"requestable_role_types"`
 	Msg                          types.String `tfsdk:"msg"`
 	ErrorCode                    types.String `tfsdk:"error_code"`
+	DeletionPolicy               types.String `tfsdk:"deletion_policy"`
 }
 
 type EndpointResource struct {
@@ -210,6 +167,11 @@ type MappedEndpoint struct {
 	Operation      types.String `tfsdk:"operation"`
 }
 
+var _ resource.ResourceWithUpgradeState = &EndpointResource{}
+
+// endpointCustomPropertyCount is the number of custom properties of an endpoint
+const endpointCustomPropertyCount = 45
+
 func NewEndpointResource() resource.Resource {
 	return &EndpointResource{
 		endpointFactory: &client.DefaultEndpointFactory{},
@@ -229,6 +191,7 @@ func (r *EndpointResource) Metadata(ctx context.Context, req resource.MetadataRe
 func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: util.EndpointDescription,
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -309,6 +272,7 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Use this parameter to determine if you need to remove the accesses which were granted outside Saviynt.",
 			},
 			"connection_config": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Use this configuration for processing the add access tasks and remove access tasks for AD and LDAP Connectors.",
@@ -354,16 +318,19 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Specify the regular expression which will be used to validate the account name either generated by the rule or provided manually.",
 			},
 			"status_config": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Enable the State and Status options (Enable, Disable, Lock, Unlock) that would be available to request for a user and service accounts.",
 			},
 			"plugin_configs": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "The Plugin Configuration drives the functionality of the Saviynt SmartAssist (Browserplugin).",
 			},
 			"endpoint_config": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Option to copy data in Step 3 of the service account request will be enabled.",
@@ -426,17 +393,7 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 		},
 	}
 
-	for i := 1; i <= 45; i++ {
-		key := fmt.Sprintf("custom_property%d", i)
-		resp.Schema.Attributes[key] = schema.StringAttribute{
-			Optional:    true,
-			Computed:    true,
-			Description: fmt.Sprintf("Custom Property %d.", i),
-			Validators: []validator.String{
-				validators.NoWhitespaceOnly(),
-			},
-		}
-	}
+	resp.Schema.Attributes["custom_properties"] = CustomPropertiesSchema(endpointCustomPropertyCount)
 
 	for i := 1; i <= 30; i++ {
 		key := fmt.Sprintf("account_custom_property_%d_label", i)
@@ -473,6 +430,19 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 		Computed:    true,
 		Description: "Specify query to restrict the access for changing the account password of the endpoint.",
 	}
+
+	// The update endpoint API documents no status field, so endpoints cannot be deactivated
+	resp.Schema.Attributes["deletion_policy"] = DeletionPolicySchema("")
+}
+
+// UpgradeState moves the custom_property1 to custom_property45 attributes of schema version 0 into
+// custom_properties.
+func (r *EndpointResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return map[int64]resource.StateUpgrader{
+		0: CustomPropertiesStateUpgrader(schemaResp.Schema, "custom_property%d", endpointCustomPropertyCount),
+	}
 }
 
 func (r *EndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -593,6 +563,14 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Clear the custom properties that were removed from custom_properties
+	customProperties, diags := CustomPropertyChanges(ctx, plan.CustomProperties, state.CustomProperties, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	SetCustomPropertyFields(&updateReq, customProperties)
+
 	// Handle mapped endpoints with proper diagnostics
 	mappedEndpoints, diags := r.BuildMappedEndpointsForUpdateWithDiags(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -657,21 +635,33 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// Saviynt does not support deleting endpoints. Delete follows the deletion_policy of the endpoint.
 func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// resp.State.RemoveResource(ctx)
-	if os.Getenv("TF_ACC") == "1" {
-		resp.State.RemoveResource(ctx)
+	var state EndpointResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		log.Printf("[ERROR]: Failed to get state in Delete Block: %v", resp.Diagnostics)
 		return
 	}
-	resp.Diagnostics.AddError(
-		"Delete Not Supported",
-		"Resource deletion is not supported by this provider. Please remove the resource manually if required, or contact your administrator.",
-	)
+
+	endpointName := state.EndpointName.ValueString()
+	switch ResolveDeletionPolicy(state.DeletionPolicy) {
+	case DeletionPolicyAbandon:
+		log.Printf("[INFO]: Abandoning endpoint %s, removing it from state only", endpointName)
+		resp.State.RemoveResource(ctx)
+	default:
+		resp.Diagnostics.AddError(
+			"Delete Not Supported",
+			fmt.Sprintf("Endpoint %s cannot be deleted in Saviynt. Set deletion_policy to \"abandon\" to remove it from state only, "+
+				"and apply before destroying it.", endpointName),
+		)
+	}
 }
 
 func (r *EndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("endpoint_name"), req, resp)
+	resp.State.SetAttribute(ctx, path.Root("deletion_policy"), DeletionPolicyError)
 }
 
 // BuildEndpointRequest builds either CREATE or UPDATE request based on isCreate parameter
@@ -698,7 +688,7 @@ func (r *EndpointResource) BuildEndpointRequest(ctx context.Context, plan *Endpo
 			UserAccountCorrelationRule:              util.StringPointerOrEmpty(plan.UserAccountCorrelationRule),
 			CreateEntTaskforRemoveAcc:               util.StringPointerOrEmpty(plan.CreateEntTaskforRemoveAcc),
 			Outofbandaction:                         util.StringPointerOrEmpty(plan.OutOfBandAction),
-			Connectionconfig:                        util.StringPointerOrEmpty(plan.ConnectionConfig),
+			Connectionconfig:                        util.StringPointerOrEmpty(plan.ConnectionConfig.StringValue),
 			ParentAccountPattern:                    util.StringPointerOrEmpty(plan.ParentAccountPattern),
 			ServiceAccountNameRule:                  util.StringPointerOrEmpty(plan.ServiceAccountNameRule),
 			ServiceAccountAccessQuery:               util.StringPointerOrEmpty(plan.ServiceAccountAccessQuery),
@@ -709,9 +699,9 @@ func (r *EndpointResource) BuildEndpointRequest(ctx context.Context, plan *Endpo
 			PrimaryAccountType:                      util.StringPointerOrEmpty(plan.PrimaryAccountType),
 			AccountTypeNoPasswordChange:             util.StringPointerOrEmpty(plan.AccountTypeNoPasswordChange),
 			ChangePasswordAccessQuery:               util.StringPointerOrEmpty(plan.ChangePasswordAccessQuery),
-			StatusConfig:                            util.StringPointerOrEmpty(plan.StatusConfig),
-			PluginConfigs:                           util.StringPointerOrEmpty(plan.PluginConfigs),
-			EndpointConfig:                          util.StringPointerOrEmpty(plan.EndpointConfig),
+			StatusConfig:                            util.StringPointerOrEmpty(plan.StatusConfig.StringValue),
+			PluginConfigs:                           util.StringPointerOrEmpty(plan.PluginConfigs.StringValue),
+			EndpointConfig:                          util.StringPointerOrEmpty(plan.EndpointConfig.StringValue),
 
 			// Boolean fields - CREATE uses util.BoolPointerOrEmpty()
 			Requestable:                 util.BoolPointerOrEmpty(plan.Requestable),
@@ -719,7 +709,7 @@ func (r *EndpointResource) BuildEndpointRequest(ctx context.Context, plan *Endpo
 		}
 
 		// Add custom properties and labels for CREATE
-		r.SetCustomProperties(&createReq, nil, plan, isCreate)
+		r.SetCustomProperties(ctx, &createReq, nil, plan, isCreate, diagnostics)
 		r.SetCustomPropertyLabels(&createReq, nil, plan, isCreate)
 
 		// Add email templates (unified logic)
@@ -771,7 +761,7 @@ func (r *EndpointResource) BuildEndpointRequest(ctx context.Context, plan *Endpo
 		}
 
 		// Add custom properties and labels for UPDATE
-		r.SetCustomProperties(nil, &updateReq, plan, isCreate)
+		r.SetCustomProperties(ctx, nil, &updateReq, plan, isCreate, diagnostics)
 		r.SetCustomPropertyLabels(nil, &updateReq, plan, isCreate)
 
 		// Add email templates (unified logic)
@@ -784,101 +774,14 @@ func (r *EndpointResource) BuildEndpointRequest(ctx context.Context, plan *Endpo
 	}
 }
 
-// SetCustomProperties sets custom properties 1-45 in either CREATE or UPDATE request
-func (r *EndpointResource) SetCustomProperties(createReq *openapi.CreateEndpointRequest, updateReq *openapi.UpdateEndpointRequest, plan *EndpointResourceModel, isCreate bool) {
-	// Custom properties use the same method (util.StringPointerOrEmpty) for both CREATE and UPDATE
+// SetCustomProperties sets the configured custom properties in either CREATE or UPDATE request
+func (r *EndpointResource) SetCustomProperties(ctx context.Context, createReq *openapi.CreateEndpointRequest, updateReq *openapi.UpdateEndpointRequest, plan *EndpointResourceModel, isCreate bool, diagnostics *diag.Diagnostics) {
+	customProperties, diags := CustomPropertyValues(ctx, plan.CustomProperties, nil)
+	diagnostics.Append(diags...)
 	if isCreate {
-		createReq.Customproperty1 = util.StringPointerOrEmpty(plan.CustomProperty1)
-		createReq.Customproperty2 = util.StringPointerOrEmpty(plan.CustomProperty2)
-		createReq.Customproperty3 = util.StringPointerOrEmpty(plan.CustomProperty3)
-		createReq.Customproperty4 = util.StringPointerOrEmpty(plan.CustomProperty4)
-		createReq.Customproperty5 = util.StringPointerOrEmpty(plan.CustomProperty5)
-		createReq.Customproperty6 = util.StringPointerOrEmpty(plan.CustomProperty6)
-		createReq.Customproperty7 = util.StringPointerOrEmpty(plan.CustomProperty7)
-		createReq.Customproperty8 = util.StringPointerOrEmpty(plan.CustomProperty8)
-		createReq.Customproperty9 = util.StringPointerOrEmpty(plan.CustomProperty9)
-		createReq.Customproperty10 = util.StringPointerOrEmpty(plan.CustomProperty10)
-		createReq.Customproperty11 = util.StringPointerOrEmpty(plan.CustomProperty11)
-		createReq.Customproperty12 = util.StringPointerOrEmpty(plan.CustomProperty12)
-		createReq.Customproperty13 = util.StringPointerOrEmpty(plan.CustomProperty13)
-		createReq.Customproperty14 = util.StringPointerOrEmpty(plan.CustomProperty14)
-		createReq.Customproperty15 = util.StringPointerOrEmpty(plan.CustomProperty15)
-		createReq.Customproperty16 = util.StringPointerOrEmpty(plan.CustomProperty16)
-		createReq.Customproperty17 = util.StringPointerOrEmpty(plan.CustomProperty17)
-		createReq.Customproperty18 = util.StringPointerOrEmpty(plan.CustomProperty18)
-		createReq.Customproperty19 = util.StringPointerOrEmpty(plan.CustomProperty19)
-		createReq.Customproperty20 = util.StringPointerOrEmpty(plan.CustomProperty20)
-		createReq.Customproperty21 = util.StringPointerOrEmpty(plan.CustomProperty21)
-		createReq.Customproperty22 = util.StringPointerOrEmpty(plan.CustomProperty22)
-		createReq.Customproperty23 = util.StringPointerOrEmpty(plan.CustomProperty23)
-		createReq.Customproperty24 = util.StringPointerOrEmpty(plan.CustomProperty24)
-		createReq.Customproperty25 = util.StringPointerOrEmpty(plan.CustomProperty25)
-		createReq.Customproperty26 = util.StringPointerOrEmpty(plan.CustomProperty26)
-		createReq.Customproperty27 = util.StringPointerOrEmpty(plan.CustomProperty27)
-		createReq.Customproperty28 = util.StringPointerOrEmpty(plan.CustomProperty28)
-		createReq.Customproperty29 = util.StringPointerOrEmpty(plan.CustomProperty29)
-		createReq.Customproperty30 = util.StringPointerOrEmpty(plan.CustomProperty30)
-		createReq.Customproperty31 = util.StringPointerOrEmpty(plan.CustomProperty31)
-		createReq.Customproperty32 = util.StringPointerOrEmpty(plan.CustomProperty32)
-		createReq.Customproperty33 = util.StringPointerOrEmpty(plan.CustomProperty33)
-		createReq.Customproperty34 = util.StringPointerOrEmpty(plan.CustomProperty34)
-		createReq.Customproperty35 = util.StringPointerOrEmpty(plan.CustomProperty35)
-		createReq.Customproperty36 = util.StringPointerOrEmpty(plan.CustomProperty36)
-		createReq.Customproperty37 = util.StringPointerOrEmpty(plan.CustomProperty37)
-		createReq.Customproperty38 = util.StringPointerOrEmpty(plan.CustomProperty38)
-		createReq.Customproperty39 = util.StringPointerOrEmpty(plan.CustomProperty39)
-		createReq.Customproperty40 = util.StringPointerOrEmpty(plan.CustomProperty40)
-		createReq.Customproperty41 = util.StringPointerOrEmpty(plan.CustomProperty41)
-		createReq.Customproperty42 = util.StringPointerOrEmpty(plan.CustomProperty42)
-		createReq.Customproperty43 = util.StringPointerOrEmpty(plan.CustomProperty43)
-		createReq.Customproperty44 = util.StringPointerOrEmpty(plan.CustomProperty44)
-		createReq.Customproperty45 = util.StringPointerOrEmpty(plan.CustomProperty45)
+		SetCustomPropertyFields(createReq, customProperties)
 	} else {
-		updateReq.Customproperty1 = util.StringPointerOrEmpty(plan.CustomProperty1)
-		updateReq.Customproperty2 = util.StringPointerOrEmpty(plan.CustomProperty2)
-		updateReq.Customproperty3 = util.StringPointerOrEmpty(plan.CustomProperty3)
-		updateReq.Customproperty4 = util.StringPointerOrEmpty(plan.CustomProperty4)
-		updateReq.Customproperty5 = util.StringPointerOrEmpty(plan.CustomProperty5)
-		updateReq.Customproperty6 = util.StringPointerOrEmpty(plan.CustomProperty6)
-		updateReq.Customproperty7 = util.StringPointerOrEmpty(plan.CustomProperty7)
-		updateReq.Customproperty8 = util.StringPointerOrEmpty(plan.CustomProperty8)
-		updateReq.Customproperty9 = util.StringPointerOrEmpty(plan.CustomProperty9)
-		updateReq.Customproperty10 = util.StringPointerOrEmpty(plan.CustomProperty10)
-		updateReq.Customproperty11 = util.StringPointerOrEmpty(plan.CustomProperty11)
-		updateReq.Customproperty12 = util.StringPointerOrEmpty(plan.CustomProperty12)
-		updateReq.Customproperty13 = util.StringPointerOrEmpty(plan.CustomProperty13)
-		updateReq.Customproperty14 = util.StringPointerOrEmpty(plan.CustomProperty14)
-		updateReq.Customproperty15 = util.StringPointerOrEmpty(plan.CustomProperty15)
-		updateReq.Customproperty16 = util.StringPointerOrEmpty(plan.CustomProperty16)
-		updateReq.Customproperty17 = util.StringPointerOrEmpty(plan.CustomProperty17)
-		updateReq.Customproperty18 = util.StringPointerOrEmpty(plan.CustomProperty18)
-		updateReq.Customproperty19 = util.StringPointerOrEmpty(plan.CustomProperty19)
-		updateReq.Customproperty20 = util.StringPointerOrEmpty(plan.CustomProperty20)
-		updateReq.Customproperty21 = util.StringPointerOrEmpty(plan.CustomProperty21)
-		updateReq.Customproperty22 = util.StringPointerOrEmpty(plan.CustomProperty22)
-		updateReq.Customproperty23 = util.StringPointerOrEmpty(plan.CustomProperty23)
-		updateReq.Customproperty24 = util.StringPointerOrEmpty(plan.CustomProperty24)
-		updateReq.Customproperty25 = util.StringPointerOrEmpty(plan.CustomProperty25)
-		updateReq.Customproperty26 = util.StringPointerOrEmpty(plan.CustomProperty26)
-		updateReq.Customproperty27 = util.StringPointerOrEmpty(plan.CustomProperty27)
-		updateReq.Customproperty28 = util.StringPointerOrEmpty(plan.CustomProperty28)
-		updateReq.Customproperty29 = util.StringPointerOrEmpty(plan.CustomProperty29)
-		updateReq.Customproperty30 = util.StringPointerOrEmpty(plan.CustomProperty30)
-		updateReq.Customproperty31 = util.StringPointerOrEmpty(plan.CustomProperty31)
-		updateReq.Customproperty32 = util.StringPointerOrEmpty(plan.CustomProperty32)
-		updateReq.Customproperty33 = util.StringPointerOrEmpty(plan.CustomProperty33)
-		updateReq.Customproperty34 = util.StringPointerOrEmpty(plan.CustomProperty34)
-		updateReq.Customproperty35 = util.StringPointerOrEmpty(plan.CustomProperty35)
-		updateReq.Customproperty36 = util.StringPointerOrEmpty(plan.CustomProperty36)
-		updateReq.Customproperty37 = util.StringPointerOrEmpty(plan.CustomProperty37)
-		updateReq.Customproperty38 = util.StringPointerOrEmpty(plan.CustomProperty38)
-		updateReq.Customproperty39 = util.StringPointerOrEmpty(plan.CustomProperty39)
-		updateReq.Customproperty40 = util.StringPointerOrEmpty(plan.CustomProperty40)
-		updateReq.Customproperty41 = util.StringPointerOrEmpty(plan.CustomProperty41)
-		updateReq.Customproperty42 = util.StringPointerOrEmpty(plan.CustomProperty42)
-		updateReq.Customproperty43 = util.StringPointerOrEmpty(plan.CustomProperty43)
-		updateReq.Customproperty44 = util.StringPointerOrEmpty(plan.CustomProperty44)
-		updateReq.Customproperty45 = util.StringPointerOrEmpty(plan.CustomProperty45)
+		SetCustomPropertyFields(updateReq, customProperties)
 	}
 }
 
@@ -1096,23 +999,23 @@ func (r *EndpointResource) SetEndpointFields(target *EndpointResourceModel, read
 	if endpoint.ConnectionconfigAsJson != nil {
 		normalized, err := endpointsutil.NormalizeJSON(*endpoint.ConnectionconfigAsJson)
 		if err != nil {
-			target.ConnectionConfig = types.StringNull()
+			target.ConnectionConfig = customtypes.NewJSONStringNull()
 		} else {
-			target.ConnectionConfig = types.StringValue(normalized)
+			target.ConnectionConfig = customtypes.NewJSONStringValue(normalized)
 		}
 	} else {
-		target.ConnectionConfig = types.StringNull()
+		target.ConnectionConfig = customtypes.NewJSONStringNull()
 	}
 
 	target.AccountNameRule = util.SafeString(endpoint.AccountNameRule)
 	target.ChangePasswordAccessQuery = util.SafeString(endpoint.ChangePasswordAccessQuery)
-	target.PluginConfigs = util.SafeString(endpoint.PluginConfigs)
+	target.PluginConfigs = customtypes.NewJSONStringValue(util.SafeDeref(endpoint.PluginConfigs))
 	target.CreateEntTaskforRemoveAcc = util.SafeString(endpoint.CreateEntTaskforRemoveAcc)
 	target.EnableCopyAccess = util.SafeString(endpoint.EnableCopyAccess)
-	target.EndpointConfig = util.SafeString(endpoint.EndpointConfig)
+	target.EndpointConfig = customtypes.NewJSONStringValue(util.SafeDeref(endpoint.EndpointConfig))
 	target.ServiceAccountAccessQuery = util.SafeString(endpoint.ServiceAccountAccessQuery)
 	target.UserAccountCorrelationRule = util.SafeString(endpoint.UserAccountCorrelationRule)
-	target.StatusConfig = util.SafeString(endpoint.StatusConfig)
+	target.StatusConfig = customtypes.NewJSONStringValue(util.SafeDeref(endpoint.StatusConfig))
 	if endpoint.Disableaccountrequest != nil {
 		disableAccountRequestStr := *endpoint.Disableaccountrequest
 
@@ -1129,7 +1032,7 @@ func (r *EndpointResource) SetEndpointFields(target *EndpointResourceModel, read
 		}
 	}
 	// Set custom properties 1-45
-	r.SetCustomPropertiesFromAPI(target, &endpoint)
+	r.SetCustomPropertiesFromAPI(target, &endpoint, diagnostics)
 
 	// Set custom property labels 1-60
 	r.SetCustomPropertyLabelsFromAPI(target, &endpoint)
@@ -1148,52 +1051,10 @@ func (r *EndpointResource) SetEndpointFields(target *EndpointResourceModel, read
 }
 
 // SetCustomPropertiesFromAPI sets custom properties 1-45 from API response
-func (r *EndpointResource) SetCustomPropertiesFromAPI(target *EndpointResourceModel, apiResponse *openapi.GetEndpoints200ResponseEndpointsInner) {
-	target.CustomProperty1 = util.SafeString(apiResponse.CustomProperty1)
-	target.CustomProperty2 = util.SafeString(apiResponse.CustomProperty2)
-	target.CustomProperty3 = util.SafeString(apiResponse.CustomProperty3)
-	target.CustomProperty4 = util.SafeString(apiResponse.CustomProperty4)
-	target.CustomProperty5 = util.SafeString(apiResponse.CustomProperty5)
-	target.CustomProperty6 = util.SafeString(apiResponse.CustomProperty6)
-	target.CustomProperty7 = util.SafeString(apiResponse.CustomProperty7)
-	target.CustomProperty8 = util.SafeString(apiResponse.CustomProperty8)
-	target.CustomProperty9 = util.SafeString(apiResponse.CustomProperty9)
-	target.CustomProperty10 = util.SafeString(apiResponse.CustomProperty10)
-	target.CustomProperty11 = util.SafeString(apiResponse.CustomProperty11)
-	target.CustomProperty12 = util.SafeString(apiResponse.CustomProperty12)
-	target.CustomProperty13 = util.SafeString(apiResponse.CustomProperty13)
-	target.CustomProperty14 = util.SafeString(apiResponse.CustomProperty14)
-	target.CustomProperty15 = util.SafeString(apiResponse.CustomProperty15)
-	target.CustomProperty16 = util.SafeString(apiResponse.CustomProperty16)
-	target.CustomProperty17 = util.SafeString(apiResponse.CustomProperty17)
-	target.CustomProperty18 = util.SafeString(apiResponse.CustomProperty18)
-	target.CustomProperty19 = util.SafeString(apiResponse.CustomProperty19)
-	target.CustomProperty20 = util.SafeString(apiResponse.CustomProperty20)
-	target.CustomProperty21 = util.SafeString(apiResponse.CustomProperty21)
-	target.CustomProperty22 = util.SafeString(apiResponse.CustomProperty22)
-	target.CustomProperty23 = util.SafeString(apiResponse.CustomProperty23)
-	target.CustomProperty24 = util.SafeString(apiResponse.CustomProperty24)
-	target.CustomProperty25 = util.SafeString(apiResponse.CustomProperty25)
-	target.CustomProperty26 = util.SafeString(apiResponse.CustomProperty26)
-	target.CustomProperty27 = util.SafeString(apiResponse.CustomProperty27)
-	target.CustomProperty28 = util.SafeString(apiResponse.CustomProperty28)
-	target.CustomProperty29 = util.SafeString(apiResponse.CustomProperty29)
-	target.CustomProperty30 = util.SafeString(apiResponse.CustomProperty30)
-	target.CustomProperty31 = util.SafeString(apiResponse.Customproperty31)
-	target.CustomProperty32 = util.SafeString(apiResponse.Customproperty32)
-	target.CustomProperty33 = util.SafeString(apiResponse.Customproperty33)
-	target.CustomProperty34 = util.SafeString(apiResponse.Customproperty34)
-	target.CustomProperty35 = util.SafeString(apiResponse.Customproperty35)
-	target.CustomProperty36 = util.SafeString(apiResponse.Customproperty36)
-	target.CustomProperty37 = util.SafeString(apiResponse.Customproperty37)
-	target.CustomProperty38 = util.SafeString(apiResponse.Customproperty38)
-	target.CustomProperty39 = util.SafeString(apiResponse.Customproperty39)
-	target.CustomProperty40 = util.SafeString(apiResponse.Customproperty40)
-	target.CustomProperty41 = util.SafeString(apiResponse.Customproperty41)
-	target.CustomProperty42 = util.SafeString(apiResponse.Customproperty42)
-	target.CustomProperty43 = util.SafeString(apiResponse.Customproperty43)
-	target.CustomProperty44 = util.SafeString(apiResponse.Customproperty44)
-	target.CustomProperty45 = util.SafeString(apiResponse.Customproperty45)
+func (r *EndpointResource) SetCustomPropertiesFromAPI(target *EndpointResourceModel, apiResponse *openapi.GetEndpoints200ResponseEndpointsInner, diagnostics *diag.Diagnostics) {
+	properties, diags := CustomPropertiesValue(context.Background(), CustomPropertyFields(apiResponse, endpointCustomPropertyCount), target.CustomProperties, nil)
+	diagnostics.Append(diags...)
+	target.CustomProperties = properties
 }
 
 // SetCustomPropertyLabelsFromAPI sets custom property labels 1-60 from API response
@@ -1857,7 +1718,7 @@ func (r *EndpointResource) UpdateModelFromCreateResponse(plan *EndpointResourceM
 	plan.DisableModifyAccount = util.SafeString(plan.DisableModifyAccount.ValueStringPointer())
 	plan.UserAccountCorrelationRule = util.SafeString(plan.UserAccountCorrelationRule.ValueStringPointer())
 	plan.CreateEntTaskforRemoveAcc = util.SafeString(plan.CreateEntTaskforRemoveAcc.ValueStringPointer())
-	plan.ConnectionConfig = util.SafeString(plan.ConnectionConfig.ValueStringPointer())
+	plan.ConnectionConfig = customtypes.NewJSONStringValue(util.SafeDeref(plan.ConnectionConfig.ValueStringPointer()))
 	plan.ParentAccountPattern = util.SafeString(plan.ParentAccountPattern.ValueStringPointer())
 	plan.ServiceAccountNameRule = util.SafeString(plan.ServiceAccountNameRule.ValueStringPointer())
 	plan.ServiceAccountAccessQuery = util.SafeString(plan.ServiceAccountAccessQuery.ValueStringPointer())
@@ -1868,54 +1729,13 @@ func (r *EndpointResource) UpdateModelFromCreateResponse(plan *EndpointResourceM
 	plan.PrimaryAccountType = util.SafeString(plan.PrimaryAccountType.ValueStringPointer())
 	plan.AccountTypeNoPasswordChange = util.SafeString(plan.AccountTypeNoPasswordChange.ValueStringPointer())
 	plan.ChangePasswordAccessQuery = util.SafeString(plan.ChangePasswordAccessQuery.ValueStringPointer())
-	plan.StatusConfig = util.SafeString(plan.StatusConfig.ValueStringPointer())
-	plan.PluginConfigs = util.SafeString(plan.PluginConfigs.ValueStringPointer())
-	plan.EndpointConfig = util.SafeString(plan.EndpointConfig.ValueStringPointer())
-	plan.CustomProperty1 = util.SafeString(plan.CustomProperty1.ValueStringPointer())
-	plan.CustomProperty2 = util.SafeString(plan.CustomProperty2.ValueStringPointer())
-	plan.CustomProperty3 = util.SafeString(plan.CustomProperty3.ValueStringPointer())
-	plan.CustomProperty4 = util.SafeString(plan.CustomProperty4.ValueStringPointer())
-	plan.CustomProperty5 = util.SafeString(plan.CustomProperty5.ValueStringPointer())
-	plan.CustomProperty6 = util.SafeString(plan.CustomProperty6.ValueStringPointer())
-	plan.CustomProperty7 = util.SafeString(plan.CustomProperty7.ValueStringPointer())
-	plan.CustomProperty8 = util.SafeString(plan.CustomProperty8.ValueStringPointer())
-	plan.CustomProperty9 = util.SafeString(plan.CustomProperty9.ValueStringPointer())
-	plan.CustomProperty10 = util.SafeString(plan.CustomProperty10.ValueStringPointer())
-	plan.CustomProperty11 = util.SafeString(plan.CustomProperty11.ValueStringPointer())
-	plan.CustomProperty12 = util.SafeString(plan.CustomProperty12.ValueStringPointer())
-	plan.CustomProperty13 = util.SafeString(plan.CustomProperty13.ValueStringPointer())
-	plan.CustomProperty14 = util.SafeString(plan.CustomProperty14.ValueStringPointer())
-	plan.CustomProperty15 = util.SafeString(plan.CustomProperty15.ValueStringPointer())
-	plan.CustomProperty16 = util.SafeString(plan.CustomProperty16.ValueStringPointer())
-	plan.CustomProperty17 = util.SafeString(plan.CustomProperty17.ValueStringPointer())
-	plan.CustomProperty18 = util.SafeString(plan.CustomProperty18.ValueStringPointer())
-	plan.CustomProperty19 = util.SafeString(plan.CustomProperty19.ValueStringPointer())
-	plan.CustomProperty20 = util.SafeString(plan.CustomProperty20.ValueStringPointer())
-	plan.CustomProperty21 = util.SafeString(plan.CustomProperty21.ValueStringPointer())
-	plan.CustomProperty22 = util.SafeString(plan.CustomProperty22.ValueStringPointer())
-	plan.CustomProperty23 = util.SafeString(plan.CustomProperty23.ValueStringPointer())
-	plan.CustomProperty24 = util.SafeString(plan.CustomProperty24.ValueStringPointer())
-	plan.CustomProperty25 = util.SafeString(plan.CustomProperty25.ValueStringPointer())
-	plan.CustomProperty26 = util.SafeString(plan.CustomProperty26.ValueStringPointer())
-	plan.CustomProperty27 = util.SafeString(plan.CustomProperty27.ValueStringPointer())
-	plan.CustomProperty28 = util.SafeString(plan.CustomProperty28.ValueStringPointer())
-	plan.CustomProperty29 = util.SafeString(plan.CustomProperty29.ValueStringPointer())
-	plan.CustomProperty30 = util.SafeString(plan.CustomProperty30.ValueStringPointer())
-	plan.CustomProperty31 = util.SafeString(plan.CustomProperty31.ValueStringPointer())
-	plan.CustomProperty32 = util.SafeString(plan.CustomProperty32.ValueStringPointer())
-	plan.CustomProperty33 = util.SafeString(plan.CustomProperty33.ValueStringPointer())
-	plan.CustomProperty34 = util.SafeString(plan.CustomProperty34.ValueStringPointer())
-	plan.CustomProperty35 = util.SafeString(plan.CustomProperty35.ValueStringPointer())
-	plan.CustomProperty36 = util.SafeString(plan.CustomProperty36.ValueStringPointer())
-	plan.CustomProperty37 = util.SafeString(plan.CustomProperty37.ValueStringPointer())
-	plan.CustomProperty38 = util.SafeString(plan.CustomProperty38.ValueStringPointer())
-	plan.CustomProperty39 = util.SafeString(plan.CustomProperty39.ValueStringPointer())
-	plan.CustomProperty40 = util.SafeString(plan.CustomProperty40.ValueStringPointer())
-	plan.CustomProperty41 = util.SafeString(plan.CustomProperty41.ValueStringPointer())
-	plan.CustomProperty42 = util.SafeString(plan.CustomProperty42.ValueStringPointer())
-	plan.CustomProperty43 = util.SafeString(plan.CustomProperty43.ValueStringPointer())
-	plan.CustomProperty44 = util.SafeString(plan.CustomProperty44.ValueStringPointer())
-	plan.CustomProperty45 = util.SafeString(plan.CustomProperty45.ValueStringPointer())
+	plan.StatusConfig = customtypes.NewJSONStringValue(util.SafeDeref(plan.StatusConfig.ValueStringPointer()))
+	plan.PluginConfigs = customtypes.NewJSONStringValue(util.SafeDeref(plan.PluginConfigs.ValueStringPointer()))
+	plan.EndpointConfig = customtypes.NewJSONStringValue(util.SafeDeref(plan.EndpointConfig.ValueStringPointer()))
+	// Custom properties that were not configured are read back on the next refresh
+	if plan.CustomProperties.IsUnknown() {
+		plan.CustomProperties = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 	plan.AccountCustomProperty1Label = util.SafeString(plan.AccountCustomProperty1Label.ValueStringPointer())
 	plan.AccountCustomProperty2Label = util.SafeString(plan.AccountCustomProperty2Label.ValueStringPointer())
 	plan.AccountCustomProperty3Label = util.SafeString(plan.AccountCustomProperty3Label.ValueStringPointer())
@@ -14,9 +14,12 @@ import (
 
 	"terraform-provider-Saviynt/util/errorsutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openapi "github.com/saviynt/saviynt-api-go-client/entitlements"
@@ -75,6 +78,7 @@ type EntitlementDataSourceModel struct {
 	Authenticate          types.Bool           `tfsdk:"authenticate"`
 	Max                   types.Int32          `tfsdk:"max"`
 	Offset                types.Int32          `tfsdk:"offset"`
+	FetchAll              types.Bool           `tfsdk:"fetch_all"`
 }
 
 type EntitlementDetails struct {
@@ -202,6 +206,13 @@ func (d *entitlementDataSource) Schema(ctx context.Context, req datasource.Schem
 				Optional:    true,
 				Description: "Number of entitlements to skip for pagination.",
 			},
+			"fetch_all": schema.BoolAttribute{
+				Optional:    true,
+				Description: FetchAllDescription("entitlements", "total_entitlement_count", "entitlements_count"),
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("offset")),
+				},
+			},
 		},
 	}
 }
@@ -252,7 +263,13 @@ func (d *entitlementDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Execute API call to get entitlement details
-	apiResp, err := d.ReadEntitlementDetails(ctx, &state)
+	var apiResp *openapi.GetEntitlementResponse
+	var err error
+	if fetchAllEnabled(state.FetchAll) {
+		apiResp, err = d.ReadAllEntitlementDetails(ctx, &state)
+	} else {
+		apiResp, err = d.ReadEntitlementDetails(ctx, &state)
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to read entitlement details", map[string]interface{}{
 			"error": err.Error(),
@@ -366,6 +383,60 @@ func (d *entitlementDataSource) ReadEntitlementDetails(ctx context.Context, stat
 	return getResp, nil
 }
 
+// ReadAllEntitlementDetails reads every page of entitlements matching the filters of state and
+// returns them as a single response
+func (d *entitlementDataSource) ReadAllEntitlementDetails(ctx context.Context, state *EntitlementDataSourceModel) (*openapi.GetEntitlementResponse, error) {
+	pageSize := DefaultFetchAllPageSize
+	if !state.Max.IsNull() && !state.Max.IsUnknown() {
+		if state.Max.ValueInt32() <= 0 {
+			return nil, fmt.Errorf("max must be a positive number when fetch_all is true, got %d", state.Max.ValueInt32())
+		}
+		pageSize = int(state.Max.ValueInt32())
+	}
+
+	// Pages use the max and offset fields of GetEntitlementRequest in the OpenAPI description
+	var firstResp *openapi.GetEntitlementResponse
+	entitlements, total, err := FetchAllPages(ctx, pageSize, FetchAllParallelism,
+		func(ctx context.Context, offset, max int) (Page[openapi.GetEntitlementResponseEntitlementdetailsInner], error) {
+			pageState := *state
+			pageState.Offset = types.Int32Value(int32(offset))
+			pageState.Max = types.Int32Value(int32(max))
+			resp, err := d.ReadEntitlementDetails(ctx, &pageState)
+			if err != nil || resp == nil {
+				return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{}, err
+			}
+			if offset == 0 {
+				firstResp = resp
+			}
+			total := -1
+			if resp.TotalEntitlementCount != nil {
+				total = int(*resp.TotalEntitlementCount)
+			}
+			return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{Items: resp.Entitlementdetails, Total: total}, nil
+		},
+		func(e openapi.GetEntitlementResponseEntitlementdetailsInner) string {
+			return util.SafeDeref(e.EntitlementValuekey)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if entitlements == nil {
+		entitlements = []openapi.GetEntitlementResponseEntitlementdetailsInner{}
+	}
+	allResp := openapi.GetEntitlementResponse{Entitlementdetails: entitlements}
+	if firstResp != nil {
+		allResp.Msg = firstResp.Msg
+		allResp.ErrorCode = firstResp.ErrorCode
+	}
+	allResp.SetEntitlementsCount(int32(len(entitlements)))
+	allResp.SetTotalEntitlementCount(int32(total))
+
+	tflog.Debug(ctx, "Read all entitlement pages", map[string]interface{}{"count": len(entitlements), "total": total})
+	return &allResp, nil
+}
+
 // UpdateModelFromAPIResponse maps API response data to the Terraform state model
 func (d *entitlementDataSource) UpdateModelFromAPIResponse(state *EntitlementDataSourceModel, apiResp *openapi.GetEntitlementResponse) {
 	// Map basic response fields
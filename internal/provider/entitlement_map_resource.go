@@ -0,0 +1,459 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_entitlement_map manages the mapping of a single entitlement to a primary entitlement.
+// The resource implements the full Terraform lifecycle:
+//   - Create: adds the mapping to the primary entitlement through the create or update entitlement API.
+//   - Read: refreshes the mapping flags and removes the resource from state when the mapping is gone.
+//   - Update: changes the mapping flags in place.
+//   - Delete: removes the mapping from the primary entitlement.
+//   - Import: brings an existing mapping under Terraform management using
+//     'endpoint:entitlement_type:entitlement_value:mapped_endpoint:mapped_entitlement_type:mapped_entitlement_value'.
+//
+// Primary entitlements that are managed by saviynt_entitlement_resource should set
+// manage_entitlement_map to false so that mappings added by this resource are left alone.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/errorsutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/entitlements"
+)
+
+var _ resource.Resource = &EntitlementMapResource{}
+var _ resource.ResourceWithImportState = &EntitlementMapResource{}
+
+// EntitlementMapResourceModel defines the state for the entitlement map resource.
+type EntitlementMapResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Endpoint               types.String `tfsdk:"endpoint"`
+	EntitlementType        types.String `tfsdk:"entitlement_type"`
+	EntitlementValue       types.String `tfsdk:"entitlement_value"`
+	MappedEndpoint         types.String `tfsdk:"mapped_endpoint"`
+	MappedEntitlementType  types.String `tfsdk:"mapped_entitlement_type"`
+	MappedEntitlementValue types.String `tfsdk:"mapped_entitlement_value"`
+	MappedEntitlementKey   types.String `tfsdk:"mapped_entitlement_key"`
+	RequestFilter          types.Bool   `tfsdk:"request_filter"`
+	ExcludeEntitlement     types.Bool   `tfsdk:"exclude_entitlement"`
+	AddDependentTask       types.Bool   `tfsdk:"add_dependent_task"`
+	RemoveDependentEntTask types.Bool   `tfsdk:"remove_dependent_ent_task"`
+}
+
+// EntitlementMapResource implements the resource.Resource interface for a single entitlement mapping.
+type EntitlementMapResource struct {
+	client             client.SaviyntClientInterface
+	token              string
+	provider           client.SaviyntProviderInterface
+	entitlementFactory client.EntitlementFactoryInterface
+}
+
+// NewEntitlementMapResource creates a new instance of the entitlement map resource.
+func NewEntitlementMapResource() resource.Resource {
+	return &EntitlementMapResource{
+		entitlementFactory: &client.DefaultEntitlementFactory{},
+	}
+}
+
+func NewEntitlementMapResourceWithFactory(factory client.EntitlementFactoryInterface) resource.Resource {
+	return &EntitlementMapResource{
+		entitlementFactory: factory,
+	}
+}
+
+func (r *EntitlementMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entitlement_map"
+}
+
+func (r *EntitlementMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		Description: util.EntitlementMapDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the mapping in the format 'endpoint:entitlement_type:entitlement_value:mapped_endpoint:mapped_entitlement_type:mapped_entitlement_value'.",
+			},
+			"endpoint": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the endpoint of the primary entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlement_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the entitlement type of the primary entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlement_value": schema.StringAttribute{
+				Required:      true,
+				Description:   "Value of the primary entitlement the mapping is added to.",
+				PlanModifiers: requiresReplace,
+			},
+			"mapped_endpoint": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the endpoint of the mapped entitlement. It can differ from the endpoint of the primary entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"mapped_entitlement_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the entitlement type of the mapped entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"mapped_entitlement_value": schema.StringAttribute{
+				Required:      true,
+				Description:   "Value of the mapped entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"mapped_entitlement_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key of the mapped entitlement in Saviynt.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"request_filter": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Request filter flag for the mapping. Defaults to false.",
+			},
+			"exclude_entitlement": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Exclude entitlement flag for the mapping. Defaults to false.",
+			},
+			"add_dependent_task": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether a task is created for the mapped entitlement when the primary entitlement is added. Defaults to false.",
+			},
+			"remove_dependent_ent_task": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether a task is created for the mapped entitlement when the primary entitlement is removed. Defaults to false.",
+			},
+		},
+	}
+}
+
+// Configure initializes the entitlement map resource with the provider's API client and access token.
+func (r *EntitlementMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting entitlement map resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+	tflog.Debug(ctx, "Entitlement map resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *EntitlementMapResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *EntitlementMapResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *EntitlementMapResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+func entitlementMapID(model *EntitlementMapResourceModel) string {
+	return strings.Join([]string{
+		model.Endpoint.ValueString(),
+		model.EntitlementType.ValueString(),
+		model.EntitlementValue.ValueString(),
+		model.MappedEndpoint.ValueString(),
+		model.MappedEntitlementType.ValueString(),
+		model.MappedEntitlementValue.ValueString(),
+	}, ":")
+}
+
+// ChangeEntitlementMap adds, updates or removes the mapping of the model on its primary entitlement.
+// Only the mapping is sent, so the other attributes of the primary entitlement are left unchanged.
+func (r *EntitlementMapResource) ChangeEntitlementMap(ctx context.Context, model *EntitlementMapResourceModel, updateType string) error {
+	updateReq := openapi.CreateUpdateEntitlementRequest{
+		Endpoint:         model.Endpoint.ValueString(),
+		Entitlementtype:  model.EntitlementType.ValueString(),
+		EntitlementValue: model.EntitlementValue.ValueString(),
+		Entitlementmap: []openapi.CreateUpdateEntitlementRequestEntitlementmapInner{
+			{
+				Entitlementvalue:       model.MappedEntitlementValue.ValueStringPointer(),
+				Entitlementtype:        model.MappedEntitlementType.ValueStringPointer(),
+				Endpoint:               model.MappedEndpoint.ValueStringPointer(),
+				Requestfilter:          util.BoolToStringPointer(model.RequestFilter),
+				Excludeentitlement:     util.BoolToStringPointer(model.ExcludeEntitlement),
+				Adddependenttask:       util.BoolToStringPointer(model.AddDependentTask),
+				Removedependententtask: util.BoolToStringPointer(model.RemoveDependentEntTask),
+				UpdateType:             util.StringPtr(updateType),
+			},
+		},
+	}
+
+	tflog.Debug(ctx, "Executing entitlement map update API call", map[string]interface{}{
+		"id":          entitlementMapID(model),
+		"update_type": updateType,
+	})
+	var apiResp *openapi.CreateOrUpdateEntitlementResponse
+	var finalHttpResp *http.Response
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_entitlement_map", func(token string) error {
+		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := entitlementOps.CreateUpdateEntitlement(ctx, updateReq)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+	if err != nil {
+		err = errorsutil.HandleHTTPError(finalHttpResp, err, "Update")
+		return fmt.Errorf("error updating entitlement map of %s: %v", model.EntitlementValue.ValueString(), err)
+	}
+	if apiResp != nil && apiResp.ErrorCode != nil && *apiResp.ErrorCode != "0" {
+		return fmt.Errorf("error updating entitlement map of %s: %v", model.EntitlementValue.ValueString(), util.SafeDeref(apiResp.Msg))
+	}
+	return nil
+}
+
+// ReadEntitlementMap returns the mapping of the model as reported on its primary entitlement, or
+// nil when the primary entitlement does not list it.
+func (r *EntitlementMapResource) ReadEntitlementMap(ctx context.Context, model *EntitlementMapResourceModel) (*openapi.GetEntitlementResponseEntitlementdetailsInnerEntitlementMapDetailsInner, error) {
+	readReq := openapi.GetEntitlementRequest{
+		Endpoint:             util.StringPtr(model.Endpoint.ValueString()),
+		Entitlementtype:      util.StringPtr(model.EntitlementType.ValueString()),
+		EntitlementValue:     util.StringPtr(model.EntitlementValue.ValueString()),
+		Returnentitlementmap: util.StringPtr("true"),
+	}
+	readResp, err := r.getEntitlements(ctx, readReq)
+	if err != nil {
+		return nil, err
+	}
+	if readResp == nil || len(readResp.Entitlementdetails) == 0 {
+		return nil, nil
+	}
+
+	var candidates []openapi.GetEntitlementResponseEntitlementdetailsInnerEntitlementMapDetailsInner
+	for _, mapDetail := range readResp.Entitlementdetails[0].EntitlementMapDetails {
+		if util.SafeDeref(mapDetail.Primary) == model.MappedEntitlementValue.ValueString() &&
+			strings.EqualFold(util.SafeDeref(mapDetail.PrimaryEntType), model.MappedEntitlementType.ValueString()) {
+			candidates = append(candidates, mapDetail)
+		}
+	}
+	if len(candidates) == 1 {
+		return &candidates[0], nil
+	}
+
+	// The map details do not include the endpoint, so look it up when the value and type are ambiguous
+	for i := range candidates {
+		if candidates[i].PrimaryEntKey == nil {
+			continue
+		}
+		endpoint, err := r.entitlementEndpoint(ctx, *candidates[i].PrimaryEntKey)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(endpoint, model.MappedEndpoint.ValueString()) {
+			return &candidates[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// entitlementEndpoint returns the endpoint of the entitlement with the given key
+func (r *EntitlementMapResource) entitlementEndpoint(ctx context.Context, entitlementKey string) (string, error) {
+	entQuery := fmt.Sprintf("ent.id like '%s'", entitlementKey)
+	readResp, err := r.getEntitlements(ctx, openapi.GetEntitlementRequest{EntQuery: &entQuery})
+	if err != nil {
+		return "", err
+	}
+	if readResp == nil || len(readResp.Entitlementdetails) == 0 {
+		return "", nil
+	}
+	return util.SafeDeref(readResp.Entitlementdetails[0].Endpoint), nil
+}
+
+func (r *EntitlementMapResource) getEntitlements(ctx context.Context, readReq openapi.GetEntitlementRequest) (*openapi.GetEntitlementResponse, error) {
+	var readResp *openapi.GetEntitlementResponse
+	var finalHttpResp *http.Response
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "read_entitlement_map", func(token string) error {
+		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := entitlementOps.GetEntitlements(ctx, readReq)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		readResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+	if err != nil {
+		err = errorsutil.HandleHTTPError(finalHttpResp, err, "Read")
+		return nil, fmt.Errorf("error reading entitlement: %v", err)
+	}
+	if readResp != nil && readResp.ErrorCode != nil && *readResp.ErrorCode != "0" {
+		return nil, fmt.Errorf("error reading entitlement. Error code: %v, Msg: %v", util.SafeDeref(readResp.ErrorCode), util.SafeDeref(readResp.Msg))
+	}
+	return readResp, nil
+}
+
+// populateFromEntitlementMap copies the mapping flags and key reported by Saviynt into the model.
+// Flags that are not reported are false.
+func populateFromEntitlementMap(model *EntitlementMapResourceModel, mapDetail *openapi.GetEntitlementResponseEntitlementdetailsInnerEntitlementMapDetailsInner) {
+	model.ID = types.StringValue(entitlementMapID(model))
+	model.MappedEntitlementKey = util.SafeString(mapDetail.PrimaryEntKey)
+	model.RequestFilter = types.BoolValue(mapDetail.GetRequestFilter())
+	model.ExcludeEntitlement = types.BoolValue(mapDetail.GetExcludeEntitlement())
+	model.AddDependentTask = types.BoolValue(mapDetail.GetAddDependentTask())
+	model.RemoveDependentEntTask = types.BoolValue(mapDetail.GetRemoveDependentEntTask())
+}
+
+func (r *EntitlementMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EntitlementMapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.ChangeEntitlementMap(ctx, &plan, UpdateTypeAdd); err != nil {
+		resp.Diagnostics.AddError("Entitlement Map Creation Failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(entitlementMapID(&plan))
+	mapDetail, err := r.ReadEntitlementMap(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Entitlement Map Read Failed", err.Error())
+		return
+	}
+	if mapDetail == nil {
+		resp.Diagnostics.AddError(
+			"Entitlement Map Not Found",
+			fmt.Sprintf("Saviynt accepted the mapping %s but does not list it on the primary entitlement. Check that the mapped entitlement exists.", plan.ID.ValueString()),
+		)
+		return
+	}
+	plan.MappedEntitlementKey = util.SafeString(mapDetail.PrimaryEntKey)
+
+	tflog.Info(ctx, "Entitlement map created", map[string]interface{}{"id": plan.ID.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntitlementMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EntitlementMapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapDetail, err := r.ReadEntitlementMap(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Failed", err.Error())
+		return
+	}
+	if mapDetail == nil {
+		tflog.Warn(ctx, "Entitlement map not found, removing from state", map[string]interface{}{"id": entitlementMapID(&state)})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	populateFromEntitlementMap(&state, mapDetail)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the mapping flags; every other configurable attribute requires replacement.
+func (r *EntitlementMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EntitlementMapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.ChangeEntitlementMap(ctx, &plan, UpdateTypeUpdate); err != nil {
+		resp.Diagnostics.AddError("Entitlement Map Update Failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(entitlementMapID(&plan))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntitlementMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EntitlementMapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.ChangeEntitlementMap(ctx, &state, UpdateTypeRemove); err != nil {
+		resp.Diagnostics.AddError("Entitlement Map Deletion Failed", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *EntitlementMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	attributes := []string{"endpoint", "entitlement_type", "entitlement_value", "mapped_endpoint", "mapped_entitlement_type", "mapped_entitlement_value"}
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != len(attributes) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			fmt.Sprintf("Expected import ID format: 'endpoint:entitlement_type:entitlement_value:mapped_endpoint:mapped_entitlement_type:mapped_entitlement_value', got: %s\n"+
+				"Example: terraform import saviynt_entitlement_map.example sample-103:Roles:payroll_admin:sample-104:Groups:payroll_readers", req.ID),
+		)
+		return
+	}
+
+	for i, attribute := range attributes {
+		value := strings.TrimSpace(idParts[i])
+		if value == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID Components",
+				"endpoint, entitlement_type, entitlement_value, mapped_endpoint, mapped_entitlement_type and mapped_entitlement_value must be non-empty",
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
@@ -16,7 +16,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"os"
 	"reflect"
 	"regexp"
 	"strings"
@@ -31,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -40,26 +40,48 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &EntitlementResource{}
 var _ resource.ResourceWithImportState = &EntitlementResource{}
+var _ resource.ResourceWithUpgradeState = &EntitlementResource{}
+var _ resource.ResourceWithModifyPlan = &EntitlementResource{}
+var _ resource.ResourceWithValidateConfig = &EntitlementResource{}
+
+// entitlementCustomPropertyCount is the number of custom properties of an entitlement
+const entitlementCustomPropertyCount = 40
+
+// EntitlementStatusInactive is the status of an inactive entitlement
+const EntitlementStatusInactive int32 = 2
+
+// entitlementDeactivateDescription explains the deactivate deletion policy of an entitlement
+const entitlementDeactivateDescription = "sets the entitlement status to inactive, assigns it to 'deactivation_owner' and appends 'deactivation_note' to its description when they are set,"
 
 type EntitlementResource struct {
 	client             client.SaviyntClientInterface
 	token              string
 	provider           client.SaviyntProviderInterface
 	entitlementFactory client.EntitlementFactoryInterface
+	// entitlementTypeFactory reads the custom property labels of the entitlement type
+	entitlementTypeFactory client.EntitlementTypeFactoryInterface
 }
 
 func NewEntitlementResource() resource.Resource {
 	return &EntitlementResource{
-		entitlementFactory: &client.DefaultEntitlementFactory{},
+		entitlementFactory:     &client.DefaultEntitlementFactory{},
+		entitlementTypeFactory: &client.DefaultEntitlementTypeFactory{},
 	}
 }
 
 func NewEntitlementResourceWithFactory(factory client.EntitlementFactoryInterface) resource.Resource {
 	return &EntitlementResource{
-		entitlementFactory: factory,
+		entitlementFactory:     factory,
+		entitlementTypeFactory: &client.DefaultEntitlementTypeFactory{},
 	}
 }
 
+// entitlementCustomPropertyLabelSource describes where the labels of entitlement custom properties are configured
+const entitlementCustomPropertyLabelSource = "the entitlement type of the entitlement"
+
+const entitlementCustomPropertyLabelNote = " Labels are not read from the custom_propertyN_label or account_custom_property_N_label " +
+	"attributes of the endpoint, which label the custom properties of the endpoint and of its accounts."
+
 type EntitlementResourceModel struct {
 	ID                  types.String `tfsdk:"id"`
 	Endpoint            types.String `tfsdk:"endpoint"`
@@ -78,49 +100,14 @@ type EntitlementResourceModel struct {
 	Priority            types.Int32  `tfsdk:"priority"`
 	Description         types.String `tfsdk:"description"`
 	Confidentiality     types.Int32  `tfsdk:"confidentiality"`
-	Customproperty1     types.String `tfsdk:"customproperty1"`
-	Customproperty2     types.String `tfsdk:"customproperty2"`
-	Customproperty3     types.String `tfsdk:"customproperty3"`
-	Customproperty4     types.String `tfsdk:"customproperty4"`
-	Customproperty5     types.String `tfsdk:"customproperty5"`
-	Customproperty6     types.String `tfsdk:"customproperty6"`
-	Customproperty7     types.String `tfsdk:"customproperty7"`
-	Customproperty8     types.String `tfsdk:"customproperty8"`
-	Customproperty9     types.String `tfsdk:"customproperty9"`
-	Customproperty10    types.String `tfsdk:"customproperty10"`
-	Customproperty11    types.String `tfsdk:"customproperty11"`
-	Customproperty12    types.String `tfsdk:"customproperty12"`
-	Customproperty13    types.String `tfsdk:"customproperty13"`
-	Customproperty14    types.String `tfsdk:"customproperty14"`
-	Customproperty15    types.String `tfsdk:"customproperty15"`
-	Customproperty16    types.String `tfsdk:"customproperty16"`
-	Customproperty17    types.String `tfsdk:"customproperty17"`
-	Customproperty18    types.String `tfsdk:"customproperty18"`
-	Customproperty19    types.String `tfsdk:"customproperty19"`
-	Customproperty20    types.String `tfsdk:"customproperty20"`
-	Customproperty21    types.String `tfsdk:"customproperty21"`
-	Customproperty22    types.String `tfsdk:"customproperty22"`
-	Customproperty23    types.String `tfsdk:"customproperty23"`
-	Customproperty24    types.String `tfsdk:"customproperty24"`
-	Customproperty25    types.String `tfsdk:"customproperty25"`
-	Customproperty26    types.String `tfsdk:"customproperty26"`
-	Customproperty27    types.String `tfsdk:"customproperty27"`
-	Customproperty28    types.String `tfsdk:"customproperty28"`
-	Customproperty29    types.String `tfsdk:"customproperty29"`
-	Customproperty30    types.String `tfsdk:"customproperty30"`
-	Customproperty31    types.String `tfsdk:"customproperty31"`
-	Customproperty32    types.String `tfsdk:"customproperty32"`
-	Customproperty33    types.String `tfsdk:"customproperty33"`
-	Customproperty34    types.String `tfsdk:"customproperty34"`
-	Customproperty35    types.String `tfsdk:"customproperty35"`
-	Customproperty36    types.String `tfsdk:"customproperty36"`
-	Customproperty37    types.String `tfsdk:"customproperty37"`
-	Customproperty38    types.String `tfsdk:"customproperty38"`
-	Customproperty39    types.String `tfsdk:"customproperty39"`
-	Customproperty40    types.String `tfsdk:"customproperty40"`
-
-	EntitlementOwners types.Map `tfsdk:"entitlement_owners"`
-	EntitlementMap    types.Set `tfsdk:"entitlement_map"`
+	CustomProperties    types.Map    `tfsdk:"custom_properties"`
+
+	EntitlementOwners    types.Map    `tfsdk:"entitlement_owners"`
+	EntitlementMap       types.Set    `tfsdk:"entitlement_map"`
+	ManageEntitlementMap types.Bool   `tfsdk:"manage_entitlement_map"`
+	DeletionPolicy       types.String `tfsdk:"deletion_policy"`
+	DeactivationOwner    types.String `tfsdk:"deactivation_owner"`
+	DeactivationNote     types.String `tfsdk:"deactivation_note"`
 }
 
 type EntitlementMapModel struct {
@@ -340,23 +327,108 @@ func (r *EntitlementResource) Schema(ctx context.Context, req resource.SchemaReq
 			Optional:    true,
 			Description: "Set of entitlement mappings for hierarchical relationships",
 		},
-	}
-
-	// Generate custom properties using reflection
-	for i := 1; i <= 40; i++ {
-		attributes[fmt.Sprintf("customproperty%d", i)] = schema.StringAttribute{
+		"manage_entitlement_map": schema.BoolAttribute{
 			Optional:    true,
 			Computed:    true,
-			Description: fmt.Sprintf("Custom property %d value", i),
-		}
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether this resource manages the entitlement mappings of the entitlement. Set to false when mappings are managed with saviynt_entitlement_map; mappings are then neither read, imported nor changed by this resource and 'entitlement_map' must not be set. Defaults to true.",
+		},
+	}
+
+	attributes["deletion_policy"] = DeletionPolicySchema(entitlementDeactivateDescription)
+	attributes["deactivation_owner"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "User name that becomes the rank 1 owner of the entitlement when it is deactivated by deletion_policy \"deactivate\". The rank 1 owners in state are removed. Requires deletion_policy \"deactivate\".",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
 	}
+	attributes["deactivation_note"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Note appended to the description of the entitlement when it is deactivated by deletion_policy \"deactivate\", for example a ticket number. Requires deletion_policy \"deactivate\".",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	// Saviynt configures the labels of entitlement custom properties on the entitlement type. The
+	// custom property labels of the endpoint name the custom properties of the endpoint itself.
+	customProperties := CustomPropertyLabelsSchema(entitlementCustomPropertyCount, entitlementCustomPropertyLabelSource)
+	customProperties.Description += entitlementCustomPropertyLabelNote
+	attributes["custom_properties"] = customProperties
 
 	resp.Schema = schema.Schema{
 		Description: util.EntitlementDescription,
 		Attributes:  attributes,
+		Version:     1,
+	}
+}
+
+// UpgradeState moves the customproperty1 to customproperty40 attributes of schema version 0 into
+// custom_properties.
+func (r *EntitlementResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return map[int64]resource.StateUpgrader{
+		0: CustomPropertiesStateUpgrader(schemaResp.Schema, "customproperty%d", entitlementCustomPropertyCount),
 	}
 }
 
+// ModifyPlan resolves custom properties set by label against the labels configured on the
+// entitlement type, so that unknown labels are reported at plan time. On destroy it reports what
+// the deletion policy will do.
+func (r *EntitlementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		if req.State.Raw.IsNull() {
+			return
+		}
+		var state EntitlementResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name := fmt.Sprintf("entitlement %s (%s on endpoint %s)", state.EntitlementValue.ValueString(),
+			state.Entitlementtype.ValueString(), state.Endpoint.ValueString())
+		AddDeletionPolicyPlanDiagnostics(&resp.Diagnostics, state.DeletionPolicy, name, entitlementDeactivationSummary(&state))
+		return
+	}
+	if r.provider == nil {
+		return
+	}
+
+	var plan EntitlementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(CustomPropertyLabelKeys(plan.CustomProperties)) == 0 ||
+		plan.Endpoint.IsUnknown() || plan.Entitlementtype.IsUnknown() {
+		return
+	}
+
+	labels, err := r.CustomPropertyLabels(ctx, &plan, plan.CustomProperties)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_properties"),
+			"Unable to Resolve Custom Property Labels",
+			fmt.Sprintf("Failed to read the custom property labels of entitlement type %s on endpoint %s: %s",
+				plan.Entitlementtype.ValueString(), plan.Endpoint.ValueString(), err),
+		)
+		return
+	}
+	source := fmt.Sprintf("entitlement type %s on endpoint %s", plan.Entitlementtype.ValueString(), plan.Endpoint.ValueString())
+	resp.Diagnostics.Append(ResolveCustomPropertyLabels(plan.CustomProperties, labels, entitlementCustomPropertyCount, path.Root("custom_properties"), source)...)
+}
+
+// CustomPropertyLabels reads the custom property labels of the entitlement type of model when one
+// of the given custom_properties maps sets a property by label, and returns nil otherwise.
+func (r *EntitlementResource) CustomPropertyLabels(ctx context.Context, model *EntitlementResourceModel, properties ...types.Map) (CustomPropertyLabels, error) {
+	if len(CustomPropertyLabelKeys(properties...)) == 0 {
+		return nil, nil
+	}
+	return ReadEntitlementTypeCustomPropertyLabels(ctx, r.provider, r.client, r.entitlementTypeFactory,
+		model.Endpoint.ValueString(), model.Entitlementtype.ValueString())
+}
+
 func (r *EntitlementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		log.Println("[DEBUG] Entitlements: ProviderData is nil, returning early.")
@@ -419,48 +491,6 @@ func (r *EntitlementResource) BuildCreateEntitlementRequest(plan *EntitlementRes
 		Priviliged:      util.Int32PointerOrEmpty(plan.Privileged),
 		Priority:        util.Int32PointerOrEmpty(plan.Priority),
 		Confidentiality: util.Int32PointerOrEmpty(plan.Confidentiality),
-
-		// Custom properties
-		Customproperty1:  util.StringPointerOrEmpty(plan.Customproperty1),
-		Customproperty2:  util.StringPointerOrEmpty(plan.Customproperty2),
-		Customproperty3:  util.StringPointerOrEmpty(plan.Customproperty3),
-		Customproperty4:  util.StringPointerOrEmpty(plan.Customproperty4),
-		Customproperty5:  util.StringPointerOrEmpty(plan.Customproperty5),
-		Customproperty6:  util.StringPointerOrEmpty(plan.Customproperty6),
-		Customproperty7:  util.StringPointerOrEmpty(plan.Customproperty7),
-		Customproperty8:  util.StringPointerOrEmpty(plan.Customproperty8),
-		Customproperty9:  util.StringPointerOrEmpty(plan.Customproperty9),
-		Customproperty10: util.StringPointerOrEmpty(plan.Customproperty10),
-		Customproperty11: util.StringPointerOrEmpty(plan.Customproperty11),
-		Customproperty12: util.StringPointerOrEmpty(plan.Customproperty12),
-		Customproperty13: util.StringPointerOrEmpty(plan.Customproperty13),
-		Customproperty14: util.StringPointerOrEmpty(plan.Customproperty14),
-		Customproperty15: util.StringPointerOrEmpty(plan.Customproperty15),
-		Customproperty16: util.StringPointerOrEmpty(plan.Customproperty16),
-		Customproperty17: util.StringPointerOrEmpty(plan.Customproperty17),
-		Customproperty18: util.StringPointerOrEmpty(plan.Customproperty18),
-		Customproperty19: util.StringPointerOrEmpty(plan.Customproperty19),
-		Customproperty20: util.StringPointerOrEmpty(plan.Customproperty20),
-		Customproperty21: util.StringPointerOrEmpty(plan.Customproperty21),
-		Customproperty22: util.StringPointerOrEmpty(plan.Customproperty22),
-		Customproperty23: util.StringPointerOrEmpty(plan.Customproperty23),
-		Customproperty24: util.StringPointerOrEmpty(plan.Customproperty24),
-		Customproperty25: util.StringPointerOrEmpty(plan.Customproperty25),
-		Customproperty26: util.StringPointerOrEmpty(plan.Customproperty26),
-		Customproperty27: util.StringPointerOrEmpty(plan.Customproperty27),
-		Customproperty28: util.StringPointerOrEmpty(plan.Customproperty28),
-		Customproperty29: util.StringPointerOrEmpty(plan.Customproperty29),
-		Customproperty30: util.StringPointerOrEmpty(plan.Customproperty30),
-		Customproperty31: util.StringPointerOrEmpty(plan.Customproperty31),
-		Customproperty32: util.StringPointerOrEmpty(plan.Customproperty32),
-		Customproperty33: util.StringPointerOrEmpty(plan.Customproperty33),
-		Customproperty34: util.StringPointerOrEmpty(plan.Customproperty34),
-		Customproperty35: util.StringPointerOrEmpty(plan.Customproperty35),
-		Customproperty36: util.StringPointerOrEmpty(plan.Customproperty36),
-		Customproperty37: util.StringPointerOrEmpty(plan.Customproperty37),
-		Customproperty38: util.StringPointerOrEmpty(plan.Customproperty38),
-		Customproperty39: util.StringPointerOrEmpty(plan.Customproperty39),
-		Customproperty40: util.StringPointerOrEmpty(plan.Customproperty40),
 	}
 
 	return createReq
@@ -491,7 +521,7 @@ func (r *EntitlementResource) ProcessEntitlementOwnersForEntitlementCreatee(ctx
 
 // ProcessEntitlementMap processes entitlement map for create requests
 func (r *EntitlementResource) ProcessEntitlementMapForEntitlementCreate(ctx context.Context, plan *EntitlementResourceModel, createReq *openapi.CreateUpdateEntitlementRequest) {
-	if !plan.EntitlementMap.IsNull() {
+	if entitlementManagesMap(plan) && !plan.EntitlementMap.IsNull() {
 		var entitlementMaps []EntitlementMapModel
 		plan.EntitlementMap.ElementsAs(ctx, &entitlementMaps, false)
 
@@ -508,6 +538,15 @@ func (r *EntitlementResource) CreateEntitlement(ctx context.Context, plan *Entit
 	log.Printf("[DEBUG] Entitlements: Starting creation for entitlement: %s", plan.EntitlementValue.ValueString())
 
 	createReq := r.BuildCreateEntitlementRequest(plan)
+	labels, err := r.CustomPropertyLabels(ctx, plan, plan.CustomProperties)
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom property labels: %v", err)
+	}
+	customProperties, diags := CustomPropertyValues(ctx, plan.CustomProperties, labels)
+	if diags.HasError() {
+		return nil, fmt.Errorf("invalid custom_properties: %v", diags.Errors())
+	}
+	SetCustomPropertyFields(&createReq, customProperties)
 	r.ProcessEntitlementOwnersForEntitlementCreatee(ctx, plan, &createReq)
 	r.ProcessEntitlementMapForEntitlementCreate(ctx, plan, &createReq)
 
@@ -516,7 +555,7 @@ func (r *EntitlementResource) CreateEntitlement(ctx context.Context, plan *Entit
 	// Execute create operation with retry logic
 	var createResp *openapi.CreateOrUpdateEntitlementResponse
 	var createHttpResp *http.Response
-	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "create_entitlement", func(token string) error {
+	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_entitlement", func(token string) error {
 		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
 		resp, hResp, err := entitlementOps.CreateUpdateEntitlement(ctx, createReq)
 		if hResp != nil && hResp.StatusCode == 401 {
@@ -565,47 +604,10 @@ func (r *EntitlementResource) SetDefaultComputedValues(plan *EntitlementResource
 	plan.Priority = util.SafeInt32(plan.Priority.ValueInt32Pointer())
 	plan.Confidentiality = util.SafeInt32(plan.Confidentiality.ValueInt32Pointer())
 
-	// Set custom properties to ensure they have known values
-	plan.Customproperty1 = util.SafeStringDatasource(plan.Customproperty1.ValueStringPointer())
-	plan.Customproperty2 = util.SafeStringDatasource(plan.Customproperty2.ValueStringPointer())
-	plan.Customproperty3 = util.SafeStringDatasource(plan.Customproperty3.ValueStringPointer())
-	plan.Customproperty4 = util.SafeStringDatasource(plan.Customproperty4.ValueStringPointer())
-	plan.Customproperty5 = util.SafeStringDatasource(plan.Customproperty5.ValueStringPointer())
-	plan.Customproperty6 = util.SafeStringDatasource(plan.Customproperty6.ValueStringPointer())
-	plan.Customproperty7 = util.SafeStringDatasource(plan.Customproperty7.ValueStringPointer())
-	plan.Customproperty8 = util.SafeStringDatasource(plan.Customproperty8.ValueStringPointer())
-	plan.Customproperty9 = util.SafeStringDatasource(plan.Customproperty9.ValueStringPointer())
-	plan.Customproperty10 = util.SafeStringDatasource(plan.Customproperty10.ValueStringPointer())
-	plan.Customproperty11 = util.SafeStringDatasource(plan.Customproperty11.ValueStringPointer())
-	plan.Customproperty12 = util.SafeStringDatasource(plan.Customproperty12.ValueStringPointer())
-	plan.Customproperty13 = util.SafeStringDatasource(plan.Customproperty13.ValueStringPointer())
-	plan.Customproperty14 = util.SafeStringDatasource(plan.Customproperty14.ValueStringPointer())
-	plan.Customproperty15 = util.SafeStringDatasource(plan.Customproperty15.ValueStringPointer())
-	plan.Customproperty16 = util.SafeStringDatasource(plan.Customproperty16.ValueStringPointer())
-	plan.Customproperty17 = util.SafeStringDatasource(plan.Customproperty17.ValueStringPointer())
-	plan.Customproperty18 = util.SafeStringDatasource(plan.Customproperty18.ValueStringPointer())
-	plan.Customproperty19 = util.SafeStringDatasource(plan.Customproperty19.ValueStringPointer())
-	plan.Customproperty20 = util.SafeStringDatasource(plan.Customproperty20.ValueStringPointer())
-	plan.Customproperty21 = util.SafeStringDatasource(plan.Customproperty21.ValueStringPointer())
-	plan.Customproperty22 = util.SafeStringDatasource(plan.Customproperty22.ValueStringPointer())
-	plan.Customproperty23 = util.SafeStringDatasource(plan.Customproperty23.ValueStringPointer())
-	plan.Customproperty24 = util.SafeStringDatasource(plan.Customproperty24.ValueStringPointer())
-	plan.Customproperty25 = util.SafeStringDatasource(plan.Customproperty25.ValueStringPointer())
-	plan.Customproperty26 = util.SafeStringDatasource(plan.Customproperty26.ValueStringPointer())
-	plan.Customproperty27 = util.SafeStringDatasource(plan.Customproperty27.ValueStringPointer())
-	plan.Customproperty28 = util.SafeStringDatasource(plan.Customproperty28.ValueStringPointer())
-	plan.Customproperty29 = util.SafeStringDatasource(plan.Customproperty29.ValueStringPointer())
-	plan.Customproperty30 = util.SafeStringDatasource(plan.Customproperty30.ValueStringPointer())
-	plan.Customproperty31 = util.SafeStringDatasource(plan.Customproperty31.ValueStringPointer())
-	plan.Customproperty32 = util.SafeStringDatasource(plan.Customproperty32.ValueStringPointer())
-	plan.Customproperty33 = util.SafeStringDatasource(plan.Customproperty33.ValueStringPointer())
-	plan.Customproperty34 = util.SafeStringDatasource(plan.Customproperty34.ValueStringPointer())
-	plan.Customproperty35 = util.SafeStringDatasource(plan.Customproperty35.ValueStringPointer())
-	plan.Customproperty36 = util.SafeStringDatasource(plan.Customproperty36.ValueStringPointer())
-	plan.Customproperty37 = util.SafeStringDatasource(plan.Customproperty37.ValueStringPointer())
-	plan.Customproperty38 = util.SafeStringDatasource(plan.Customproperty38.ValueStringPointer())
-	plan.Customproperty39 = util.SafeStringDatasource(plan.Customproperty39.ValueStringPointer())
-	plan.Customproperty40 = util.SafeStringDatasource(plan.Customproperty40.ValueStringPointer())
+	// Custom properties that were not configured are read back on the next refresh
+	if plan.CustomProperties.IsUnknown() {
+		plan.CustomProperties = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 }
 
 // ReadEntitlement reads an entitlement from the API
@@ -653,8 +655,9 @@ func (r *EntitlementResource) ReadEntitlement(ctx context.Context, state *Entitl
 	return readResp, nil
 }
 
-// PopulateStateFromAPI populates the state model from API response
-func (r *EntitlementResource) PopulateStateFromAPI(ctx context.Context, state *EntitlementResourceModel, entitlement openapi.GetEntitlementResponseEntitlementdetailsInner) {
+// PopulateStateFromAPI populates the state model from API response. labels resolves custom
+// properties that state sets by label and may be nil.
+func (r *EntitlementResource) PopulateStateFromAPI(ctx context.Context, state *EntitlementResourceModel, entitlement openapi.GetEntitlementResponseEntitlementdetailsInner, labels CustomPropertyLabels) {
 	// Set basic fields
 	state.ID = util.SafeString(entitlement.EntitlementValuekey)
 	state.Endpoint = util.SafeString(entitlement.Endpoint)
@@ -678,27 +681,8 @@ func (r *EntitlementResource) PopulateStateFromAPI(ctx context.Context, state *E
 	state.Module = util.SafeString(entitlement.Module)
 	state.Access = util.SafeString(entitlement.Access)
 
-	// Handle custom properties 1-20 (CustomProperty format)
-	for i := 1; i <= 20; i++ {
-		fieldName := fmt.Sprintf("CustomProperty%d", i)
-		if field := reflect.ValueOf(&entitlement).Elem().FieldByName(fieldName); field.IsValid() && !field.IsNil() {
-			planField := reflect.ValueOf(state).Elem().FieldByName(fmt.Sprintf("Customproperty%d", i))
-			if planField.IsValid() {
-				planField.Set(reflect.ValueOf(util.SafeString(field.Interface().(*string))))
-			}
-		}
-	}
-
-	// Handle custom properties 21-40 (Customproperty format)
-	for i := 21; i <= 40; i++ {
-		fieldName := fmt.Sprintf("Customproperty%d", i)
-		if field := reflect.ValueOf(&entitlement).Elem().FieldByName(fieldName); field.IsValid() && !field.IsNil() {
-			planField := reflect.ValueOf(state).Elem().FieldByName(fmt.Sprintf("Customproperty%d", i))
-			if planField.IsValid() {
-				planField.Set(reflect.ValueOf(util.SafeString(field.Interface().(*string))))
-			}
-		}
-	}
+	// Custom properties 1-20 are named CustomPropertyN and 21-40 CustompropertyN in the response
+	state.CustomProperties, _ = CustomPropertiesValue(ctx, CustomPropertyFields(&entitlement, entitlementCustomPropertyCount), state.CustomProperties, labels)
 }
 
 // ProcessEntitlementOwnersForEntitlementRead processes entitlement owners during read operations
@@ -779,6 +763,9 @@ func (r *EntitlementResource) ProcessEntitlementOwnersForEntitlementRead(ctx con
 
 // ProcessEntitlementMapForEntitlementRead processes entitlement map during read operations
 func (r *EntitlementResource) ProcessEntitlementMapForEntitlementRead(ctx context.Context, state *EntitlementResourceModel, entitlement openapi.GetEntitlementResponseEntitlementdetailsInner, isImportOrPostUpdate bool) error {
+	if !entitlementManagesMap(state) {
+		return nil
+	}
 	if isImportOrPostUpdate {
 		// True import: Import all maps from API
 		if len(entitlement.EntitlementMapDetails) > 0 {
@@ -979,48 +966,6 @@ func (r *EntitlementResource) BuildUpdateEntitlementRequest(plan *EntitlementRes
 	updateReq.Priority = util.Int32PointerOrEmpty(plan.Priority)
 	updateReq.Confidentiality = util.Int32PointerOrEmpty(plan.Confidentiality)
 
-	// Custom properties
-	updateReq.Customproperty1 = util.StringPointerOrEmpty(plan.Customproperty1)
-	updateReq.Customproperty2 = util.StringPointerOrEmpty(plan.Customproperty2)
-	updateReq.Customproperty3 = util.StringPointerOrEmpty(plan.Customproperty3)
-	updateReq.Customproperty4 = util.StringPointerOrEmpty(plan.Customproperty4)
-	updateReq.Customproperty5 = util.StringPointerOrEmpty(plan.Customproperty5)
-	updateReq.Customproperty6 = util.StringPointerOrEmpty(plan.Customproperty6)
-	updateReq.Customproperty7 = util.StringPointerOrEmpty(plan.Customproperty7)
-	updateReq.Customproperty8 = util.StringPointerOrEmpty(plan.Customproperty8)
-	updateReq.Customproperty9 = util.StringPointerOrEmpty(plan.Customproperty9)
-	updateReq.Customproperty10 = util.StringPointerOrEmpty(plan.Customproperty10)
-	updateReq.Customproperty11 = util.StringPointerOrEmpty(plan.Customproperty11)
-	updateReq.Customproperty12 = util.StringPointerOrEmpty(plan.Customproperty12)
-	updateReq.Customproperty13 = util.StringPointerOrEmpty(plan.Customproperty13)
-	updateReq.Customproperty14 = util.StringPointerOrEmpty(plan.Customproperty14)
-	updateReq.Customproperty15 = util.StringPointerOrEmpty(plan.Customproperty15)
-	updateReq.Customproperty16 = util.StringPointerOrEmpty(plan.Customproperty16)
-	updateReq.Customproperty17 = util.StringPointerOrEmpty(plan.Customproperty17)
-	updateReq.Customproperty18 = util.StringPointerOrEmpty(plan.Customproperty18)
-	updateReq.Customproperty19 = util.StringPointerOrEmpty(plan.Customproperty19)
-	updateReq.Customproperty20 = util.StringPointerOrEmpty(plan.Customproperty20)
-	updateReq.Customproperty21 = util.StringPointerOrEmpty(plan.Customproperty21)
-	updateReq.Customproperty22 = util.StringPointerOrEmpty(plan.Customproperty22)
-	updateReq.Customproperty23 = util.StringPointerOrEmpty(plan.Customproperty23)
-	updateReq.Customproperty24 = util.StringPointerOrEmpty(plan.Customproperty24)
-	updateReq.Customproperty25 = util.StringPointerOrEmpty(plan.Customproperty25)
-	updateReq.Customproperty26 = util.StringPointerOrEmpty(plan.Customproperty26)
-	updateReq.Customproperty27 = util.StringPointerOrEmpty(plan.Customproperty27)
-	updateReq.Customproperty28 = util.StringPointerOrEmpty(plan.Customproperty28)
-	updateReq.Customproperty29 = util.StringPointerOrEmpty(plan.Customproperty29)
-	updateReq.Customproperty30 = util.StringPointerOrEmpty(plan.Customproperty30)
-	updateReq.Customproperty31 = util.StringPointerOrEmpty(plan.Customproperty31)
-	updateReq.Customproperty32 = util.StringPointerOrEmpty(plan.Customproperty32)
-	updateReq.Customproperty33 = util.StringPointerOrEmpty(plan.Customproperty33)
-	updateReq.Customproperty34 = util.StringPointerOrEmpty(plan.Customproperty34)
-	updateReq.Customproperty35 = util.StringPointerOrEmpty(plan.Customproperty35)
-	updateReq.Customproperty36 = util.StringPointerOrEmpty(plan.Customproperty36)
-	updateReq.Customproperty37 = util.StringPointerOrEmpty(plan.Customproperty37)
-	updateReq.Customproperty38 = util.StringPointerOrEmpty(plan.Customproperty38)
-	updateReq.Customproperty39 = util.StringPointerOrEmpty(plan.Customproperty39)
-	updateReq.Customproperty40 = util.StringPointerOrEmpty(plan.Customproperty40)
-
 	if !plan.EntitlementValue.Equal(state.EntitlementValue) {
 		updateReq.UpdatedentitlementValue = util.StringPointerOrEmpty(plan.EntitlementValue)
 	}
@@ -1079,7 +1024,7 @@ func (r *EntitlementResource) ProcessEntitlementOwnersForEntitlementUpdate(ctx c
 
 // ProcessEntitlementMapForEntitlementUpdate processes entitlement map for update requests
 func (r *EntitlementResource) ProcessEntitlementMapForEntitlementUpdate(ctx context.Context, plan *EntitlementResourceModel, state *EntitlementResourceModel, updateReq *openapi.CreateUpdateEntitlementRequest) {
-	if !plan.EntitlementMap.IsNull() || !state.EntitlementMap.IsNull() {
+	if entitlementManagesMap(plan) && (!plan.EntitlementMap.IsNull() || !state.EntitlementMap.IsNull()) {
 		var planMaps, stateMaps []EntitlementMapModel
 
 		if !plan.EntitlementMap.IsNull() {
@@ -1158,6 +1103,15 @@ func (r *EntitlementResource) UpdateEntitlement(ctx context.Context, plan *Entit
 	log.Printf("[DEBUG] Entitlements: Starting updation for entitlement id: %s", plan.EntitlementValuekey.ValueString())
 
 	updateReq := r.BuildUpdateEntitlementRequest(plan, state)
+	labels, err := r.CustomPropertyLabels(ctx, plan, plan.CustomProperties, state.CustomProperties)
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom property labels: %v", err)
+	}
+	customProperties, diags := CustomPropertyChanges(ctx, plan.CustomProperties, state.CustomProperties, labels)
+	if diags.HasError() {
+		return nil, fmt.Errorf("invalid custom_properties: %v", diags.Errors())
+	}
+	SetCustomPropertyFields(updateReq, customProperties)
 	r.ProcessEntitlementOwnersForEntitlementUpdate(ctx, plan, state, updateReq)
 	r.ProcessEntitlementMapForEntitlementUpdate(ctx, plan, state, updateReq)
 
@@ -1171,7 +1125,7 @@ func (r *EntitlementResource) UpdateEntitlement(ctx context.Context, plan *Entit
 	// Execute update operation with retry logic
 	var updateResp *openapi.CreateOrUpdateEntitlementResponse
 	var updateHttpResp *http.Response
-	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_entitlement", func(token string) error {
+	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "update_entitlement", func(token string) error {
 		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
 		resp, hResp, err := entitlementOps.CreateUpdateEntitlement(ctx, *updateReq)
 		if hResp != nil && hResp.StatusCode == 401 {
@@ -1209,11 +1163,16 @@ func (r *EntitlementResource) ReadEntitlementState(ctx context.Context, plan *En
 	if readResp != nil && len(readResp.Entitlementdetails) > 0 {
 		entitlement := readResp.Entitlementdetails[0]
 
+		labels, err := r.CustomPropertyLabels(ctx, plan, plan.CustomProperties)
+		if err != nil {
+			return fmt.Errorf("error reading custom property labels: %v", err)
+		}
+
 		// Populate basic fields from API response
-		r.PopulateStateFromAPI(ctx, plan, entitlement)
+		r.PopulateStateFromAPI(ctx, plan, entitlement, labels)
 
 		// Handle entitlement owners (post-update, so treat as import)
-		err := r.ProcessEntitlementOwnersForEntitlementRead(ctx, plan, entitlement, true)
+		err = r.ProcessEntitlementOwnersForEntitlementRead(ctx, plan, entitlement, true)
 		if err != nil {
 			// Handle failures when processing entitlement owners during post-update read
 			return fmt.Errorf("error processing entitlement owners: %v", err)
@@ -1377,11 +1336,17 @@ func (r *EntitlementResource) Read(ctx context.Context, req resource.ReadRequest
 	if readResp != nil && len(readResp.Entitlementdetails) > 0 {
 		entitlement := readResp.Entitlementdetails[0]
 
+		labels, err := r.CustomPropertyLabels(ctx, &state, state.CustomProperties)
+		if err != nil {
+			resp.Diagnostics.AddError("Error in reading custom property labels", err.Error())
+			return
+		}
+
 		// Populate basic fields from API response
-		r.PopulateStateFromAPI(ctx, &state, entitlement)
+		r.PopulateStateFromAPI(ctx, &state, entitlement, labels)
 
 		// Handle entitlement owners
-		err := r.ProcessEntitlementOwnersForEntitlementRead(ctx, &state, entitlement, isImport)
+		err = r.ProcessEntitlementOwnersForEntitlementRead(ctx, &state, entitlement, isImport)
 		if err != nil {
 			resp.Diagnostics.AddError("Entitlement Owner Drift Detected", err.Error())
 			return
@@ -1446,16 +1411,114 @@ func (r *EntitlementResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(stateUpdateDiagnostics...)
 }
 
+// Saviynt does not support deleting entitlements. Delete follows the deletion_policy of the entitlement.
 func (r *EntitlementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// resp.State.RemoveResource(ctx)
-	if os.Getenv("TF_ACC") == "1" {
-		resp.State.RemoveResource(ctx)
+	var state EntitlementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		log.Printf("[ERROR] Entitlements: Failed to get state in Delete Block: %v", resp.Diagnostics)
 		return
 	}
-	resp.Diagnostics.AddError(
-		"Delete Not Supported",
-		"Resource deletion is not supported by this provider. Please remove the resource manually if required, or contact your administrator.",
+
+	entitlementValue := state.EntitlementValue.ValueString()
+	switch ResolveDeletionPolicy(state.DeletionPolicy) {
+	case DeletionPolicyAbandon:
+		log.Printf("[INFO] Entitlements: Abandoning entitlement %s, removing it from state only", entitlementValue)
+		resp.State.RemoveResource(ctx)
+	case DeletionPolicyDeactivate:
+		if err := r.DeactivateEntitlement(ctx, &state); err != nil {
+			resp.Diagnostics.AddError("Entitlement Deactivation Failed", err.Error())
+			return
+		}
+		resp.State.RemoveResource(ctx)
+	default:
+		resp.Diagnostics.AddError(
+			"Delete Not Supported",
+			fmt.Sprintf("Entitlement %s cannot be deleted in Saviynt. Set deletion_policy to \"abandon\" to remove it from state only, "+
+				"or to \"deactivate\" to set it inactive, and apply before destroying it.", entitlementValue),
+		)
+	}
+}
+
+// BuildDeactivateEntitlementRequest builds the request that sets the entitlement of state inactive,
+// reassigns it to the deactivation owner and appends the deactivation note to its description.
+func (r *EntitlementResource) BuildDeactivateEntitlementRequest(ctx context.Context, state *EntitlementResourceModel) *openapi.CreateUpdateEntitlementRequest {
+	deactivateReq := openapi.NewCreateUpdateEntitlementRequest(
+		state.Endpoint.ValueString(),
+		state.Entitlementtype.ValueString(),
+		state.EntitlementValue.ValueString(),
 	)
+	deactivateReq.Entitlementcasecheck = util.StringPtr("true")
+	deactivateReq.SetStatus(EntitlementStatusInactive)
+
+	if note := state.DeactivationNote.ValueString(); note != "" {
+		description := state.Description.ValueString()
+		if description != "" {
+			description += "\n"
+		}
+		deactivateReq.SetDescription(description + note)
+	}
+
+	if owner := state.DeactivationOwner.ValueString(); owner != "" {
+		var owners map[string][]string
+		if !state.EntitlementOwners.IsNull() && !state.EntitlementOwners.IsUnknown() {
+			state.EntitlementOwners.ElementsAs(ctx, &owners, false)
+		}
+		rankOne := []string{owner + "##add"}
+		for _, user := range owners["rank_1"] {
+			if user != owner {
+				rankOne = append(rankOne, user+"##remove")
+			}
+		}
+		deactivateReq.Entitlementowner1 = rankOne
+	}
+
+	return deactivateReq
+}
+
+// DeactivateEntitlement sets the entitlement of state inactive as described by its deletion policy
+func (r *EntitlementResource) DeactivateEntitlement(ctx context.Context, state *EntitlementResourceModel) error {
+	entitlementValue := state.EntitlementValue.ValueString()
+	deactivateReq := r.BuildDeactivateEntitlementRequest(ctx, state)
+
+	var apiResp *openapi.CreateOrUpdateEntitlementResponse
+	var finalHttpResp *http.Response
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "deactivate_entitlement", func(token string) error {
+		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := entitlementOps.CreateUpdateEntitlement(ctx, *deactivateReq)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+
+	if err != nil {
+		log.Printf("[ERROR] Entitlements: Problem with deactivating entitlement %s. Error: %v", entitlementValue, err)
+		err = errorsutil.HandleHTTPError(finalHttpResp, err, "Update")
+		return fmt.Errorf("error deactivating entitlement %s: %v", entitlementValue, err)
+	}
+	if apiResp != nil && apiResp.ErrorCode != nil && *apiResp.ErrorCode == "1" {
+		msg := util.SafeDeref(apiResp.Msg)
+		log.Printf("[ERROR] Entitlements: Error deactivating entitlement %s: %v", entitlementValue, msg)
+		return fmt.Errorf("error deactivating entitlement %s: %v", entitlementValue, msg)
+	}
+
+	log.Printf("[INFO] Entitlements: Entitlement %s deactivated", entitlementValue)
+	return nil
+}
+
+// entitlementDeactivationSummary describes in the plan what deactivating the entitlement of state changes
+func entitlementDeactivationSummary(state *EntitlementResourceModel) string {
+	summary := "sets its status to inactive"
+	if owner := state.DeactivationOwner.ValueString(); owner != "" {
+		summary += fmt.Sprintf(", makes %s its rank 1 owner", owner)
+	}
+	if note := state.DeactivationNote.ValueString(); note != "" {
+		summary += fmt.Sprintf(", appends %q to its description", note)
+	}
+	return summary + " and removes it from state"
 }
 
 func (r *EntitlementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1489,4 +1552,47 @@ func (r *EntitlementResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint"), endpointName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entitlement_type"), entitlementType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entitlement_value"), entitlementName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manage_entitlement_map"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_policy"), DeletionPolicyError)...)
+}
+
+// ValidateConfig rejects entitlement_map on entitlements whose mappings are managed by saviynt_entitlement_map,
+// and deactivation settings without the deactivate deletion policy.
+func (r *EntitlementResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EntitlementResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ManageEntitlementMap.IsNull() && !config.ManageEntitlementMap.IsUnknown() && !config.ManageEntitlementMap.ValueBool() && !config.EntitlementMap.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entitlement_map"),
+			"Conflicting Entitlement Map Configuration",
+			"entitlement_map cannot be set when manage_entitlement_map is false. Manage the mappings with saviynt_entitlement_map instead.",
+		)
+	}
+
+	// deactivation_owner and deactivation_note only take effect with the deactivate policy
+	if config.DeletionPolicy.IsUnknown() || config.DeletionPolicy.ValueString() == DeletionPolicyDeactivate {
+		return
+	}
+	for name, value := range map[string]types.String{
+		"deactivation_owner": config.DeactivationOwner,
+		"deactivation_note":  config.DeactivationNote,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting Deletion Policy Configuration",
+				fmt.Sprintf("%s can only be set when deletion_policy is \"deactivate\".", name),
+			)
+		}
+	}
+}
+
+// entitlementManagesMap reports whether the entitlement resource owns the entitlement mappings.
+// Unset values, for example in state written before manage_entitlement_map existed, count as managed.
+func entitlementManagesMap(model *EntitlementResourceModel) bool {
+	return model.ManageEntitlementMap.IsNull() || model.ManageEntitlementMap.IsUnknown() || model.ManageEntitlementMap.ValueBool()
 }
@@ -17,6 +17,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"reflect"
 	"strings"
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
@@ -1298,3 +1299,40 @@ func (r *EntitlementTypeResource) NormalizeEntitlementTypeState(plan *Entitlemen
 
 	log.Printf("[DEBUG] State normalization completed")
 }
+
+// ReadEntitlementTypeCustomPropertyLabels returns the custom property labels configured for an
+// entitlement type of an endpoint. These are the labels of the custom properties of its entitlements.
+func ReadEntitlementTypeCustomPropertyLabels(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.EntitlementTypeFactoryInterface, endpointName, entitlementTypeName string) (CustomPropertyLabels, error) {
+	var readResp *openapi.GetEntitlementTypeResponse
+	err := provider.AuthenticatedAPICallWithRetry(ctx, "read_entitlement_type_labels", func(token string) error {
+		entitlementTypeOps := factory.CreateEntitlementTypeOperations(apiClient.APIBaseURL(), token)
+		resp, httpResp, err := entitlementTypeOps.GetEntitlementType(ctx, entitlementTypeName, "", "", endpointName)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		readResp = resp
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("API call failed: %w", err)
+	}
+	if readResp != nil && readResp.ErrorCode != nil && *readResp.ErrorCode != "0" {
+		return nil, fmt.Errorf("API Read Failed with error code %s and message: %s", util.SafeDeref(readResp.ErrorCode), util.SafeDeref(readResp.Msg))
+	}
+
+	for _, details := range readResp.GetEntitlementTypeDetails() {
+		if !strings.EqualFold(util.SafeDeref(details.Entitlementname), entitlementTypeName) {
+			continue
+		}
+		labels := make(CustomPropertyLabels)
+		value := reflect.ValueOf(details)
+		for index := 1; index <= entitlementCustomPropertyCount; index++ {
+			field := value.FieldByName(fmt.Sprintf("Customproperty%dLabel", index))
+			if label, ok := field.Interface().(*string); ok && label != nil && strings.TrimSpace(*label) != "" {
+				labels[index] = *label
+			}
+		}
+		return labels, nil
+	}
+	return nil, fmt.Errorf("no entitlement type %s found for endpoint %s", entitlementTypeName, endpointName)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/provider/validators"
+	"terraform-provider-Saviynt/util"
+
+	openapi "github.com/saviynt/saviynt-api-go-client/entitlements"
+)
+
+// BulkEntitlementItem is one entitlement of a saviynt_entitlements_bulk input. Fields that are nil
+// are not sent to Saviynt and keep their current value.
+type BulkEntitlementItem struct {
+	EntitlementValue    string         `json:"entitlement_value"`
+	Displayname         *string        `json:"displayname,omitempty"`
+	Description         *string        `json:"description,omitempty"`
+	EntitlementGlossary *string        `json:"entitlement_glossary,omitempty"`
+	Module              *string        `json:"module,omitempty"`
+	Access              *string        `json:"access,omitempty"`
+	Risk                *int32         `json:"risk,omitempty"`
+	Status              *int32         `json:"status,omitempty"`
+	Soxcritical         *int32         `json:"soxcritical,omitempty"`
+	Syscritical         *int32         `json:"syscritical,omitempty"`
+	Privileged          *int32         `json:"privileged,omitempty"`
+	Priority            *int32         `json:"priority,omitempty"`
+	Confidentiality     *int32         `json:"confidentiality,omitempty"`
+	CustomProperties    map[int]string `json:"custom_properties,omitempty"`
+}
+
+// bulkEntitlementHashLength is the number of hex characters of the SHA256 checksum kept per item
+const bulkEntitlementHashLength = 16
+
+// Hash returns a short checksum of the item. Items with the same attributes have the same hash.
+func (i BulkEntitlementItem) Hash() string {
+	// encoding/json writes struct fields in order and map keys sorted, so the encoding is canonical
+	data, _ := json.Marshal(i)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:bulkEntitlementHashLength]
+}
+
+// Request builds the create or update entitlement request of the item.
+func (i BulkEntitlementItem) Request(endpoint, entitlementType string) openapi.CreateUpdateEntitlementRequest {
+	req := openapi.CreateUpdateEntitlementRequest{
+		Endpoint:             endpoint,
+		Entitlementtype:      entitlementType,
+		EntitlementValue:     i.EntitlementValue,
+		Entitlementcasecheck: util.StringPtr("true"),
+		Displayname:          i.Displayname,
+		Description:          i.Description,
+		EntitlementGlossary:  i.EntitlementGlossary,
+		Module:               i.Module,
+		Access:               i.Access,
+		Risk:                 i.Risk,
+		Status:               i.Status,
+		Soxcritical:          i.Soxcritical,
+		Syscritical:          i.Syscritical,
+		Priviliged:           i.Privileged,
+		Priority:             i.Priority,
+		Confidentiality:      i.Confidentiality,
+	}
+	SetCustomPropertyFields(&req, i.CustomProperties)
+	return req
+}
+
+// BulkEntitlementItemFromAPI builds an item from an entitlement read from Saviynt with the fields
+// that are set in input, so that its hash matches the hash of input when Saviynt holds the input
+// values. Fields that input leaves unset are not managed and are left out.
+func BulkEntitlementItemFromAPI(entitlement openapi.GetEntitlementResponseEntitlementdetailsInner, input BulkEntitlementItem) BulkEntitlementItem {
+	text := func(set *string, value *string) *string {
+		if set == nil {
+			return nil
+		}
+		v := util.SafeDeref(value)
+		return &v
+	}
+	number := func(set *int32, value *string) *int32 {
+		if set == nil {
+			return nil
+		}
+		return util.StringPtrToInt32Ptr(value)
+	}
+
+	item := BulkEntitlementItem{
+		EntitlementValue:    input.EntitlementValue,
+		Displayname:         text(input.Displayname, entitlement.Displayname),
+		Description:         text(input.Description, entitlement.Description),
+		EntitlementGlossary: text(input.EntitlementGlossary, entitlement.EntitlementGlossary),
+		Module:              text(input.Module, entitlement.Module),
+		Access:              text(input.Access, entitlement.Access),
+		Risk:                number(input.Risk, entitlement.Risk),
+		Status:              number(input.Status, entitlement.Status),
+		Soxcritical:         number(input.Soxcritical, entitlement.Soxcritical),
+		Syscritical:         number(input.Syscritical, entitlement.Syscritical),
+		Privileged:          number(input.Privileged, entitlement.Priviliged),
+		Priority:            number(input.Priority, entitlement.Priority),
+		Confidentiality:     number(input.Confidentiality, entitlement.Confidentiality),
+	}
+	if len(input.CustomProperties) > 0 {
+		fields := CustomPropertyFields(&entitlement, entitlementCustomPropertyCount)
+		item.CustomProperties = make(map[int]string, len(input.CustomProperties))
+		for index := range input.CustomProperties {
+			item.CustomProperties[index] = util.SafeDeref(fields[index])
+		}
+	}
+	return item
+}
+
+// ReadBulkEntitlementFile reads the entitlements of a CSV or JSON file, chosen by the extension of
+// filePath.
+func ReadBulkEntitlementFile(filePath string) ([]BulkEntitlementItem, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		return ParseBulkEntitlementCSV(bytes.NewReader(data))
+	case ".json":
+		return ParseBulkEntitlementJSON(data)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q, expected .csv or .json", filepath.Ext(filePath))
+	}
+}
+
+// ParseBulkEntitlementCSV parses entitlements from CSV with a header row. Columns are named after
+// the attributes of an entitlement; custom properties use their number, for example
+// "custom_property3". Empty cells leave the attribute unset.
+func ParseBulkEntitlementCSV(r io.Reader) ([]BulkEntitlementItem, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")))
+	}
+	hasValue := false
+	for _, column := range header {
+		if column == "entitlement_value" {
+			hasValue = true
+		} else if _, ok := bulkEntitlementFields[column]; !ok {
+			if _, ok := validators.CustomPropertyIndex(column); !ok {
+				return nil, fmt.Errorf("unknown CSV column %q", column)
+			}
+		}
+	}
+	if !hasValue {
+		return nil, fmt.Errorf("the CSV header has no entitlement_value column")
+	}
+
+	var items []BulkEntitlementItem
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		values := make(map[string]string, len(record))
+		for i, value := range record {
+			if value = strings.TrimSpace(value); value != "" {
+				values[header[i]] = value
+			}
+		}
+		item, err := bulkEntitlementItemFromStrings(values)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ParseBulkEntitlementJSON parses entitlements from a JSON array of objects with the attributes of
+// an entitlement. Custom properties are an object keyed by property number.
+func ParseBulkEntitlementJSON(data []byte) ([]BulkEntitlementItem, error) {
+	var raw []struct {
+		BulkEntitlementItem
+		CustomProperties map[string]string `json:"custom_properties"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	items := make([]BulkEntitlementItem, 0, len(raw))
+	for n, entry := range raw {
+		item := entry.BulkEntitlementItem
+		if strings.TrimSpace(item.EntitlementValue) == "" {
+			return nil, fmt.Errorf("entry %d: entitlement_value is required", n)
+		}
+		for key, value := range entry.CustomProperties {
+			index, ok := validators.CustomPropertyIndex(key)
+			if !ok || index < 1 || index > entitlementCustomPropertyCount {
+				return nil, fmt.Errorf("entry %d: %q is not a custom property", n, key)
+			}
+			if item.CustomProperties == nil {
+				item.CustomProperties = make(map[int]string)
+			}
+			item.CustomProperties[index] = value
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// bulkEntitlementFields maps the optional CSV columns to the item fields they set
+var bulkEntitlementFields = map[string]func(item *BulkEntitlementItem, value string) error{
+	"displayname":          func(i *BulkEntitlementItem, v string) error { i.Displayname = &v; return nil },
+	"description":          func(i *BulkEntitlementItem, v string) error { i.Description = &v; return nil },
+	"entitlement_glossary": func(i *BulkEntitlementItem, v string) error { i.EntitlementGlossary = &v; return nil },
+	"module":               func(i *BulkEntitlementItem, v string) error { i.Module = &v; return nil },
+	"access":               func(i *BulkEntitlementItem, v string) error { i.Access = &v; return nil },
+	"risk":                 func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Risk, v) },
+	"status":               func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Status, v) },
+	"soxcritical":          func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Soxcritical, v) },
+	"syscritical":          func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Syscritical, v) },
+	"privileged":           func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Privileged, v) },
+	"priority":             func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Priority, v) },
+	"confidentiality":      func(i *BulkEntitlementItem, v string) error { return parseBulkInt32(&i.Confidentiality, v) },
+}
+
+func parseBulkInt32(target **int32, value string) error {
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("%q is not a whole number", value)
+	}
+	v := int32(n)
+	*target = &v
+	return nil
+}
+
+func bulkEntitlementItemFromStrings(values map[string]string) (BulkEntitlementItem, error) {
+	item := BulkEntitlementItem{EntitlementValue: values["entitlement_value"]}
+	if item.EntitlementValue == "" {
+		return item, fmt.Errorf("entitlement_value is required")
+	}
+	for column, value := range values {
+		if column == "entitlement_value" {
+			continue
+		}
+		if set, ok := bulkEntitlementFields[column]; ok {
+			if err := set(&item, value); err != nil {
+				return item, fmt.Errorf("%s: %w", column, err)
+			}
+			continue
+		}
+		index, _ := validators.CustomPropertyIndex(column)
+		if index < 1 || index > entitlementCustomPropertyCount {
+			return item, fmt.Errorf("%q is not a custom property", column)
+		}
+		if item.CustomProperties == nil {
+			item.CustomProperties = make(map[int]string)
+		}
+		item.CustomProperties[index] = value
+	}
+	return item, nil
+}
@@ -0,0 +1,842 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_entitlements_bulk manages many entitlements of one endpoint and entitlement type as a
+// single resource. The entitlements come from the entitlements attribute or from a CSV or JSON file.
+// The resource implements the full Terraform lifecycle:
+//   - Create: creates or updates every entitlement with batched create or update entitlement calls.
+//   - Read: drops entitlements that no longer exist in Saviynt from state so that they are recreated,
+//     and records the hash of the values read for the others so that changes made in Saviynt show up.
+//   - Update: sends only the entitlements whose attributes changed since the last apply and, with
+//     deletion_policy 'deactivate', deactivates the entitlements removed from the input.
+//   - Import: brings the entitlements of an endpoint and entitlement type under Terraform management
+//     using 'endpoint:entitlement_type'.
+//
+// Only a short hash of each entitlement is kept in state, so plans stay fast for thousands of entitlements.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/validators"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/errorsutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/entitlements"
+)
+
+var _ resource.Resource = &EntitlementsBulkResource{}
+var _ resource.ResourceWithImportState = &EntitlementsBulkResource{}
+var _ resource.ResourceWithModifyPlan = &EntitlementsBulkResource{}
+
+const (
+	// DefaultBulkBatchSize is the default number of entitlements sent before failures are checked
+	DefaultBulkBatchSize = 100
+	// DefaultBulkParallelism is the default number of concurrent create or update entitlement calls
+	DefaultBulkParallelism = 4
+	// bulkReadPageSize is the number of entitlements read per call when refreshing
+	bulkReadPageSize = 500
+	// bulkErrorsReported caps the number of failed entitlements listed in an error
+	bulkErrorsReported = 10
+)
+
+// EntitlementsBulkResourceModel defines the state for the bulk entitlements resource.
+type EntitlementsBulkResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	EntitlementType   types.String `tfsdk:"entitlement_type"`
+	Entitlements      types.Set    `tfsdk:"entitlements"`
+	FilePath          types.String `tfsdk:"file_path"`
+	BatchSize         types.Int64  `tfsdk:"batch_size"`
+	Parallelism       types.Int64  `tfsdk:"parallelism"`
+	EntitlementHashes types.Map    `tfsdk:"entitlement_hashes"`
+	DeletionPolicy    types.String `tfsdk:"deletion_policy"`
+}
+
+// BulkEntitlementModel is one element of the entitlements attribute.
+type BulkEntitlementModel struct {
+	EntitlementValue    types.String `tfsdk:"entitlement_value"`
+	Displayname         types.String `tfsdk:"displayname"`
+	Description         types.String `tfsdk:"description"`
+	EntitlementGlossary types.String `tfsdk:"entitlement_glossary"`
+	Module              types.String `tfsdk:"module"`
+	Access              types.String `tfsdk:"access"`
+	Risk                types.Int32  `tfsdk:"risk"`
+	Status              types.Int32  `tfsdk:"status"`
+	Soxcritical         types.Int32  `tfsdk:"soxcritical"`
+	Syscritical         types.Int32  `tfsdk:"syscritical"`
+	Privileged          types.Int32  `tfsdk:"privileged"`
+	Priority            types.Int32  `tfsdk:"priority"`
+	Confidentiality     types.Int32  `tfsdk:"confidentiality"`
+	CustomProperties    types.Map    `tfsdk:"custom_properties"`
+}
+
+// EntitlementsBulkResource implements the resource.Resource interface for bulk entitlements.
+type EntitlementsBulkResource struct {
+	client             client.SaviyntClientInterface
+	token              string
+	provider           client.SaviyntProviderInterface
+	entitlementFactory client.EntitlementFactoryInterface
+}
+
+// NewEntitlementsBulkResource creates a new instance of the bulk entitlements resource.
+func NewEntitlementsBulkResource() resource.Resource {
+	return &EntitlementsBulkResource{
+		entitlementFactory: &client.DefaultEntitlementFactory{},
+	}
+}
+
+func NewEntitlementsBulkResourceWithFactory(factory client.EntitlementFactoryInterface) resource.Resource {
+	return &EntitlementsBulkResource{
+		entitlementFactory: factory,
+	}
+}
+
+func (r *EntitlementsBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entitlements_bulk"
+}
+
+func (r *EntitlementsBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	// With 'deactivate', entitlements removed from the input are deactivated on apply as well
+	deletionPolicy := DeletionPolicySchema("sets the status of every entitlement in 'entitlement_hashes' to inactive in batches,")
+	deletionPolicy.Description += " With 'deactivate', entitlements removed from the input are also set inactive when the change is applied; " +
+		"with the other policies they are left unchanged in Saviynt."
+	resp.Schema = schema.Schema{
+		Description: util.EntitlementsBulkDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the resource in the format 'endpoint:entitlement_type'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the endpoint of the entitlements.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlement_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the entitlement type of the entitlements.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlements": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Entitlements to create or update. Attributes that are not set keep their value in Saviynt. Exactly one of 'entitlements' and 'file_path' must be set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entitlement_value": schema.StringAttribute{
+							Required:    true,
+							Description: "Value of the entitlement.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"displayname":          schema.StringAttribute{Optional: true, Description: "Display name of the entitlement."},
+						"description":          schema.StringAttribute{Optional: true, Description: "Description of the entitlement."},
+						"entitlement_glossary": schema.StringAttribute{Optional: true, Description: "Glossary or additional information about the entitlement."},
+						"module":               schema.StringAttribute{Optional: true, Description: "Functional module the entitlement belongs to."},
+						"access":               schema.StringAttribute{Optional: true, Description: "Access type or permission level."},
+						"risk":                 schema.Int32Attribute{Optional: true, Description: "Risk level of the entitlement."},
+						"status":               schema.Int32Attribute{Optional: true, Description: "Status of the entitlement."},
+						"soxcritical":          schema.Int32Attribute{Optional: true, Description: "SOX criticality level."},
+						"syscritical":          schema.Int32Attribute{Optional: true, Description: "System criticality level."},
+						"privileged":           schema.Int32Attribute{Optional: true, Description: "Privilege level of the entitlement."},
+						"priority":             schema.Int32Attribute{Optional: true, Description: "Priority of the entitlement."},
+						"confidentiality":      schema.Int32Attribute{Optional: true, Description: "Confidentiality classification level."},
+						"custom_properties": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: fmt.Sprintf("Custom properties keyed by their number from 1 to %d, for example { \"3\" = \"Finance\" }. Properties that are not set keep their value in Saviynt.", entitlementCustomPropertyCount),
+							Validators: []validator.Map{
+								validators.CustomPropertyKeys(entitlementCustomPropertyCount),
+								mapvalidator.ValueStringsAre(validators.NoWhitespaceOnly()),
+							},
+						},
+					},
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a CSV or JSON file with the entitlements, chosen by the .csv or .json extension. A CSV file has a header row " +
+					"with the attribute names of 'entitlements', and custom properties in columns such as 'custom_property3'; empty cells leave " +
+					"the attribute unset. A JSON file holds an array of objects with the attributes of 'entitlements'. The file is read at plan " +
+					"time and must not change before apply.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("entitlements")),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(DefaultBulkBatchSize),
+				Description: fmt.Sprintf("Number of entitlements sent per batch. Processing stops after a batch with failures. Defaults to %d.", DefaultBulkBatchSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(DefaultBulkParallelism),
+				Description: fmt.Sprintf("Number of concurrent create or update entitlement calls within a batch. Defaults to %d.", DefaultBulkParallelism),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"deletion_policy": deletionPolicy,
+			"entitlement_hashes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Short hash of the attributes of each entitlement, keyed by entitlement value. Computed at plan time when the resource exists, so the plan lists the entitlements that change.",
+			},
+		},
+	}
+}
+
+// Configure initializes the bulk entitlements resource with the provider's API client and access token.
+func (r *EntitlementsBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting bulk entitlements resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+	tflog.Debug(ctx, "Bulk entitlements resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *EntitlementsBulkResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *EntitlementsBulkResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *EntitlementsBulkResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+// BulkEntitlementItems returns the entitlements of the model from the entitlements attribute or
+// the input file. known is false when the input is not known yet.
+func BulkEntitlementItems(ctx context.Context, model *EntitlementsBulkResourceModel) (items []BulkEntitlementItem, known bool, diags diag.Diagnostics) {
+	if model.Entitlements.IsUnknown() || model.FilePath.IsUnknown() {
+		return nil, false, diags
+	}
+
+	if !model.FilePath.IsNull() {
+		var err error
+		items, err = ReadBulkEntitlementFile(model.FilePath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("file_path"),
+				"Unable to Read Entitlements File",
+				fmt.Sprintf("Failed to read entitlements from %s: %s", model.FilePath.ValueString(), err),
+			)
+			return nil, false, diags
+		}
+	} else if !model.Entitlements.IsNull() {
+		var entitlements []BulkEntitlementModel
+		diags.Append(model.Entitlements.ElementsAs(ctx, &entitlements, false)...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+		for _, e := range entitlements {
+			if e.EntitlementValue.IsUnknown() || e.CustomProperties.IsUnknown() {
+				return nil, false, diags
+			}
+			customProperties, propertyDiags := CustomPropertyValues(ctx, e.CustomProperties, nil)
+			diags.Append(propertyDiags...)
+			if len(customProperties) == 0 {
+				customProperties = nil
+			}
+			items = append(items, BulkEntitlementItem{
+				EntitlementValue:    e.EntitlementValue.ValueString(),
+				Displayname:         e.Displayname.ValueStringPointer(),
+				Description:         e.Description.ValueStringPointer(),
+				EntitlementGlossary: e.EntitlementGlossary.ValueStringPointer(),
+				Module:              e.Module.ValueStringPointer(),
+				Access:              e.Access.ValueStringPointer(),
+				Risk:                e.Risk.ValueInt32Pointer(),
+				Status:              e.Status.ValueInt32Pointer(),
+				Soxcritical:         e.Soxcritical.ValueInt32Pointer(),
+				Syscritical:         e.Syscritical.ValueInt32Pointer(),
+				Privileged:          e.Privileged.ValueInt32Pointer(),
+				Priority:            e.Priority.ValueInt32Pointer(),
+				Confidentiality:     e.Confidentiality.ValueInt32Pointer(),
+				CustomProperties:    customProperties,
+			})
+		}
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item.EntitlementValue] {
+			diags.AddError(
+				"Duplicate Entitlement",
+				fmt.Sprintf("The entitlement %q is listed more than once. Each entitlement value must appear only once.", item.EntitlementValue),
+			)
+		}
+		seen[item.EntitlementValue] = true
+	}
+	return items, !diags.HasError(), diags
+}
+
+func bulkEntitlementHashes(items []BulkEntitlementItem) map[string]string {
+	hashes := make(map[string]string, len(items))
+	for _, item := range items {
+		hashes[item.EntitlementValue] = item.Hash()
+	}
+	return hashes
+}
+
+func bulkEntitlementID(model *EntitlementsBulkResourceModel) string {
+	return model.Endpoint.ValueString() + ":" + model.EntitlementType.ValueString()
+}
+
+// ModifyPlan reads the input and plans the hash of every entitlement, so that changed entitlements
+// show up in the plan.
+func (r *EntitlementsBulkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		if req.State.Raw.IsNull() {
+			return
+		}
+		var state EntitlementsBulkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name := fmt.Sprintf("the %d entitlement(s) of %s", len(state.EntitlementHashes.Elements()), bulkEntitlementID(&state))
+		AddDeletionPolicyPlanDiagnostics(&resp.Diagnostics, state.DeletionPolicy, name, "sets their status to inactive and removes them from state")
+		return
+	}
+
+	var plan EntitlementsBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, known, diags := BulkEntitlementItems(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// On create the hashes stay unknown, because an apply in which some entitlements fail saves only
+	// the entitlements that were applied
+	if !known || req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entitlement_hashes"), types.MapUnknown(types.StringType))...)
+		return
+	}
+	hashes, diags := types.MapValueFrom(ctx, types.StringType, bulkEntitlementHashes(items))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entitlement_hashes"), hashes)...)
+}
+
+// CreateUpdateEntitlement sends a single entitlement of the bulk input to Saviynt.
+func (r *EntitlementsBulkResource) CreateUpdateEntitlement(ctx context.Context, endpoint, entitlementType string, item BulkEntitlementItem) error {
+	createReq := item.Request(endpoint, entitlementType)
+
+	var apiResp *openapi.CreateOrUpdateEntitlementResponse
+	var finalHttpResp *http.Response
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "create_update_entitlement_bulk", func(token string) error {
+		entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := entitlementOps.CreateUpdateEntitlement(ctx, createReq)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp
+		return err
+	})
+	if err != nil {
+		return errorsutil.HandleHTTPError(finalHttpResp, err, "Create")
+	}
+	if apiResp != nil && apiResp.ErrorCode != nil && *apiResp.ErrorCode != "0" {
+		return fmt.Errorf("%s", util.SafeDeref(apiResp.Msg))
+	}
+	return nil
+}
+
+// ApplyBulkEntitlements sends the entitlements in batches of batchSize, with up to parallelism
+// calls in flight. It stops after the first batch with failures and returns the entitlement values
+// that were applied and the failures.
+func (r *EntitlementsBulkResource) ApplyBulkEntitlements(ctx context.Context, endpoint, entitlementType string, items []BulkEntitlementItem, batchSize, parallelism int) (applied map[string]bool, failures []string) {
+	applied = make(map[string]bool, len(items))
+	var mu sync.Mutex
+
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		jobs := make(chan BulkEntitlementItem)
+		var wg sync.WaitGroup
+		for w := 0; w < parallelism && w < end-start; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for item := range jobs {
+					err := r.CreateUpdateEntitlement(ctx, endpoint, entitlementType, item)
+					mu.Lock()
+					if err != nil {
+						failures = append(failures, fmt.Sprintf("%s: %s", item.EntitlementValue, err))
+					} else {
+						applied[item.EntitlementValue] = true
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		for _, item := range items[start:end] {
+			jobs <- item
+		}
+		close(jobs)
+		wg.Wait()
+
+		tflog.Info(ctx, "Bulk entitlement batch applied", map[string]interface{}{
+			"processed": end,
+			"total":     len(items),
+			"failed":    len(failures),
+		})
+		if len(failures) > 0 || ctx.Err() != nil {
+			break
+		}
+	}
+
+	sort.Strings(failures)
+	return applied, failures
+}
+
+// reconcile sends the entitlements of plan whose hash differs from prior and returns the hashes
+// to store in state. Entitlements that failed keep their prior hash so that they are retried.
+func (r *EntitlementsBulkResource) reconcile(ctx context.Context, plan *EntitlementsBulkResourceModel, prior map[string]string, diagnostics *diag.Diagnostics) map[string]string {
+	items, known, diags := BulkEntitlementItems(ctx, plan)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return prior
+	}
+	if !known {
+		diagnostics.AddError("Unknown Entitlements", "The entitlements are still unknown at apply time.")
+		return prior
+	}
+
+	var planned map[string]string
+	if !plan.EntitlementHashes.IsNull() && !plan.EntitlementHashes.IsUnknown() {
+		diagnostics.Append(plan.EntitlementHashes.ElementsAs(ctx, &planned, false)...)
+	}
+
+	inputPath := path.Root("entitlements")
+	if !plan.FilePath.IsNull() {
+		inputPath = path.Root("file_path")
+	}
+
+	hashes := make(map[string]string, len(items))
+	var pending []BulkEntitlementItem
+	for _, item := range items {
+		hash := item.Hash()
+		if planned != nil && planned[item.EntitlementValue] != hash {
+			diagnostics.AddAttributeError(
+				inputPath,
+				"Entitlements Changed Since Plan",
+				fmt.Sprintf("The entitlement %q differs from the planned one. The input changed between plan and apply; run terraform plan again.", item.EntitlementValue),
+			)
+			return prior
+		}
+		if prior[item.EntitlementValue] == hash {
+			hashes[item.EntitlementValue] = hash
+			continue
+		}
+		pending = append(pending, item)
+	}
+
+	current := bulkEntitlementHashes(items)
+	var removed []string
+	for value := range prior {
+		if _, ok := current[value]; !ok {
+			removed = append(removed, value)
+		}
+	}
+	sort.Strings(removed)
+	if len(removed) > 0 && ResolveDeletionPolicy(plan.DeletionPolicy) == DeletionPolicyDeactivate {
+		deactivated, failures := r.DeactivateBulkEntitlements(ctx, plan.Endpoint.ValueString(), plan.EntitlementType.ValueString(),
+			removed, int(plan.BatchSize.ValueInt64()), int(plan.Parallelism.ValueInt64()))
+		// Entitlements that are still active stay in state so that the next apply deactivates them
+		for _, value := range removed {
+			if !deactivated[value] {
+				hashes[value] = prior[value]
+			}
+		}
+		if len(failures) > 0 {
+			diagnostics.AddError(
+				"Bulk Entitlement Deactivation Failed",
+				fmt.Sprintf("%d of %d entitlement(s) removed from the input were deactivated before processing stopped; %d failed:\n%s",
+					len(deactivated), len(removed), len(failures), summarizeBulkValues(failures)),
+			)
+		}
+	} else if len(removed) > 0 {
+		diagnostics.AddWarning(
+			"Entitlements Left in Saviynt",
+			fmt.Sprintf("%d entitlement(s) were removed from the input and are no longer managed, but are left unchanged in Saviynt. "+
+				"Set deletion_policy to \"deactivate\" to set removed entitlements inactive: %s",
+				len(removed), summarizeBulkValues(removed)),
+		)
+	}
+
+	tflog.Info(ctx, "Applying bulk entitlements", map[string]interface{}{
+		"id":        bulkEntitlementID(plan),
+		"changed":   len(pending),
+		"unchanged": len(items) - len(pending),
+	})
+	applied, failures := r.ApplyBulkEntitlements(ctx, plan.Endpoint.ValueString(), plan.EntitlementType.ValueString(),
+		pending, int(plan.BatchSize.ValueInt64()), int(plan.Parallelism.ValueInt64()))
+	for _, item := range pending {
+		if applied[item.EntitlementValue] {
+			hashes[item.EntitlementValue] = item.Hash()
+		} else if hash, ok := prior[item.EntitlementValue]; ok {
+			hashes[item.EntitlementValue] = hash
+		}
+	}
+
+	if len(failures) > 0 {
+		diagnostics.AddError(
+			"Bulk Entitlement Apply Failed",
+			fmt.Sprintf("%d of %d entitlement(s) were applied before processing stopped; %d failed:\n%s",
+				len(applied), len(pending), len(failures), summarizeBulkValues(failures)),
+		)
+	}
+	return hashes
+}
+
+// DeactivateBulkEntitlements sets the status of the entitlements with the given values to inactive
+// and returns the values that were deactivated and the failures.
+func (r *EntitlementsBulkResource) DeactivateBulkEntitlements(ctx context.Context, endpoint, entitlementType string, values []string, batchSize, parallelism int) (map[string]bool, []string) {
+	inactive := EntitlementStatusInactive
+	items := make([]BulkEntitlementItem, 0, len(values))
+	for _, value := range values {
+		items = append(items, BulkEntitlementItem{EntitlementValue: value, Status: &inactive})
+	}
+	return r.ApplyBulkEntitlements(ctx, endpoint, entitlementType, items, batchSize, parallelism)
+}
+
+// summarizeBulkValues lists the first values of a long list
+func summarizeBulkValues(values []string) string {
+	if len(values) <= bulkErrorsReported {
+		return strings.Join(values, "\n")
+	}
+	return strings.Join(values[:bulkErrorsReported], "\n") + fmt.Sprintf("\n... and %d more", len(values)-bulkErrorsReported)
+}
+
+// ListEntitlements returns all entitlements of an endpoint and entitlement type keyed by value.
+func (r *EntitlementsBulkResource) ListEntitlements(ctx context.Context, endpoint, entitlementType string, parallelism int) (map[string]openapi.GetEntitlementResponseEntitlementdetailsInner, error) {
+	// Pages use the max and offset fields of GetEntitlementRequest in the OpenAPI description
+	entitlements, _, err := FetchAllPages(ctx, bulkReadPageSize, parallelism,
+		func(ctx context.Context, offset, max int) (Page[openapi.GetEntitlementResponseEntitlementdetailsInner], error) {
+			readReq := openapi.GetEntitlementRequest{
+				Endpoint:        util.StringPtr(endpoint),
+				Entitlementtype: util.StringPtr(entitlementType),
+				Max:             openapi.PtrInt32(int32(max)),
+				Offset:          openapi.PtrInt32(int32(offset)),
+			}
+
+			var readResp *openapi.GetEntitlementResponse
+			var finalHttpResp *http.Response
+			err := r.provider.AuthenticatedAPICallWithRetry(ctx, "read_entitlements_bulk", func(token string) error {
+				entitlementOps := r.entitlementFactory.CreateEntitlementOperations(r.client.APIBaseURL(), token)
+				resp, httpResp, err := entitlementOps.GetEntitlements(ctx, readReq)
+				if httpResp != nil && httpResp.StatusCode == 401 {
+					return fmt.Errorf("401 unauthorized")
+				}
+				readResp = resp
+				finalHttpResp = httpResp
+				return err
+			})
+			if err != nil {
+				err = errorsutil.HandleHTTPError(finalHttpResp, err, "Read")
+				return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{}, fmt.Errorf("error reading entitlements: %v", err)
+			}
+			if readResp == nil {
+				return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{}, nil
+			}
+			if readResp.ErrorCode != nil && *readResp.ErrorCode != "0" {
+				return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{}, fmt.Errorf("error reading entitlements. Error code: %v, Msg: %v",
+					util.SafeDeref(readResp.ErrorCode), util.SafeDeref(readResp.Msg))
+			}
+			total := -1
+			if readResp.TotalEntitlementCount != nil {
+				total = int(*readResp.TotalEntitlementCount)
+			}
+			return Page[openapi.GetEntitlementResponseEntitlementdetailsInner]{Items: readResp.Entitlementdetails, Total: total}, nil
+		},
+		func(e openapi.GetEntitlementResponseEntitlementdetailsInner) string {
+			return util.SafeDeref(e.EntitlementValue)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]openapi.GetEntitlementResponseEntitlementdetailsInner, len(entitlements))
+	for _, entitlement := range entitlements {
+		values[util.SafeDeref(entitlement.EntitlementValue)] = entitlement
+	}
+	return values, nil
+}
+
+func (r *EntitlementsBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EntitlementsBulkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	hashes := r.reconcile(ctx, &plan, nil, &diags)
+	if len(hashes) == 0 && diags.HasError() {
+		// Nothing was applied, so there is nothing to keep in state and the next apply creates the
+		// resource again
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if diags.HasError() {
+		// Terraform taints a resource whose creation returns an error, and replacing it would run the
+		// deletion policy on the entitlements that were applied. The applied entitlements are saved
+		// instead and the failed ones stay out of state, so the next plan lists them again.
+		for _, d := range diags {
+			if d.Severity() == diag.SeverityError {
+				resp.Diagnostics.AddWarning(d.Summary(), d.Detail()+fmt.Sprintf("\n\nThe %d entitlement(s) of %s that were applied are saved in state. "+
+					"The failed entitlements are not, so the next plan lists them as changes and the next apply retries them.",
+					len(hashes), bulkEntitlementID(&plan)))
+				continue
+			}
+			resp.Diagnostics.Append(d)
+		}
+	} else {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	plan.ID = types.StringValue(bulkEntitlementID(&plan))
+	hashValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	plan.EntitlementHashes = hashValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EntitlementsBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EntitlementsBulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hashes map[string]string
+	if !state.EntitlementHashes.IsNull() && !state.EntitlementHashes.IsUnknown() {
+		resp.Diagnostics.Append(state.EntitlementHashes.ElementsAs(ctx, &hashes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	parallelism := DefaultBulkParallelism
+	if !state.Parallelism.IsNull() {
+		parallelism = int(state.Parallelism.ValueInt64())
+	}
+	existing, err := r.ListEntitlements(ctx, state.Endpoint.ValueString(), state.EntitlementType.ValueString(), parallelism)
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Failed", err.Error())
+		return
+	}
+
+	// The input tells which attributes of each entitlement are managed. When it cannot be read, for
+	// example because the file is gone, only missing entitlements are detected.
+	inputs := make(map[string]BulkEntitlementItem)
+	items, known, diags := BulkEntitlementItems(ctx, &state)
+	if known && !diags.HasError() {
+		for _, item := range items {
+			inputs[item.EntitlementValue] = item
+		}
+	} else {
+		tflog.Warn(ctx, "Bulk entitlements input not readable, checking only that the entitlements exist", map[string]interface{}{
+			"id": bulkEntitlementID(&state),
+		})
+	}
+
+	missing, changed := 0, 0
+	for value, hash := range hashes {
+		entitlement, ok := existing[value]
+		if !ok {
+			delete(hashes, value)
+			missing++
+			continue
+		}
+		input, ok := inputs[value]
+		if !ok {
+			continue
+		}
+		if current := BulkEntitlementItemFromAPI(entitlement, input).Hash(); current != hash {
+			hashes[value] = current
+			changed++
+		}
+	}
+	if missing > 0 || changed > 0 {
+		tflog.Warn(ctx, "Bulk entitlements changed in Saviynt", map[string]interface{}{
+			"id":      bulkEntitlementID(&state),
+			"missing": missing,
+			"changed": changed,
+		})
+	}
+
+	state.ID = types.StringValue(bulkEntitlementID(&state))
+	hashValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	state.EntitlementHashes = hashValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EntitlementsBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EntitlementsBulkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior map[string]string
+	if !state.EntitlementHashes.IsNull() && !state.EntitlementHashes.IsUnknown() {
+		resp.Diagnostics.Append(state.EntitlementHashes.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	hashes := r.reconcile(ctx, &plan, prior, &resp.Diagnostics)
+	plan.ID = types.StringValue(bulkEntitlementID(&plan))
+	hashValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	plan.EntitlementHashes = hashValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Saviynt does not support deleting entitlements. Delete follows the deletion_policy of the resource.
+func (r *EntitlementsBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EntitlementsBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch ResolveDeletionPolicy(state.DeletionPolicy) {
+	case DeletionPolicyAbandon:
+		tflog.Info(ctx, "Abandoning bulk entitlements, removing them from state only", map[string]interface{}{"id": bulkEntitlementID(&state)})
+		resp.State.RemoveResource(ctx)
+	case DeletionPolicyDeactivate:
+		var hashes map[string]string
+		if !state.EntitlementHashes.IsNull() && !state.EntitlementHashes.IsUnknown() {
+			resp.Diagnostics.Append(state.EntitlementHashes.ElementsAs(ctx, &hashes, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		values := make([]string, 0, len(hashes))
+		for value := range hashes {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+
+		batchSize, parallelism := DefaultBulkBatchSize, DefaultBulkParallelism
+		if !state.BatchSize.IsNull() {
+			batchSize = int(state.BatchSize.ValueInt64())
+		}
+		if !state.Parallelism.IsNull() {
+			parallelism = int(state.Parallelism.ValueInt64())
+		}
+
+		applied, failures := r.DeactivateBulkEntitlements(ctx, state.Endpoint.ValueString(), state.EntitlementType.ValueString(), values, batchSize, parallelism)
+		if len(failures) > 0 {
+			// Keep the entitlements that are still active in state so that the destroy can be retried
+			for value := range applied {
+				delete(hashes, value)
+			}
+			hashValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+			resp.Diagnostics.Append(diags...)
+			state.EntitlementHashes = hashValue
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			resp.Diagnostics.AddError(
+				"Bulk Entitlement Deactivation Failed",
+				fmt.Sprintf("%d of %d entitlement(s) were deactivated before processing stopped; %d failed:\n%s",
+					len(applied), len(values), len(failures), summarizeBulkValues(failures)),
+			)
+			return
+		}
+		resp.State.RemoveResource(ctx)
+	default:
+		resp.Diagnostics.AddError(
+			"Delete Not Supported",
+			fmt.Sprintf("Entitlements of %s cannot be deleted in Saviynt. Set deletion_policy to \"abandon\" to remove them from state only, "+
+				"or to \"deactivate\" to set them inactive, and apply before destroying the resource.", bulkEntitlementID(&state)),
+		)
+	}
+}
+
+func (r *EntitlementsBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || strings.TrimSpace(idParts[0]) == "" || strings.TrimSpace(idParts[1]) == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			fmt.Sprintf("Expected import ID format: 'endpoint:entitlement_type', got: %s\n"+
+				"Example: terraform import saviynt_entitlements_bulk.example sample-103:Groups", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint"), strings.TrimSpace(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entitlement_type"), strings.TrimSpace(idParts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("batch_size"), int64(DefaultBulkBatchSize))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parallelism"), int64(DefaultBulkParallelism))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_policy"), DeletionPolicyError)...)
+}
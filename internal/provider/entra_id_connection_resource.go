@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
@@ -39,59 +41,59 @@ var entraIdErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTy
 
 type EntraIdConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                              types.String `tfsdk:"id"`
-	ClientId                        types.String `tfsdk:"client_id"`
-	ClientSecret                    types.String `tfsdk:"client_secret"`
-	ClientSecretWO                  types.String `tfsdk:"client_secret_wo"`
-	AccessToken                     types.String `tfsdk:"access_token"`
-	AccessTokenWO                   types.String `tfsdk:"access_token_wo"`
-	AadTenantId                     types.String `tfsdk:"aad_tenant_id"`
-	AzureMgmtAccessToken            types.String `tfsdk:"azure_mgmt_access_token"`
-	AzureMgmtAccessTokenWO          types.String `tfsdk:"azure_mgmt_access_token_wo"`
-	AuthenticationEndpoint          types.String `tfsdk:"authentication_endpoint"`
-	MicrosoftGraphEndpoint          types.String `tfsdk:"microsoft_graph_endpoint"`
-	AzureManagementEndpoint         types.String `tfsdk:"azure_management_endpoint"`
-	ImportUserJson                  types.String `tfsdk:"import_user_json"`
-	CreateUsers                     types.String `tfsdk:"create_users"`
-	WindowsConnectorJson            types.String `tfsdk:"windows_connector_json"`
-	WindowsConnectorJsonWO          types.String `tfsdk:"windows_connector_json_wo"`
-	CreateNewEndpoints              types.String `tfsdk:"create_new_endpoints"`
-	ManagedAccountType              types.String `tfsdk:"managed_account_type"`
-	AccountAttributes               types.String `tfsdk:"account_attributes"`
-	ServiceAccountAttributes        types.String `tfsdk:"service_account_attributes"`
-	DeltaTokensJson                 types.String `tfsdk:"delta_tokens_json"`
-	AccountImportFields             types.String `tfsdk:"account_import_fields"`
-	ImportDepth                     types.String `tfsdk:"import_depth"`
-	EntitlementAttribute            types.String `tfsdk:"entitlement_attribute"`
-	CreateAccountJson               types.String `tfsdk:"create_account_json"`
-	UpdateAccountJson               types.String `tfsdk:"update_account_json"`
-	EnableAccountJson               types.String `tfsdk:"enable_account_json"`
-	DisableAccountJson              types.String `tfsdk:"disable_account_json"`
-	AddAccessJson                   types.String `tfsdk:"add_access_json"`
-	RemoveAccessJson                types.String `tfsdk:"remove_access_json"`
-	UpdateUserJson                  types.String `tfsdk:"update_user_json"`
-	ChangePassJson                  types.String `tfsdk:"change_pass_json"`
-	RemoveAccountJson               types.String `tfsdk:"remove_account_json"`
-	ConnectionJson                  types.String `tfsdk:"connection_json"`
-	ConnectionJsonWO                types.String `tfsdk:"connection_json_wo"`
-	CreateGroupJson                 types.String `tfsdk:"create_group_json"`
-	UpdateGroupJson                 types.String `tfsdk:"update_group_json"`
-	AddAccessToEntitlementJson      types.String `tfsdk:"add_access_to_entitlement_json"`
-	RemoveAccessFromEntitlementJson types.String `tfsdk:"remove_access_from_entitlement_json"`
-	DeleteGroupJson                 types.String `tfsdk:"delete_group_json"`
-	CreateServicePrincipalJson      types.String `tfsdk:"create_service_principal_json"`
-	UpdateServicePrincipalJson      types.String `tfsdk:"update_service_principal_json"`
-	RemoveServicePrincipalJson      types.String `tfsdk:"remove_service_principal_json"`
-	EntitlementFilterJson           types.String `tfsdk:"entitlement_filter_json"`
-	CreateTeamJson                  types.String `tfsdk:"create_team_json"`
-	CreateChannelJson               types.String `tfsdk:"create_channel_json"`
-	StatusThresholdConfig           types.String `tfsdk:"status_threshold_config"`
-	AccountsFilter                  types.String `tfsdk:"accounts_filter"`
-	PamConfig                       types.String `tfsdk:"pam_config"`
-	EndpointsFilter                 types.String `tfsdk:"endpoints_filter"`
-	ConfigJson                      types.String `tfsdk:"config_json"`
-	ModifyUserdataJson              types.String `tfsdk:"modify_user_data_json"`
-	EnhancedDirectoryRoles          types.String `tfsdk:"enhanced_directory_roles"`
+	ID                              types.String           `tfsdk:"id"`
+	ClientId                        types.String           `tfsdk:"client_id"`
+	ClientSecret                    types.String           `tfsdk:"client_secret"`
+	ClientSecretWO                  types.String           `tfsdk:"client_secret_wo"`
+	AccessToken                     types.String           `tfsdk:"access_token"`
+	AccessTokenWO                   types.String           `tfsdk:"access_token_wo"`
+	AadTenantId                     types.String           `tfsdk:"aad_tenant_id"`
+	AzureMgmtAccessToken            types.String           `tfsdk:"azure_mgmt_access_token"`
+	AzureMgmtAccessTokenWO          types.String           `tfsdk:"azure_mgmt_access_token_wo"`
+	AuthenticationEndpoint          types.String           `tfsdk:"authentication_endpoint"`
+	MicrosoftGraphEndpoint          types.String           `tfsdk:"microsoft_graph_endpoint"`
+	AzureManagementEndpoint         types.String           `tfsdk:"azure_management_endpoint"`
+	ImportUserJson                  customtypes.JSONString `tfsdk:"import_user_json"`
+	CreateUsers                     types.String           `tfsdk:"create_users"`
+	WindowsConnectorJson            customtypes.JSONString `tfsdk:"windows_connector_json"`
+	WindowsConnectorJsonWO          types.String           `tfsdk:"windows_connector_json_wo"`
+	CreateNewEndpoints              types.String           `tfsdk:"create_new_endpoints"`
+	ManagedAccountType              types.String           `tfsdk:"managed_account_type"`
+	AccountAttributes               types.String           `tfsdk:"account_attributes"`
+	ServiceAccountAttributes        types.String           `tfsdk:"service_account_attributes"`
+	DeltaTokensJson                 customtypes.JSONString `tfsdk:"delta_tokens_json"`
+	AccountImportFields             types.String           `tfsdk:"account_import_fields"`
+	ImportDepth                     types.String           `tfsdk:"import_depth"`
+	EntitlementAttribute            types.String           `tfsdk:"entitlement_attribute"`
+	CreateAccountJson               customtypes.JSONString `tfsdk:"create_account_json"`
+	UpdateAccountJson               customtypes.JSONString `tfsdk:"update_account_json"`
+	EnableAccountJson               customtypes.JSONString `tfsdk:"enable_account_json"`
+	DisableAccountJson              customtypes.JSONString `tfsdk:"disable_account_json"`
+	AddAccessJson                   customtypes.JSONString `tfsdk:"add_access_json"`
+	RemoveAccessJson                customtypes.JSONString `tfsdk:"remove_access_json"`
+	UpdateUserJson                  customtypes.JSONString `tfsdk:"update_user_json"`
+	ChangePassJson                  customtypes.JSONString `tfsdk:"change_pass_json"`
+	RemoveAccountJson               customtypes.JSONString `tfsdk:"remove_account_json"`
+	ConnectionJson                  customtypes.JSONString `tfsdk:"connection_json"`
+	ConnectionJsonWO                types.String           `tfsdk:"connection_json_wo"`
+	CreateGroupJson                 customtypes.JSONString `tfsdk:"create_group_json"`
+	UpdateGroupJson                 customtypes.JSONString `tfsdk:"update_group_json"`
+	AddAccessToEntitlementJson      customtypes.JSONString `tfsdk:"add_access_to_entitlement_json"`
+	RemoveAccessFromEntitlementJson customtypes.JSONString `tfsdk:"remove_access_from_entitlement_json"`
+	DeleteGroupJson                 customtypes.JSONString `tfsdk:"delete_group_json"`
+	CreateServicePrincipalJson      customtypes.JSONString `tfsdk:"create_service_principal_json"`
+	UpdateServicePrincipalJson      customtypes.JSONString `tfsdk:"update_service_principal_json"`
+	RemoveServicePrincipalJson      customtypes.JSONString `tfsdk:"remove_service_principal_json"`
+	EntitlementFilterJson           customtypes.JSONString `tfsdk:"entitlement_filter_json"`
+	CreateTeamJson                  customtypes.JSONString `tfsdk:"create_team_json"`
+	CreateChannelJson               customtypes.JSONString `tfsdk:"create_channel_json"`
+	StatusThresholdConfig           customtypes.JSONString `tfsdk:"status_threshold_config"`
+	AccountsFilter                  types.String           `tfsdk:"accounts_filter"`
+	PamConfig                       customtypes.JSONString `tfsdk:"pam_config"`
+	EndpointsFilter                 customtypes.JSONString `tfsdk:"endpoints_filter"`
+	ConfigJson                      customtypes.JSONString `tfsdk:"config_json"`
+	ModifyUserdataJson              customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	EnhancedDirectoryRoles          types.String           `tfsdk:"enhanced_directory_roles"`
 }
 
 type EntraIdConnectionResource struct {
@@ -195,6 +197,7 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Azure management endpoint URL.",
 		},
 		"import_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for importing users.",
@@ -205,6 +208,7 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Flag or configuration for creating users.",
 		},
 		"windows_connector_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Windows connector JSON configuration.",
@@ -241,6 +245,7 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Attributes for service account configuration.",
 		},
 		"delta_tokens_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Delta tokens JSON data.",
@@ -261,51 +266,61 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Attribute used for entitlement.",
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to create an account.",
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to update an account.",
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to enable an account.",
 		},
 		"disable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to disable an account.",
 		},
 		"add_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to add access.",
 		},
 		"remove_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to remove access.",
 		},
 		"update_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to update user.",
 		},
 		"change_pass_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to change password.",
 		},
 		"remove_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template to remove account.",
 		},
 		"connection_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Configuration for the connection in JSON format. Either the connection_json field or the connection_json_wo field must be populated to set the connection_json attribute.",
@@ -322,64 +337,79 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			},
 		},
 		"create_group_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create group.",
 		},
 		"update_group_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update group.",
 		},
 		"add_access_to_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to add access to entitlement.",
 		},
 		"remove_access_from_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to remove access from entitlement.",
 		},
 		"delete_group_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to delete group.",
 		},
 		"create_service_principal_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create service principal.",
 		},
 		"update_service_principal_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update service principal.",
 		},
 		"remove_service_principal_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to remove service principal.",
 		},
 		"entitlement_filter_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Filter JSON for entitlements.",
 		},
 		"create_team_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create team.",
 		},
 		"create_channel_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create channel.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Configuration for status thresholds.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"accounts_filter": schema.StringAttribute{
 			Optional:    true,
@@ -387,21 +417,29 @@ func EntraIdConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Filter for accounts.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "PAM configuration.",
 		},
 		"endpoints_filter": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Endpoints filter configuration.",
 		},
 		"config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Main config JSON.",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to modify user data.",
@@ -528,44 +566,44 @@ func (r *EntraIdConnectionResource) BuildEntraIdConnector(plan *EntraIdConnector
 		AUTHENTICATION_ENDPOINT:         util.StringPointerOrEmpty(plan.AuthenticationEndpoint),
 		MICROSOFT_GRAPH_ENDPOINT:        util.StringPointerOrEmpty(plan.MicrosoftGraphEndpoint),
 		AZURE_MANAGEMENT_ENDPOINT:       util.StringPointerOrEmpty(plan.AzureManagementEndpoint),
-		ImportUserJSON:                  util.StringPointerOrEmpty(plan.ImportUserJson),
+		ImportUserJSON:                  util.StringPointerOrEmpty(plan.ImportUserJson.StringValue),
 		CREATEUSERS:                     util.StringPointerOrEmpty(plan.CreateUsers),
 		WINDOWS_CONNECTOR_JSON:          util.StringPointerOrEmpty(types.StringValue(windowsConnectorJson)),
 		CREATE_NEW_ENDPOINTS:            util.StringPointerOrEmpty(plan.CreateNewEndpoints),
 		MANAGED_ACCOUNT_TYPE:            util.StringPointerOrEmpty(plan.ManagedAccountType),
 		ACCOUNT_ATTRIBUTES:              util.StringPointerOrEmpty(plan.AccountAttributes),
 		SERVICE_ACCOUNT_ATTRIBUTES:      util.StringPointerOrEmpty(plan.ServiceAccountAttributes),
-		DELTATOKENSJSON:                 util.StringPointerOrEmpty(plan.DeltaTokensJson),
+		DELTATOKENSJSON:                 util.StringPointerOrEmpty(plan.DeltaTokensJson.StringValue),
 		ACCOUNT_IMPORT_FIELDS:           util.StringPointerOrEmpty(plan.AccountImportFields),
 		IMPORT_DEPTH:                    util.StringPointerOrEmpty(plan.ImportDepth),
 		ENTITLEMENT_ATTRIBUTE:           util.StringPointerOrEmpty(plan.EntitlementAttribute),
-		CreateAccountJSON:               util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UpdateAccountJSON:               util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		EnableAccountJSON:               util.StringPointerOrEmpty(plan.EnableAccountJson),
-		DisableAccountJSON:              util.StringPointerOrEmpty(plan.DisableAccountJson),
-		AddAccessJSON:                   util.StringPointerOrEmpty(plan.AddAccessJson),
-		RemoveAccessJSON:                util.StringPointerOrEmpty(plan.RemoveAccessJson),
-		UpdateUserJSON:                  util.StringPointerOrEmpty(plan.UpdateUserJson),
-		ChangePassJSON:                  util.StringPointerOrEmpty(plan.ChangePassJson),
-		RemoveAccountJSON:               util.StringPointerOrEmpty(plan.RemoveAccountJson),
+		CreateAccountJSON:               util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UpdateAccountJSON:               util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		EnableAccountJSON:               util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
+		DisableAccountJSON:              util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
+		AddAccessJSON:                   util.StringPointerOrEmpty(plan.AddAccessJson.StringValue),
+		RemoveAccessJSON:                util.StringPointerOrEmpty(plan.RemoveAccessJson.StringValue),
+		UpdateUserJSON:                  util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
+		ChangePassJSON:                  util.StringPointerOrEmpty(plan.ChangePassJson.StringValue),
+		RemoveAccountJSON:               util.StringPointerOrEmpty(plan.RemoveAccountJson.StringValue),
 		ConnectionJSON:                  util.StringPointerOrEmpty(types.StringValue(connectionJson)),
-		CreateGroupJSON:                 util.StringPointerOrEmpty(plan.CreateGroupJson),
-		UpdateGroupJSON:                 util.StringPointerOrEmpty(plan.UpdateGroupJson),
-		AddAccessToEntitlementJSON:      util.StringPointerOrEmpty(plan.AddAccessToEntitlementJson),
-		RemoveAccessFromEntitlementJSON: util.StringPointerOrEmpty(plan.RemoveAccessFromEntitlementJson),
-		DeleteGroupJSON:                 util.StringPointerOrEmpty(plan.DeleteGroupJson),
-		CreateServicePrincipalJSON:      util.StringPointerOrEmpty(plan.CreateServicePrincipalJson),
-		UpdateServicePrincipalJSON:      util.StringPointerOrEmpty(plan.UpdateServicePrincipalJson),
-		RemoveServicePrincipalJSON:      util.StringPointerOrEmpty(plan.RemoveServicePrincipalJson),
-		ENTITLEMENT_FILTER_JSON:         util.StringPointerOrEmpty(plan.EntitlementFilterJson),
-		CreateTeamJSON:                  util.StringPointerOrEmpty(plan.CreateTeamJson),
-		CreateChannelJSON:               util.StringPointerOrEmpty(plan.CreateChannelJson),
-		STATUS_THRESHOLD_CONFIG:         util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		CreateGroupJSON:                 util.StringPointerOrEmpty(plan.CreateGroupJson.StringValue),
+		UpdateGroupJSON:                 util.StringPointerOrEmpty(plan.UpdateGroupJson.StringValue),
+		AddAccessToEntitlementJSON:      util.StringPointerOrEmpty(plan.AddAccessToEntitlementJson.StringValue),
+		RemoveAccessFromEntitlementJSON: util.StringPointerOrEmpty(plan.RemoveAccessFromEntitlementJson.StringValue),
+		DeleteGroupJSON:                 util.StringPointerOrEmpty(plan.DeleteGroupJson.StringValue),
+		CreateServicePrincipalJSON:      util.StringPointerOrEmpty(plan.CreateServicePrincipalJson.StringValue),
+		UpdateServicePrincipalJSON:      util.StringPointerOrEmpty(plan.UpdateServicePrincipalJson.StringValue),
+		RemoveServicePrincipalJSON:      util.StringPointerOrEmpty(plan.RemoveServicePrincipalJson.StringValue),
+		ENTITLEMENT_FILTER_JSON:         util.StringPointerOrEmpty(plan.EntitlementFilterJson.StringValue),
+		CreateTeamJSON:                  util.StringPointerOrEmpty(plan.CreateTeamJson.StringValue),
+		CreateChannelJSON:               util.StringPointerOrEmpty(plan.CreateChannelJson.StringValue),
+		STATUS_THRESHOLD_CONFIG:         util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		ACCOUNTS_FILTER:                 util.StringPointerOrEmpty(plan.AccountsFilter),
-		PAM_CONFIG:                      util.StringPointerOrEmpty(plan.PamConfig),
-		ENDPOINTS_FILTER:                util.StringPointerOrEmpty(plan.EndpointsFilter),
-		ConfigJSON:                      util.StringPointerOrEmpty(plan.ConfigJson),
-		MODIFYUSERDATAJSON:              util.StringPointerOrEmpty(plan.ModifyUserdataJson),
+		PAM_CONFIG:                      util.StringPointerOrEmpty(plan.PamConfig.StringValue),
+		ENDPOINTS_FILTER:                util.StringPointerOrEmpty(plan.EndpointsFilter.StringValue),
+		ConfigJSON:                      util.StringPointerOrEmpty(plan.ConfigJson.StringValue),
+		MODIFYUSERDATAJSON:              util.StringPointerOrEmpty(plan.ModifyUserdataJson.StringValue),
 		ENHANCEDDIRECTORYROLES:          util.StringPointerOrEmpty(plan.EnhancedDirectoryRoles),
 	}
 
@@ -634,7 +672,7 @@ func (r *EntraIdConnectionResource) CreateEntraIdConnection(ctx context.Context,
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_entraid_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -741,7 +779,7 @@ func (r *EntraIdConnectionResource) UpdateEntraIdConnection(ctx context.Context,
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_entraid_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -787,43 +825,43 @@ func (r *EntraIdConnectionResource) UpdateModelFromCreateResponse(plan *EntraIdC
 	plan.AuthenticationEndpoint = util.SafeStringDatasource(plan.AuthenticationEndpoint.ValueStringPointer())
 	plan.MicrosoftGraphEndpoint = util.SafeStringDatasource(plan.MicrosoftGraphEndpoint.ValueStringPointer())
 	plan.AzureManagementEndpoint = util.SafeStringDatasource(plan.AzureManagementEndpoint.ValueStringPointer())
-	plan.ImportUserJson = util.SafeStringDatasource(plan.ImportUserJson.ValueStringPointer())
+	plan.ImportUserJson = customtypes.NewJSONStringPointerValue(plan.ImportUserJson.ValueStringPointer())
 	plan.CreateUsers = util.SafeStringDatasource(plan.CreateUsers.ValueStringPointer())
 	plan.CreateNewEndpoints = util.SafeStringDatasource(plan.CreateNewEndpoints.ValueStringPointer())
 	plan.ManagedAccountType = util.SafeStringDatasource(plan.ManagedAccountType.ValueStringPointer())
 	plan.AccountAttributes = util.SafeStringDatasource(plan.AccountAttributes.ValueStringPointer())
 	plan.ServiceAccountAttributes = util.SafeStringDatasource(plan.ServiceAccountAttributes.ValueStringPointer())
-	plan.DeltaTokensJson = util.SafeStringDatasource(plan.DeltaTokensJson.ValueStringPointer())
+	plan.DeltaTokensJson = customtypes.NewJSONStringPointerValue(plan.DeltaTokensJson.ValueStringPointer())
 	plan.AccountImportFields = util.SafeStringDatasource(plan.AccountImportFields.ValueStringPointer())
 	plan.ImportDepth = util.SafeStringDatasource(plan.ImportDepth.ValueStringPointer())
 	plan.EntitlementAttribute = util.SafeStringDatasource(plan.EntitlementAttribute.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.AddAccessJson = util.SafeStringDatasource(plan.AddAccessJson.ValueStringPointer())
-	plan.RemoveAccessJson = util.SafeStringDatasource(plan.RemoveAccessJson.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
-	plan.RemoveAccountJson = util.SafeStringDatasource(plan.RemoveAccountJson.ValueStringPointer())
-	plan.CreateGroupJson = util.SafeStringDatasource(plan.CreateGroupJson.ValueStringPointer())
-	plan.UpdateGroupJson = util.SafeStringDatasource(plan.UpdateGroupJson.ValueStringPointer())
-	plan.AddAccessToEntitlementJson = util.SafeStringDatasource(plan.AddAccessToEntitlementJson.ValueStringPointer())
-	plan.RemoveAccessFromEntitlementJson = util.SafeStringDatasource(plan.RemoveAccessFromEntitlementJson.ValueStringPointer())
-	plan.DeleteGroupJson = util.SafeStringDatasource(plan.DeleteGroupJson.ValueStringPointer())
-	plan.CreateServicePrincipalJson = util.SafeStringDatasource(plan.CreateServicePrincipalJson.ValueStringPointer())
-	plan.UpdateServicePrincipalJson = util.SafeStringDatasource(plan.UpdateServicePrincipalJson.ValueStringPointer())
-	plan.RemoveServicePrincipalJson = util.SafeStringDatasource(plan.RemoveServicePrincipalJson.ValueStringPointer())
-	plan.EntitlementFilterJson = util.SafeStringDatasource(plan.EntitlementFilterJson.ValueStringPointer())
-	plan.CreateTeamJson = util.SafeStringDatasource(plan.CreateTeamJson.ValueStringPointer())
-	plan.CreateChannelJson = util.SafeStringDatasource(plan.CreateChannelJson.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.AddAccessJson = customtypes.NewJSONStringPointerValue(plan.AddAccessJson.ValueStringPointer())
+	plan.RemoveAccessJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccessJson.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
+	plan.RemoveAccountJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccountJson.ValueStringPointer())
+	plan.CreateGroupJson = customtypes.NewJSONStringPointerValue(plan.CreateGroupJson.ValueStringPointer())
+	plan.UpdateGroupJson = customtypes.NewJSONStringPointerValue(plan.UpdateGroupJson.ValueStringPointer())
+	plan.AddAccessToEntitlementJson = customtypes.NewJSONStringPointerValue(plan.AddAccessToEntitlementJson.ValueStringPointer())
+	plan.RemoveAccessFromEntitlementJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccessFromEntitlementJson.ValueStringPointer())
+	plan.DeleteGroupJson = customtypes.NewJSONStringPointerValue(plan.DeleteGroupJson.ValueStringPointer())
+	plan.CreateServicePrincipalJson = customtypes.NewJSONStringPointerValue(plan.CreateServicePrincipalJson.ValueStringPointer())
+	plan.UpdateServicePrincipalJson = customtypes.NewJSONStringPointerValue(plan.UpdateServicePrincipalJson.ValueStringPointer())
+	plan.RemoveServicePrincipalJson = customtypes.NewJSONStringPointerValue(plan.RemoveServicePrincipalJson.ValueStringPointer())
+	plan.EntitlementFilterJson = customtypes.NewJSONStringPointerValue(plan.EntitlementFilterJson.ValueStringPointer())
+	plan.CreateTeamJson = customtypes.NewJSONStringPointerValue(plan.CreateTeamJson.ValueStringPointer())
+	plan.CreateChannelJson = customtypes.NewJSONStringPointerValue(plan.CreateChannelJson.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.AccountsFilter = util.SafeStringDatasource(plan.AccountsFilter.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
-	plan.EndpointsFilter = util.SafeStringDatasource(plan.EndpointsFilter.ValueStringPointer())
-	plan.ConfigJson = util.SafeStringDatasource(plan.ConfigJson.ValueStringPointer())
-	plan.ModifyUserdataJson = util.SafeStringDatasource(plan.ModifyUserdataJson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
+	plan.EndpointsFilter = customtypes.NewJSONStringPointerValue(plan.EndpointsFilter.ValueStringPointer())
+	plan.ConfigJson = customtypes.NewJSONStringPointerValue(plan.ConfigJson.ValueStringPointer())
+	plan.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserdataJson.ValueStringPointer())
 	plan.EnhancedDirectoryRoles = util.SafeStringDatasource(plan.EnhancedDirectoryRoles.ValueStringPointer())
-	plan.ChangePassJson = util.SafeStringDatasource(plan.ChangePassJson.ValueStringPointer())
+	plan.ChangePassJson = customtypes.NewJSONStringPointerValue(plan.ChangePassJson.ValueStringPointer())
 
 	// Set response fields
 	plan.ErrorCode = util.SafeStringDatasource(apiResp.ErrorCode)
@@ -851,57 +889,58 @@ func (r *EntraIdConnectionResource) UpdateModelFromReadResponse(state *EntraIdCo
 		state.AzureManagementEndpoint = util.SafeStringDatasource(attrs.AZURE_MANAGEMENT_ENDPOINT)
 
 		// User and account management
-		state.ImportUserJson = util.SafeStringDatasource(attrs.ImportUserJSON)
+		state.ImportUserJson = customtypes.NewJSONStringPointerValue(attrs.ImportUserJSON)
 		state.CreateUsers = util.SafeStringDatasource(attrs.CREATEUSERS)
 		state.CreateNewEndpoints = util.SafeStringDatasource(attrs.CREATE_NEW_ENDPOINTS)
 		state.ManagedAccountType = util.SafeStringDatasource(attrs.MANAGED_ACCOUNT_TYPE)
 		state.AccountAttributes = util.SafeStringDatasource(attrs.ACCOUNT_ATTRIBUTES)
 		state.ServiceAccountAttributes = util.SafeStringDatasource(attrs.SERVICE_ACCOUNT_ATTRIBUTES)
-		state.DeltaTokensJson = util.SafeStringDatasource(attrs.DELTATOKENSJSON)
+		state.DeltaTokensJson = customtypes.NewJSONStringPointerValue(attrs.DELTATOKENSJSON)
 		state.AccountImportFields = util.SafeStringDatasource(attrs.ACCOUNT_IMPORT_FIELDS)
 		state.ImportDepth = util.SafeStringDatasource(attrs.IMPORT_DEPTH)
 		state.EntitlementAttribute = util.SafeStringDatasource(attrs.ENTITLEMENT_ATTRIBUTE)
 
 		// Account lifecycle operations
-		state.CreateAccountJson = util.SafeStringDatasource(attrs.CreateAccountJSON)
-		state.UpdateAccountJson = util.SafeStringDatasource(attrs.UpdateAccountJSON)
-		state.EnableAccountJson = util.SafeStringDatasource(attrs.EnableAccountJSON)
-		state.DisableAccountJson = util.SafeStringDatasource(attrs.DisableAccountJSON)
-		state.RemoveAccountJson = util.SafeStringDatasource(attrs.RemoveAccountJSON)
+		state.CreateAccountJson = customtypes.NewJSONStringPointerValue(attrs.CreateAccountJSON)
+		state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(attrs.UpdateAccountJSON)
+		state.EnableAccountJson = customtypes.NewJSONStringPointerValue(attrs.EnableAccountJSON)
+		state.DisableAccountJson = customtypes.NewJSONStringPointerValue(attrs.DisableAccountJSON)
+		state.RemoveAccountJson = customtypes.NewJSONStringPointerValue(attrs.RemoveAccountJSON)
 
 		// Access management
-		state.AddAccessJson = util.SafeStringDatasource(attrs.AddAccessJSON)
-		state.RemoveAccessJson = util.SafeStringDatasource(attrs.RemoveAccessJSON)
-		state.AddAccessToEntitlementJson = util.SafeStringDatasource(attrs.AddAccessToEntitlementJSON)
-		state.RemoveAccessFromEntitlementJson = util.SafeStringDatasource(attrs.RemoveAccessFromEntitlementJSON)
+		state.AddAccessJson = customtypes.NewJSONStringPointerValue(attrs.AddAccessJSON)
+		state.RemoveAccessJson = customtypes.NewJSONStringPointerValue(attrs.RemoveAccessJSON)
+		state.AddAccessToEntitlementJson = customtypes.NewJSONStringPointerValue(attrs.AddAccessToEntitlementJSON)
+		state.RemoveAccessFromEntitlementJson = customtypes.NewJSONStringPointerValue(attrs.RemoveAccessFromEntitlementJSON)
 
 		// User management
-		state.UpdateUserJson = util.SafeStringDatasource(attrs.UpdateUserJSON)
-		state.ChangePassJson = util.SafeStringDatasource(attrs.ChangePassJSON)
-		state.ModifyUserdataJson = util.SafeStringDatasource(attrs.MODIFYUSERDATAJSON)
+		state.UpdateUserJson = customtypes.NewJSONStringPointerValue(attrs.UpdateUserJSON)
+		state.ChangePassJson = customtypes.NewJSONStringPointerValue(attrs.ChangePassJSON)
+		state.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(attrs.MODIFYUSERDATAJSON)
 
 		// Group management
-		state.CreateGroupJson = util.SafeStringDatasource(attrs.CreateGroupJSON)
-		state.UpdateGroupJson = util.SafeStringDatasource(attrs.UpdateGroupJSON)
-		state.DeleteGroupJson = util.SafeStringDatasource(attrs.DeleteGroupJSON)
+		state.CreateGroupJson = customtypes.NewJSONStringPointerValue(attrs.CreateGroupJSON)
+		state.UpdateGroupJson = customtypes.NewJSONStringPointerValue(attrs.UpdateGroupJSON)
+		state.DeleteGroupJson = customtypes.NewJSONStringPointerValue(attrs.DeleteGroupJSON)
 
 		// Service principal management
-		state.CreateServicePrincipalJson = util.SafeStringDatasource(attrs.CreateServicePrincipalJSON)
-		state.UpdateServicePrincipalJson = util.SafeStringDatasource(attrs.UpdateServicePrincipalJSON)
-		state.RemoveServicePrincipalJson = util.SafeStringDatasource(attrs.RemoveServicePrincipalJSON)
+		state.CreateServicePrincipalJson = customtypes.NewJSONStringPointerValue(attrs.CreateServicePrincipalJSON)
+		state.UpdateServicePrincipalJson = customtypes.NewJSONStringPointerValue(attrs.UpdateServicePrincipalJSON)
+		state.RemoveServicePrincipalJson = customtypes.NewJSONStringPointerValue(attrs.RemoveServicePrincipalJSON)
 
 		// Teams and channels
-		state.CreateTeamJson = util.SafeStringDatasource(attrs.CreateTeamJSON)
-		state.CreateChannelJson = util.SafeStringDatasource(attrs.CreateChannelJSON)
+		state.CreateTeamJson = customtypes.NewJSONStringPointerValue(attrs.CreateTeamJSON)
+		state.CreateChannelJson = customtypes.NewJSONStringPointerValue(attrs.CreateChannelJSON)
 
 		// Filtering and configuration
-		state.EntitlementFilterJson = util.SafeStringDatasource(attrs.ENTITLEMENT_FILTER_JSON)
-		state.StatusThresholdConfig = util.SafeStringDatasource(attrs.STATUS_THRESHOLD_CONFIG)
+		state.EntitlementFilterJson = customtypes.NewJSONStringPointerValue(attrs.ENTITLEMENT_FILTER_JSON)
+		state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(attrs.STATUS_THRESHOLD_CONFIG)
 		state.AccountsFilter = util.SafeStringDatasource(attrs.ACCOUNTS_FILTER)
-		state.PamConfig = util.SafeStringDatasource(attrs.PAM_CONFIG)
-		state.EndpointsFilter = util.SafeStringDatasource(attrs.ENDPOINTS_FILTER)
-		state.ConfigJson = util.SafeStringDatasource(attrs.ConfigJSON)
+		state.PamConfig = customtypes.NewJSONStringPointerValue(attrs.PAM_CONFIG)
+		state.EndpointsFilter = customtypes.NewJSONStringPointerValue(attrs.ENDPOINTS_FILTER)
+		state.ConfigJson = customtypes.NewJSONStringPointerValue(attrs.ConfigJSON)
 		state.EnhancedDirectoryRoles = util.SafeStringDatasource(attrs.ENHANCEDDIRECTORYROLES)
+		state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, attrs.ConnectionTimeoutConfig)
 	}
 }
 
@@ -951,8 +990,14 @@ func (r *EntraIdConnectionResource) Create(ctx context.Context, req resource.Cre
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use the helper method to create the connection
-	apiResp, err := r.CreateEntraIdConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateEntraIdConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "EntraID connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1028,7 +1073,9 @@ func (r *EntraIdConnectionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Update the model with the response data
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.EntraIDConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -1123,8 +1170,14 @@ func (r *EntraIdConnectionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use the helper method to update the connection
-	updateResp, err := r.UpdateEntraIdConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateEntraIdConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "EntraID connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1146,7 +1199,9 @@ func (r *EntraIdConnectionResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update the model with the response data (includes read operation)
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
@@ -53,6 +55,13 @@ type ExportTransportPackageResourceModel struct {
 	FileName       types.String `tfsdk:"file_name"`
 	MsgDescription types.String `tfsdk:"msg_description"`
 	ErrorCode      types.String `tfsdk:"error_code"`
+
+	// Status polling
+	WaitForCompletion    types.Bool   `tfsdk:"wait_for_completion"`
+	StatusTimeout        types.Int64  `tfsdk:"status_timeout"`
+	StatusPollInterval   types.Int64  `tfsdk:"status_poll_interval"`
+	Status               types.String `tfsdk:"status"`
+	StatusMsgDescription types.String `tfsdk:"status_msg_description"`
 }
 
 type ExportTransportPackageResource struct {
@@ -79,131 +88,136 @@ func (r *ExportTransportPackageResource) Metadata(ctx context.Context, req resou
 }
 
 func (r *ExportTransportPackageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique ID of the resource.",
+		},
+		"update_user": schema.StringAttribute{
+			Optional:    true,
+			Description: "Username of the user exporting the package.",
+		},
+		"transport_owner": schema.StringAttribute{
+			Optional:    true,
+			Description: "Option to transport owners for selected objects.",
+		},
+		"transport_members": schema.StringAttribute{
+			Optional:    true,
+			Description: "Option to transport members for selected objects such as SAV role.",
+		},
+		"export_online": schema.StringAttribute{
+			Required:    true,
+			Description: "Determines if package needs to be exported online (true/false).",
+		},
+		"export_path": schema.StringAttribute{
+			Required:    true,
+			Description: "Local path where export package will be generated (required if export_online is false).",
+		},
+		"environment_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Name of the environment (required if export_online is true).",
+		},
+		"business_justification": schema.StringAttribute{
+			Optional:    true,
+			Description: "Business justification for the export.",
+		},
+		"export_package_version": schema.StringAttribute{
+			Optional:    true,
+			Description: "Version identifier for the export package. Change this value to trigger re-export.",
+		},
+
+		// Objects to export
+		"sav_roles": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of SAV roles to export.",
+		},
+		"email_template": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of email templates to export.",
+		},
+		"roles": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of roles to export.",
+		},
+		"analytics_v1": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of analytics v1 to export.",
+		},
+		"analytics_v2": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of analytics v2 to export.",
+		},
+		"global_config": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of global configurations to export.",
+		},
+		"workflows": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of workflows to export.",
+		},
+		"connections": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of connections to export.",
+		},
+		"app_onboarding": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of app onboarding configurations to export.",
+		},
+		"user_groups": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of user groups to export.",
+		},
+		"scan_rules": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of scan rules to export.",
+		},
+		"organizations": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of organizations to export.",
+		},
+		"security_systems": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "List of security systems to export.",
+		},
+
+		// Response fields
+		"message": schema.StringAttribute{
+			Computed:    true,
+			Description: "Response message from the export operation.",
+		},
+		"file_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the generated transport package file.",
+		},
+		"msg_description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Detailed description of the response.",
+		},
+		"error_code": schema.StringAttribute{
+			Computed:    true,
+			Description: "Error code from the export operation.",
+		},
+	}
+	for name, attribute := range TransportStatusSchemaAttributes() {
+		attributes[name] = attribute
+	}
+
 	resp.Schema = schema.Schema{
 		Description: util.ExportTransportPackageDescription,
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unique ID of the resource.",
-			},
-			"update_user": schema.StringAttribute{
-				Optional:    true,
-				Description: "Username of the user exporting the package.",
-			},
-			"transport_owner": schema.StringAttribute{
-				Optional:    true,
-				Description: "Option to transport owners for selected objects.",
-			},
-			"transport_members": schema.StringAttribute{
-				Optional:    true,
-				Description: "Option to transport members for selected objects such as SAV role.",
-			},
-			"export_online": schema.StringAttribute{
-				Required:    true,
-				Description: "Determines if package needs to be exported online (true/false).",
-			},
-			"export_path": schema.StringAttribute{
-				Required:    true,
-				Description: "Local path where export package will be generated (required if export_online is false).",
-			},
-			"environment_name": schema.StringAttribute{
-				Optional:    true,
-				Description: "Name of the environment (required if export_online is true).",
-			},
-			"business_justification": schema.StringAttribute{
-				Optional:    true,
-				Description: "Business justification for the export.",
-			},
-			"export_package_version": schema.StringAttribute{
-				Optional:    true,
-				Description: "Version identifier for the export package. Change this value to trigger re-export.",
-			},
-
-			// Objects to export
-			"sav_roles": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of SAV roles to export.",
-			},
-			"email_template": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of email templates to export.",
-			},
-			"roles": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of roles to export.",
-			},
-			"analytics_v1": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of analytics v1 to export.",
-			},
-			"analytics_v2": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of analytics v2 to export.",
-			},
-			"global_config": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of global configurations to export.",
-			},
-			"workflows": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of workflows to export.",
-			},
-			"connections": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of connections to export.",
-			},
-			"app_onboarding": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of app onboarding configurations to export.",
-			},
-			"user_groups": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of user groups to export.",
-			},
-			"scan_rules": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of scan rules to export.",
-			},
-			"organizations": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of organizations to export.",
-			},
-			"security_systems": schema.ListAttribute{
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of security systems to export.",
-			},
-
-			// Response fields
-			"message": schema.StringAttribute{
-				Computed:    true,
-				Description: "Response message from the export operation.",
-			},
-			"file_name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Name of the generated transport package file.",
-			},
-			"msg_description": schema.StringAttribute{
-				Computed:    true,
-				Description: "Detailed description of the response.",
-			},
-			"error_code": schema.StringAttribute{
-				Computed:    true,
-				Description: "Error code from the export operation.",
-			},
-		},
+		Attributes:  attributes,
 	}
 }
 
@@ -387,6 +401,79 @@ func (r *ExportTransportPackageResource) ExportTransportPackage(ctx context.Cont
 	return apiResp, nil
 }
 
+// WaitForExportCompletion polls the export status until it reaches a terminal state and
+// records the status on the model. Online exports are tracked as transfer requests.
+func (r *ExportTransportPackageResource) WaitForExportCompletion(ctx context.Context, plan *ExportTransportPackageResourceModel) error {
+	if !plan.WaitForCompletion.ValueBool() {
+		plan.Status, plan.StatusMsgDescription = NullTransportStatus()
+		return nil
+	}
+	if plan.FileName.IsNull() || plan.FileName.ValueString() == "" {
+		return fmt.Errorf("export response did not include a file name, unable to track the export status")
+	}
+
+	operation := "export"
+	if strings.EqualFold(plan.ExportOnline.ValueString(), "true") {
+		operation = "transfer"
+	}
+	statusReq := openapi.TransportPackageStatusRequest{
+		Operation: operation,
+		Filename:  plan.FileName.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Waiting for transport package export to complete", map[string]interface{}{
+		"operation": operation,
+		"filename":  statusReq.Filename,
+	})
+
+	statusResp, err := WaitForTransportPackageStatus(ctx, r.provider, r.client, r.transportFactory, statusReq,
+		time.Duration(plan.StatusTimeout.ValueInt64())*time.Second,
+		time.Duration(plan.StatusPollInterval.ValueInt64())*time.Second)
+
+	plan.Status, plan.StatusMsgDescription = TransportStatusToModel(statusResp)
+
+	return err
+}
+
+// exportTriggerChanged reports whether any attribute that drives the export itself has changed.
+// Changes to the status polling settings alone do not re-export the package.
+func exportTriggerChanged(plan, state *ExportTransportPackageResourceModel) bool {
+	for _, pair := range [][2]types.String{
+		{plan.UpdateUser, state.UpdateUser},
+		{plan.TransportOwner, state.TransportOwner},
+		{plan.TransportMembers, state.TransportMembers},
+		{plan.ExportOnline, state.ExportOnline},
+		{plan.ExportPath, state.ExportPath},
+		{plan.EnvironmentName, state.EnvironmentName},
+		{plan.BusinessJustification, state.BusinessJustification},
+		{plan.ExportPackageVersion, state.ExportPackageVersion},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			return true
+		}
+	}
+	for _, pair := range [][2]types.List{
+		{plan.SavRoles, state.SavRoles},
+		{plan.EmailTemplate, state.EmailTemplate},
+		{plan.Roles, state.Roles},
+		{plan.AnalyticsV1, state.AnalyticsV1},
+		{plan.AnalyticsV2, state.AnalyticsV2},
+		{plan.GlobalConfig, state.GlobalConfig},
+		{plan.Workflows, state.Workflows},
+		{plan.Connections, state.Connections},
+		{plan.AppOnboarding, state.AppOnboarding},
+		{plan.UserGroups, state.UserGroups},
+		{plan.ScanRules, state.ScanRules},
+		{plan.Organizations, state.Organizations},
+		{plan.SecuritySystems, state.SecuritySystems},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateModelFromResponse updates the model with API response data
 func (r *ExportTransportPackageResource) UpdateModelFromResponse(plan *ExportTransportPackageResourceModel, apiResp *openapi.ExportTransportPackageResponse) {
 	plan.ID = types.StringValue("export-transport-" + plan.ExportPath.ValueString())
@@ -494,6 +581,18 @@ func (r *ExportTransportPackageResource) Create(ctx context.Context, req resourc
 	// Update model from response
 	r.UpdateModelFromResponse(&plan, apiResp)
 
+	// Wait for the export to reach a terminal status
+	if err := r.WaitForExportCompletion(ctx, &plan); err != nil {
+		tflog.Error(ctx, "Export transport package did not complete successfully", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Export Transport Package Failed",
+			err.Error(),
+		)
+		return
+	}
+
 	// Add success warning if operation completed successfully
 	r.AddSuccessWarning(resp, apiResp)
 
@@ -536,6 +635,25 @@ func (r *ExportTransportPackageResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	var state ExportTransportPackageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the polling settings changed, keep the result of the previous export
+	if !exportTriggerChanged(&plan, &state) {
+		plan.ID = state.ID
+		plan.Message = state.Message
+		plan.FileName = state.FileName
+		plan.MsgDescription = state.MsgDescription
+		plan.ErrorCode = state.ErrorCode
+		plan.Status = state.Status
+		plan.StatusMsgDescription = state.StatusMsgDescription
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Call the business logic method
 	apiResp, err := r.ExportTransportPackage(ctx, &plan, "update")
 	if err != nil {
@@ -552,6 +670,18 @@ func (r *ExportTransportPackageResource) Update(ctx context.Context, req resourc
 	// Update model from response
 	r.UpdateModelFromResponse(&plan, apiResp)
 
+	// Wait for the export to reach a terminal status
+	if err := r.WaitForExportCompletion(ctx, &plan); err != nil {
+		tflog.Error(ctx, "Export transport package did not complete successfully", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Export Transport Package Failed",
+			err.Error(),
+		)
+		return
+	}
+
 	// Add success warning if operation completed successfully
 	r.AddSuccessWarning(resp, apiResp)
 
@@ -5,9 +5,13 @@
 // The resource implements the full Terraform lifecycle:
 //   - Create: uploads a file to the specified location in Saviynt.
 //   - Read: maintains the current state.
-//   - Update: re-uploads the file if configuration changes.
+//   - Update: re-uploads the files if configuration or file content changes.
 //   - Delete: removes from Terraform state only (files remain in Saviynt).
 //
+// The SHA256 checksum of every file is computed at plan time, so editing a local file
+// schedules a re-upload without bumping file_version. Files are selected with file_path,
+// file_paths and file_glob, and all of them are uploaded to the same path_location.
+//
 // Supported file types and locations:
 //   - CSV files: uploaded to "Datafiles" directory
 //   - SAV files: uploaded to "SAV" directory
@@ -15,15 +19,24 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,6 +45,8 @@ import (
 )
 
 var _ resource.Resource = &FileUploadResource{}
+var _ resource.ResourceWithModifyPlan = &FileUploadResource{}
+var _ resource.ResourceWithValidateConfig = &FileUploadResource{}
 
 type FileUploadResource struct {
 	client            client.SaviyntClientInterface
@@ -55,8 +70,12 @@ func NewFileUploadResourceWithFactory(factory client.FileFactoryInterface) resou
 type FileUploadResourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	FilePath     types.String `tfsdk:"file_path"`
+	FilePaths    types.Set    `tfsdk:"file_paths"`
+	FileGlob     types.String `tfsdk:"file_glob"`
 	PathLocation types.String `tfsdk:"path_location"`
 	FileVersion  types.String `tfsdk:"file_version"`
+	FileSHA256   types.String `tfsdk:"file_sha256"`
+	FileHashes   types.Map    `tfsdk:"file_hashes"`
 	Message      types.String `tfsdk:"message"`
 	ErrorCode    types.String `tfsdk:"error_code"`
 }
@@ -74,8 +93,17 @@ func (r *FileUploadResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Unique identifier for the file upload",
 			},
 			"file_path": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Path to the file to upload",
+				Optional:            true,
+				MarkdownDescription: "Path to the file to upload. At least one of `file_path`, `file_paths` or `file_glob` must be set.",
+			},
+			"file_paths": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Paths of additional files to upload to the same location.",
+			},
+			"file_glob": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Glob pattern such as `feeds/*.csv` selecting files to upload to the same location. The pattern is resolved at plan time and must match at least one file. It follows the syntax of Go's `filepath.Glob`: `*` does not match the path separator and `**` is not supported, so files in subdirectories need a pattern per directory level, such as `feeds/*/*.csv`.",
 			},
 			"path_location": schema.StringAttribute{
 				Required:            true,
@@ -83,7 +111,16 @@ func (r *FileUploadResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"file_version": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "File version identifier. This acts as a change trigger - increment this value when you need to re-upload the same file with modifications.",
+				MarkdownDescription: "File version identifier. This acts as a change trigger - increment this value to re-upload files whose content did not change. Content changes are detected through `file_hashes` without changing this value.",
+			},
+			"file_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA256 checksum of the file at `file_path`, computed at plan time. A change re-uploads the files. Unknown until apply when a selected file does not exist at plan time.",
+			},
+			"file_hashes": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "SHA256 checksum of every uploaded file keyed by its path, computed at plan time. A change re-uploads the files. Unknown until apply when a selected file does not exist at plan time.",
 			},
 			"message": schema.StringAttribute{
 				Computed:            true,
@@ -169,10 +206,207 @@ func (r *FileUploadResource) ValidateFilePath(filePath string) error {
 	return nil
 }
 
-func (r *FileUploadResource) UploadFile(ctx context.Context, plan FileUploadResourceModel, op string) (*openapi.UploadSchemaFileResponse, error) {
-	filePath := plan.FilePath.ValueString()
-	pathLocation := plan.PathLocation.ValueString()
+// ResolveFilePaths returns the sorted, de-duplicated list of files selected by file_path,
+// file_paths and file_glob. It returns nil without error when any of them is unknown.
+func (r *FileUploadResource) ResolveFilePaths(ctx context.Context, model FileUploadResourceModel) ([]string, error) {
+	if model.FilePath.IsUnknown() || model.FilePaths.IsUnknown() || model.FileGlob.IsUnknown() {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	var filePaths []string
+	add := func(filePath string) {
+		if filePath != "" && !seen[filePath] {
+			seen[filePath] = true
+			filePaths = append(filePaths, filePath)
+		}
+	}
 
+	add(model.FilePath.ValueString())
+
+	if !model.FilePaths.IsNull() {
+		var paths []string
+		if diags := model.FilePaths.ElementsAs(ctx, &paths, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read file_paths")
+		}
+		for _, filePath := range paths {
+			if filePath == "" {
+				return nil, fmt.Errorf("file_paths must not contain empty values")
+			}
+			add(filePath)
+		}
+	}
+
+	if pattern := model.FileGlob.ValueString(); pattern != "" {
+		if err := r.ValidateFilePath(pattern); err != nil {
+			return nil, err
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file_glob '%s': %s", pattern, err.Error())
+		}
+		matched := 0
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || info.IsDir() {
+				continue
+			}
+			add(match)
+			matched++
+		}
+		if matched == 0 {
+			return nil, fmt.Errorf("file_glob '%s' did not match any files", pattern)
+		}
+	}
+
+	sort.Strings(filePaths)
+	return filePaths, nil
+}
+
+// HashFile returns the hex encoded SHA256 checksum of the file content
+func (r *FileUploadResource) HashFile(filePath string) (string, error) {
+	if err := r.ValidateFilePath(filePath); err != nil {
+		return "", err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file '%s': %w", filePath, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read file '%s': %s", filePath, err.Error())
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ComputeFileHashes resolves the selected files and sets file_sha256, file_hashes and id on the
+// model. The hashes are left unknown when the selected files are not known yet and, when
+// allowMissing is set, when a selected file does not exist yet because another resource of the
+// same apply creates it.
+func (r *FileUploadResource) ComputeFileHashes(ctx context.Context, model *FileUploadResourceModel, allowMissing bool) (map[string]string, error) {
+	filePaths, err := r.ResolveFilePaths(ctx, *model)
+	if err != nil {
+		return nil, err
+	}
+	if filePaths == nil {
+		model.FileSHA256 = types.StringUnknown()
+		model.FileHashes = types.MapUnknown(types.StringType)
+		return nil, nil
+	}
+	if len(filePaths) == 0 {
+		return nil, fmt.Errorf("at least one of file_path, file_paths or file_glob must select a file")
+	}
+
+	hashes := make(map[string]string, len(filePaths))
+	for _, filePath := range filePaths {
+		if err := r.VerifyFilePathLocationCompatibility(ctx, filePath, model.PathLocation.ValueString()); err != nil {
+			return nil, err
+		}
+		sum, err := r.HashFile(filePath)
+		if err != nil && allowMissing && errors.Is(err, fs.ErrNotExist) {
+			tflog.Debug(ctx, "File does not exist yet, leaving checksums unknown", map[string]interface{}{
+				"file_path": filePath,
+			})
+			model.FileSHA256 = types.StringUnknown()
+			model.FileHashes = types.MapUnknown(types.StringType)
+			model.ID = types.StringValue(fileUploadID(model, filePaths))
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		hashes[filePath] = sum
+	}
+
+	hashValues := make(map[string]attr.Value, len(hashes))
+	for filePath, sum := range hashes {
+		hashValues[filePath] = types.StringValue(sum)
+	}
+	model.FileHashes = types.MapValueMust(types.StringType, hashValues)
+	if filePath := model.FilePath.ValueString(); filePath != "" {
+		model.FileSHA256 = types.StringValue(hashes[filePath])
+	} else {
+		model.FileSHA256 = types.StringNull()
+	}
+	model.ID = types.StringValue(fileUploadID(model, filePaths))
+
+	return hashes, nil
+}
+
+// fileUploadID keeps the historical "<file>_<location>" ID for a single file_path and derives a
+// stable ID from the selected paths otherwise.
+func fileUploadID(model *FileUploadResourceModel, filePaths []string) string {
+	pathLocation := model.PathLocation.ValueString()
+	if len(filePaths) == 1 && filePaths[0] == model.FilePath.ValueString() {
+		return fmt.Sprintf("%s_%s", filepath.Base(filePaths[0]), pathLocation)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(filePaths, "\n")))
+	return fmt.Sprintf("%s_%s", pathLocation, hex.EncodeToString(sum[:])[:16])
+}
+
+func (r *FileUploadResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config FileUploadResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.FilePath.IsUnknown() || config.FilePaths.IsUnknown() || config.FileGlob.IsUnknown() {
+		return
+	}
+	if config.FilePath.IsNull() && config.FilePaths.IsNull() && config.FileGlob.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Missing File Selection",
+			"At least one of file_path, file_paths or file_glob must be set.",
+		)
+	}
+}
+
+// ModifyPlan computes the SHA256 checksum of the selected files so that a content change
+// shows up in the plan as an update of file_hashes. Files that do not exist yet, such as the
+// filename of a local_file created in the same apply, are hashed when they are uploaded.
+func (r *FileUploadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileUploadResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.ComputeFileHashes(ctx, &plan, true); err != nil {
+		resp.Diagnostics.AddError(
+			"File Upload Plan Failed",
+			fmt.Sprintf("Error computing file checksums: %s", err.Error()),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state FileUploadResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.FileHashes.Equal(state.FileHashes) {
+			tflog.Debug(ctx, "File content changed, planning re-upload", map[string]interface{}{
+				"id": plan.ID.ValueString(),
+			})
+			plan.Message = types.StringUnknown()
+			plan.ErrorCode = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// UploadFile uploads a single file to pathLocation
+func (r *FileUploadResource) UploadFile(ctx context.Context, filePath string, pathLocation string, op string) (*openapi.UploadSchemaFileResponse, error) {
 	// Validate file path and location compatibility
 	if err := r.VerifyFilePathLocationCompatibility(ctx, filePath, pathLocation); err != nil {
 		return nil, err
@@ -188,15 +422,15 @@ func (r *FileUploadResource) UploadFile(ctx context.Context, plan FileUploadReso
 		return nil, err
 	}
 
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file '%s': %s", filePath, err.Error())
-	}
-	defer file.Close()
-
 	var uploadResp *openapi.UploadSchemaFileResponse
 
-	err = r.provider.AuthenticatedAPICallWithRetry(ctx, op, func(token string) error {
+	err := r.provider.AuthenticatedAPICallWithRetry(ctx, op, func(token string) error {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to open file '%s': %s", filePath, err.Error())
+		}
+		defer file.Close()
+
 		fileuploadOps := r.uploadFileFactory.CreateFileOperations(r.client.APIBaseURL(), token)
 		apiResp, httpResp, apiErr := fileuploadOps.UploadSchemaFile(ctx, file, pathLocation)
 
@@ -223,6 +457,45 @@ func (r *FileUploadResource) UploadFile(ctx context.Context, plan FileUploadReso
 	return uploadResp, nil
 }
 
+// UploadFiles uploads every selected file and sets the computed attributes on the plan. The
+// checksums are recomputed before uploading so that a file edited after the plan was made is
+// reported instead of silently uploading content that differs from the plan.
+func (r *FileUploadResource) UploadFiles(ctx context.Context, plan *FileUploadResourceModel, op string) error {
+	plannedHashes := plan.FileHashes
+	hashes, err := r.ComputeFileHashes(ctx, plan, false)
+	if err != nil {
+		return err
+	}
+	if !plannedHashes.IsUnknown() && !plannedHashes.IsNull() && !plannedHashes.Equal(plan.FileHashes) {
+		return fmt.Errorf("file content changed after the plan was created, run terraform plan again")
+	}
+
+	filePaths := make([]string, 0, len(hashes))
+	for filePath := range hashes {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	var messages []string
+	errorCode := ""
+	for _, filePath := range filePaths {
+		uploadResp, err := r.UploadFile(ctx, filePath, plan.PathLocation.ValueString(), op)
+		if err != nil {
+			return err
+		}
+		if msg := uploadResp.GetMsg(); msg != "" && !slices.Contains(messages, msg) {
+			messages = append(messages, msg)
+		}
+		if code := uploadResp.GetErrorCode(); errorCode == "" || errorCode == "0" {
+			errorCode = code
+		}
+	}
+
+	plan.Message = types.StringValue(strings.Join(messages, "; "))
+	plan.ErrorCode = types.StringValue(errorCode)
+	return nil
+}
+
 func (r *FileUploadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan FileUploadResourceModel
 
@@ -231,8 +504,7 @@ func (r *FileUploadResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	uploadResp, err := r.UploadFile(ctx, plan, "upload_file")
-	if err != nil {
+	if err := r.UploadFiles(ctx, &plan, "upload_file"); err != nil {
 		resp.Diagnostics.AddError(
 			"File Upload Failed",
 			fmt.Sprintf("Error uploading file: %s", err.Error()),
@@ -240,14 +512,9 @@ func (r *FileUploadResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Set computed values
-	plan.ID = types.StringValue(fmt.Sprintf("%s_%s", filepath.Base(plan.FilePath.ValueString()), plan.PathLocation.ValueString()))
-	plan.Message = types.StringValue(uploadResp.GetMsg())
-	plan.ErrorCode = types.StringValue(uploadResp.GetErrorCode())
-
 	tflog.Debug(ctx, "File uploaded successfully", map[string]interface{}{
-		"message":    uploadResp.GetMsg(),
-		"error_code": uploadResp.GetErrorCode(),
+		"message":    plan.Message.ValueString(),
+		"error_code": plan.ErrorCode.ValueString(),
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -261,7 +528,7 @@ func (r *FileUploadResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// File upload is a one-time operation, so we just keep the state as is
+	// Files cannot be read back from Saviynt; content drift of the local files is detected at plan time
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -273,8 +540,7 @@ func (r *FileUploadResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	uploadResp, err := r.UploadFile(ctx, plan, "upload_file_update")
-	if err != nil {
+	if err := r.UploadFiles(ctx, &plan, "upload_file_update"); err != nil {
 		resp.Diagnostics.AddError(
 			"Updated File Upload Failed",
 			fmt.Sprintf("Error uploading updated file: %s", err.Error()),
@@ -282,14 +548,9 @@ func (r *FileUploadResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Set computed values
-	plan.ID = types.StringValue(fmt.Sprintf("%s_%s", filepath.Base(plan.FilePath.ValueString()), plan.PathLocation.ValueString()))
-	plan.Message = types.StringValue(uploadResp.GetMsg())
-	plan.ErrorCode = types.StringValue(uploadResp.GetErrorCode())
-
 	tflog.Debug(ctx, "Updated file uploaded successfully", map[string]interface{}{
-		"message":    uploadResp.GetMsg(),
-		"error_code": uploadResp.GetErrorCode(),
+		"message":    plan.Message.ValueString(),
+		"error_code": plan.ErrorCode.ValueString(),
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -0,0 +1,358 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_firefighter_roles retrieves the firefighter (emergency access) roles from the Saviynt Security Manager.
+// The data source supports a single Read operation that lists every firefighter role, or a single role by name,
+// together with its time frame limits, the firefighter ID accounts mapped to it and its last certification.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/endpointsutil"
+	"terraform-provider-Saviynt/util/rolesutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/roles"
+)
+
+var _ datasource.DataSource = &FirefighterRolesDataSource{}
+var _ datasource.DataSourceWithConfigure = &FirefighterRolesDataSource{}
+
+// FirefighterRolesDataSource implements the datasource.DataSource interface for firefighter roles
+type FirefighterRolesDataSource struct {
+	client      client.SaviyntClientInterface
+	token       string
+	provider    client.SaviyntProviderInterface
+	roleFactory client.RoleFactoryInterface
+}
+
+type FirefighterRolesDataSourceModel struct {
+	ID               types.String      `tfsdk:"id"`
+	RoleName         types.String      `tfsdk:"role_name"`
+	Authenticate     types.Bool        `tfsdk:"authenticate"`
+	TotalCount       types.Int64       `tfsdk:"total_count"`
+	FirefighterRoles []FirefighterRole `tfsdk:"firefighter_roles"`
+}
+
+// FirefighterRole represents a single firefighter role returned by the data source
+type FirefighterRole struct {
+	ID                        types.String `tfsdk:"id"`
+	RoleName                  types.String `tfsdk:"role_name"`
+	DisplayName               types.String `tfsdk:"display_name"`
+	Description               types.String `tfsdk:"description"`
+	Glossary                  types.String `tfsdk:"glossary"`
+	RoleType                  types.String `tfsdk:"role_type"`
+	Status                    types.String `tfsdk:"status"`
+	RoleState                 types.String `tfsdk:"role_state"`
+	Risk                      types.String `tfsdk:"risk"`
+	Level                     types.String `tfsdk:"level"`
+	Requestable               types.Bool   `tfsdk:"requestable"`
+	EndpointKey               types.Int64  `tfsdk:"endpoint_key"`
+	DefaultTimeFrameHrs       types.Int64  `tfsdk:"default_time_frame_hrs"`
+	MaxTimeFrameHrs           types.Int64  `tfsdk:"max_time_frame_hrs"`
+	FirefighterAccountKeys    types.List   `tfsdk:"firefighter_account_keys"`
+	AccessControlEntitlements types.String `tfsdk:"access_control_entitlements"`
+	LastReviewedBy            types.String `tfsdk:"last_reviewed_by"`
+	LastReviewedCampaignName  types.String `tfsdk:"last_reviewed_campaign_name"`
+	LastReviewedDate          types.String `tfsdk:"last_reviewed_date"`
+	UpdateDate                types.String `tfsdk:"update_date"`
+}
+
+func NewFirefighterRolesDataSource() datasource.DataSource {
+	return &FirefighterRolesDataSource{
+		roleFactory: &client.DefaultRoleFactory{},
+	}
+}
+
+// NewFirefighterRolesDataSourceWithFactory creates a new firefighter roles data source with custom factory
+// Used primarily for testing with mock factories
+func NewFirefighterRolesDataSourceWithFactory(factory client.RoleFactoryInterface) datasource.DataSource {
+	return &FirefighterRolesDataSource{
+		roleFactory: factory,
+	}
+}
+
+// SetClient sets the client for testing purposes
+func (d *FirefighterRolesDataSource) SetClient(client client.SaviyntClientInterface) {
+	d.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (d *FirefighterRolesDataSource) SetToken(token string) {
+	d.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (d *FirefighterRolesDataSource) SetProvider(provider client.SaviyntProviderInterface) {
+	d.provider = provider
+}
+
+func (d *FirefighterRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firefighter_roles"
+}
+
+func (d *FirefighterRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.FirefighterRolesDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the data source.",
+			},
+			"role_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the firefighter role with this name.",
+			},
+			"authenticate": schema.BoolAttribute{
+				Required:    true,
+				Description: "Controls visibility of sensitive data in Terraform state. When 'false', firefighter roles are omitted from state to prevent sensitive data exposure. When 'true', all firefighter roles are returned in state.",
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of firefighter roles returned.",
+			},
+			"firefighter_roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of firefighter roles retrieved, ordered by role name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                          schema.StringAttribute{Computed: true, Description: "Role key."},
+						"role_name":                   schema.StringAttribute{Computed: true, Description: "Name of the role."},
+						"display_name":                schema.StringAttribute{Computed: true, Description: "Display name of the role."},
+						"description":                 schema.StringAttribute{Computed: true, Description: "Description of the role."},
+						"glossary":                    schema.StringAttribute{Computed: true, Description: "Glossary of the role."},
+						"role_type":                   schema.StringAttribute{Computed: true, Description: "Type of the role."},
+						"status":                      schema.StringAttribute{Computed: true, Description: "Status of the role. Either 'Active' or 'Inactive'."},
+						"role_state":                  schema.StringAttribute{Computed: true, Description: "State of the role."},
+						"risk":                        schema.StringAttribute{Computed: true, Description: "Risk of the role."},
+						"level":                       schema.StringAttribute{Computed: true, Description: "Level of the role."},
+						"requestable":                 schema.BoolAttribute{Computed: true, Description: "Whether the role can be requested."},
+						"endpoint_key":                schema.Int64Attribute{Computed: true, Description: "Key of the endpoint the role belongs to."},
+						"default_time_frame_hrs":      schema.Int64Attribute{Computed: true, Description: "Default time frame in hours for which firefighter access is granted."},
+						"max_time_frame_hrs":          schema.Int64Attribute{Computed: true, Description: "Maximum time frame in hours for which firefighter access can be requested."},
+						"firefighter_account_keys":    schema.ListAttribute{ElementType: types.Int64Type, Computed: true, Description: "Keys of the firefighter ID accounts mapped to the role."},
+						"access_control_entitlements": schema.StringAttribute{Computed: true, Description: "Access control entitlements of the role as JSON."},
+						"last_reviewed_by":            schema.StringAttribute{Computed: true, Description: "User who last certified the role."},
+						"last_reviewed_campaign_name": schema.StringAttribute{Computed: true, Description: "Campaign in which the role was last certified."},
+						"last_reviewed_date":          schema.StringAttribute{Computed: true, Description: "Date the role was last certified, in RFC 3339 format."},
+						"update_date":                 schema.StringAttribute{Computed: true, Description: "Date the role was last updated, in RFC 3339 format."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirefighterRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting firefighter roles datasource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	d.client = &client.SaviyntClientWrapper{Client: prov.client}
+	d.token = prov.accessToken
+	d.provider = &client.SaviyntProviderWrapper{Provider: prov}
+	tflog.Debug(ctx, "Firefighter roles datasource configured successfully")
+}
+
+// ReadFirefighterRoles retrieves all firefighter roles from Saviynt
+func ReadFirefighterRoles(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface) ([]openapi.GetFireFighterRole, error) {
+	var roles []openapi.GetFireFighterRole
+	var finalHttpResp *http.Response
+	err := provider.AuthenticatedAPICallWithRetry(ctx, "get_firefighter_roles", func(token string) error {
+		roleOps := factory.CreateRoleOperations(apiClient.APIBaseURL(), token)
+		resp, httpResp, err := roleOps.GetFireFighterRoles(ctx)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		roles = resp
+		finalHttpResp = httpResp // Capture final HTTP response
+		return err
+	})
+
+	var diags diag.Diagnostics
+	if rolesutil.RoleHandleHTTPError(ctx, finalHttpResp, err, "reading firefighter roles", &diags) {
+		return nil, fmt.Errorf("failed to read firefighter roles: %v", diags.Errors())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading firefighter roles: %v", err)
+	}
+	return roles, nil
+}
+
+// FindFirefighterRole returns the firefighter role with the given name, or nil when there is none
+func FindFirefighterRole(roles []openapi.GetFireFighterRole, roleName string) *openapi.GetFireFighterRole {
+	for i := range roles {
+		if strings.EqualFold(util.SafeDeref(roles[i].RoleName), roleName) {
+			return &roles[i]
+		}
+	}
+	return nil
+}
+
+// FirefighterAccountKeys returns the keys of the firefighter ID accounts mapped to the role
+func FirefighterAccountKeys(role *openapi.GetFireFighterRole) []int64 {
+	keys := []int64{}
+	if role == nil {
+		return keys
+	}
+	for _, account := range role.RoleUserAccounts {
+		if account.Id != nil {
+			keys = append(keys, int64(*account.Id))
+		}
+	}
+	return keys
+}
+
+func (d *FirefighterRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state FirefighterRolesDataSourceModel
+
+	tflog.Debug(ctx, "Starting firefighter roles datasource read")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := ReadFirefighterRoles(ctx, d.provider, d.client, d.roleFactory)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Firefighter Roles Read Failed",
+			fmt.Sprintf("Failed to read firefighter roles: %s", err.Error()),
+		)
+		return
+	}
+
+	sort.SliceStable(roles, func(i, j int) bool {
+		return util.SafeDeref(roles[i].RoleName) < util.SafeDeref(roles[j].RoleName)
+	})
+
+	roleName := state.RoleName.ValueString()
+	state.FirefighterRoles = make([]FirefighterRole, 0, len(roles))
+	for i := range roles {
+		if roleName != "" && !strings.EqualFold(util.SafeDeref(roles[i].RoleName), roleName) {
+			continue
+		}
+		role, diags := d.mapFirefighterRole(ctx, &roles[i])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.FirefighterRoles = append(state.FirefighterRoles, role)
+	}
+
+	if roleName != "" && len(state.FirefighterRoles) == 0 {
+		resp.Diagnostics.AddError(
+			"No Data Found",
+			fmt.Sprintf("Firefighter role %s was not found.", roleName),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("firefighter-roles")
+	if roleName != "" {
+		state.ID = types.StringValue("firefighter-roles-" + roleName)
+	}
+	state.TotalCount = types.Int64Value(int64(len(state.FirefighterRoles)))
+
+	if !state.Authenticate.IsNull() && !state.Authenticate.IsUnknown() {
+		if state.Authenticate.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"Authentication Enabled",
+				"`authenticate` is true; all firefighter roles will be returned in state.",
+			)
+		} else {
+			resp.Diagnostics.AddWarning(
+				"Authentication Disabled",
+				"`authenticate` is false; firefighter roles will be removed from state.",
+			)
+			state.FirefighterRoles = nil
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Firefighter roles datasource read completed successfully", map[string]interface{}{
+		"total_count": state.TotalCount.ValueInt64(),
+	})
+}
+
+func (d *FirefighterRolesDataSource) mapFirefighterRole(ctx context.Context, item *openapi.GetFireFighterRole) (FirefighterRole, diag.Diagnostics) {
+	role := FirefighterRole{
+		RoleName:                 util.SafeStringDatasource(item.RoleName),
+		DisplayName:              util.SafeStringDatasource(item.Displayname),
+		Description:              util.SafeStringDatasource(item.Description),
+		Glossary:                 util.SafeStringDatasource(item.Glossary),
+		RoleState:                util.SafeStringDatasource(item.RoleState),
+		Risk:                     util.SafeStringDatasource(item.Risk),
+		Level:                    util.SafeStringDatasource(item.Level),
+		Requestable:              util.SafeBoolDatasource(item.Requestable),
+		EndpointKey:              util.SafeInt64(item.Endpointkey),
+		DefaultTimeFrameHrs:      util.SafeInt64(item.DefaultTimeFrameHrs),
+		MaxTimeFrameHrs:          util.SafeInt64(item.MaxTimeFrameHrs),
+		LastReviewedBy:           util.SafeStringDatasource(item.LastReviewedBy),
+		LastReviewedCampaignName: util.SafeStringDatasource(item.LastReviewedCampaignName),
+		LastReviewedDate:         formatRoleTime(item.LastReviewedDate),
+		UpdateDate:               formatRoleTime(item.Updatedate),
+	}
+
+	role.ID = types.StringNull()
+	if item.Id != nil {
+		role.ID = types.StringValue(strconv.Itoa(int(*item.Id)))
+	}
+	role.RoleType = types.StringNull()
+	if item.Roletype != nil {
+		role.RoleType = types.StringValue(endpointsutil.TranslateValue(strconv.Itoa(int(*item.Roletype)), rolesutil.RoleTypeMap))
+	}
+	role.Status = types.StringNull()
+	if item.Status != nil {
+		role.Status = types.StringValue(endpointsutil.TranslateValue(strconv.Itoa(int(*item.Status)), rolesutil.StatusMap))
+	}
+
+	role.AccessControlEntitlements = types.StringNull()
+	if item.AccessControlEntitlements != nil {
+		if data, err := json.Marshal(item.AccessControlEntitlements); err == nil {
+			role.AccessControlEntitlements = types.StringValue(string(data))
+		}
+	}
+
+	keys, diags := types.ListValueFrom(ctx, types.Int64Type, FirefighterAccountKeys(item))
+	role.FirefighterAccountKeys = keys
+	return role, diags
+}
+
+// formatRoleTime formats a role timestamp in RFC 3339, or returns null when it is not set
+func formatRoleTime(t *time.Time) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
 	"terraform-provider-Saviynt/util/errorsutil"
@@ -38,14 +40,14 @@ var githubRestErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.Connecto
 
 type GithubRestConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                      types.String `tfsdk:"id"`
-	ConnectionJSON          types.String `tfsdk:"connection_json"`
-	ConnectionJSONWO        types.String `tfsdk:"connection_json_wo"`
-	ImportAccountEntJSON    types.String `tfsdk:"import_account_ent_json"`
-	Access_Tokens           types.String `tfsdk:"access_tokens"`
-	Access_TokensWO         types.String `tfsdk:"access_tokens_wo"`
-	Organization_List       types.String `tfsdk:"organization_list"`
-	Status_Threshold_Config types.String `tfsdk:"status_threshold_config"`
+	ID                      types.String           `tfsdk:"id"`
+	ConnectionJSON          customtypes.JSONString `tfsdk:"connection_json"`
+	ConnectionJSONWO        types.String           `tfsdk:"connection_json_wo"`
+	ImportAccountEntJSON    customtypes.JSONString `tfsdk:"import_account_ent_json"`
+	Access_Tokens           types.String           `tfsdk:"access_tokens"`
+	Access_TokensWO         types.String           `tfsdk:"access_tokens_wo"`
+	Organization_List       types.String           `tfsdk:"organization_list"`
+	Status_Threshold_Config customtypes.JSONString `tfsdk:"status_threshold_config"`
 }
 
 type GithubRestConnectionResource struct {
@@ -79,11 +81,13 @@ func GithubRestConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Resource ID.",
 		},
 		"connection_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Property for ConnectionJSON. For setting connection_json either this field or connection_json_wo need to be set",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json_wo")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"connection_json_wo": schema.StringAttribute{
@@ -92,12 +96,17 @@ func GithubRestConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Property for ConnectionJSON (write-only). For setting connection_json either this field or connection_json_wo need to be set",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"import_account_ent_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Property for ImportAccountEntJSON",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_import_account_ent_json"),
+			},
 		},
 		"access_tokens": schema.StringAttribute{
 			Optional:    true,
@@ -121,9 +130,13 @@ func GithubRestConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Property for ORGANIZATION_LIST",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Property for STATUS_THRESHOLD_CONFIG",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 	}
 }
@@ -225,8 +238,14 @@ func (r *GithubRestConnectionResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateGithubRestConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateGithubRestConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "GitHub REST connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -294,7 +313,7 @@ func (r *GithubRestConnectionResource) CreateGithubRestConnection(ctx context.Co
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_githubrest_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, githubRestConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, githubRestConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -355,10 +374,10 @@ func (r *GithubRestConnectionResource) BuildGithubRestConnector(plan *GithubRest
 			EmailTemplate:         util.StringPointerOrEmpty(plan.EmailTemplate),
 		},
 		ConnectionJSON:          util.StringPointerOrEmpty(types.StringValue(connectionJson)),
-		ImportAccountEntJSON:    util.StringPointerOrEmpty(plan.ImportAccountEntJSON),
+		ImportAccountEntJSON:    util.StringPointerOrEmpty(plan.ImportAccountEntJSON.StringValue),
 		ACCESS_TOKENS:           util.StringPointerOrEmpty(types.StringValue(accessTokens)),
 		ORGANIZATION_LIST:       util.StringPointerOrEmpty(plan.Organization_List),
-		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.Status_Threshold_Config),
+		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.Status_Threshold_Config.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -380,9 +399,9 @@ func (r *GithubRestConnectionResource) UpdateModelFromCreateResponse(plan *Githu
 	plan.Description = util.SafeStringDatasource(plan.Description.ValueStringPointer())
 	plan.DefaultSavRoles = util.SortedCommaSeparated(util.SafeStringDatasource(plan.DefaultSavRoles.ValueStringPointer()))
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
-	plan.ImportAccountEntJSON = util.SafeStringDatasource(plan.ImportAccountEntJSON.ValueStringPointer())
+	plan.ImportAccountEntJSON = customtypes.NewJSONStringPointerValue(plan.ImportAccountEntJSON.ValueStringPointer())
 	plan.Organization_List = util.SafeStringDatasource(plan.Organization_List.ValueStringPointer())
-	plan.Status_Threshold_Config = util.SafeStringDatasource(plan.Status_Threshold_Config.ValueStringPointer())
+	plan.Status_Threshold_Config = customtypes.NewJSONStringPointerValue(plan.Status_Threshold_Config.ValueStringPointer())
 
 	if apiResp != nil {
 		plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
@@ -426,7 +445,9 @@ func (r *GithubRestConnectionResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.GithubRESTConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -517,9 +538,10 @@ func (r *GithubRestConnectionResource) UpdateModelFromReadResponse(state *Github
 	state.Description = util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Description)
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Emailtemplate)
-	state.ImportAccountEntJSON = util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Connectionattributes.ImportAccountEntJSON)
+	state.ImportAccountEntJSON = customtypes.NewJSONStringPointerValue(apiResp.GithubRESTConnectionResponse.Connectionattributes.ImportAccountEntJSON)
 	state.Organization_List = util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Connectionattributes.ORGANIZATION_LIST)
-	state.Status_Threshold_Config = util.SafeStringDatasource(apiResp.GithubRESTConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.Status_Threshold_Config = customtypes.NewJSONStringPointerValue(apiResp.GithubRESTConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.GithubRESTConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *GithubRestConnectionResource) ValidateGithubRestConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -603,8 +625,14 @@ func (r *GithubRestConnectionResource) Update(ctx context.Context, req resource.
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateGithubRestConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateGithubRestConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "GitHub REST connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -625,7 +653,9 @@ func (r *GithubRestConnectionResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -668,7 +698,7 @@ func (r *GithubRestConnectionResource) UpdateGithubRestConnection(ctx context.Co
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_githubrest_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, githubRestConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, githubRestConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
 	"terraform-provider-Saviynt/internal/client"
 	"terraform-provider-Saviynt/util"
@@ -33,6 +35,13 @@ type ImportTransportPackageResourceModel struct {
 	RequestID             types.String `tfsdk:"request_id"`
 	Message               types.String `tfsdk:"message"`
 	ErrorCode             types.String `tfsdk:"error_code"`
+
+	// Status polling
+	WaitForCompletion    types.Bool   `tfsdk:"wait_for_completion"`
+	StatusTimeout        types.Int64  `tfsdk:"status_timeout"`
+	StatusPollInterval   types.Int64  `tfsdk:"status_poll_interval"`
+	Status               types.String `tfsdk:"status"`
+	StatusMsgDescription types.String `tfsdk:"status_msg_description"`
 }
 
 type ImportTransportPackageResource struct {
@@ -59,42 +68,47 @@ func (r *ImportTransportPackageResource) Metadata(ctx context.Context, req resou
 }
 
 func (r *ImportTransportPackageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique ID of the resource.",
+		},
+		"package_path": schema.StringAttribute{
+			Required:    true,
+			Description: "Complete path of the package that needs to be imported.",
+		},
+		"update_user": schema.StringAttribute{
+			Optional:    true,
+			Description: "Username of the user importing the package.",
+		},
+		"business_justification": schema.StringAttribute{
+			Optional:    true,
+			Description: "Business justification for the import.",
+		},
+		"import_package_version": schema.StringAttribute{
+			Optional:    true,
+			Description: "Version identifier for the import package. Change this value to trigger re-import of the same package.",
+		},
+		"request_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Request ID generated during import submission.",
+		},
+		"message": schema.StringAttribute{
+			Computed:    true,
+			Description: "Response message from the import operation.",
+		},
+		"error_code": schema.StringAttribute{
+			Computed:    true,
+			Description: "Error code from the import operation.",
+		},
+	}
+	for name, attribute := range TransportStatusSchemaAttributes() {
+		attributes[name] = attribute
+	}
+
 	resp.Schema = schema.Schema{
 		Description: util.ImportTransportPackageDescription,
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unique ID of the resource.",
-			},
-			"package_path": schema.StringAttribute{
-				Required:    true,
-				Description: "Complete path of the package that needs to be imported.",
-			},
-			"update_user": schema.StringAttribute{
-				Optional:    true,
-				Description: "Username of the user importing the package.",
-			},
-			"business_justification": schema.StringAttribute{
-				Optional:    true,
-				Description: "Business justification for the import.",
-			},
-			"import_package_version": schema.StringAttribute{
-				Optional:    true,
-				Description: "Version identifier for the import package. Change this value to trigger re-import of the same package.",
-			},
-			"request_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "Request ID generated during import submission.",
-			},
-			"message": schema.StringAttribute{
-				Computed:    true,
-				Description: "Response message from the import operation.",
-			},
-			"error_code": schema.StringAttribute{
-				Computed:    true,
-				Description: "Error code from the import operation.",
-			},
-		},
+		Attributes:  attributes,
 	}
 }
 
@@ -182,6 +196,45 @@ func (r *ImportTransportPackageResource) ImportTransportPackage(ctx context.Cont
 	return apiResp, nil
 }
 
+// WaitForImportCompletion polls the import status until it reaches a terminal state and
+// records the status on the model
+func (r *ImportTransportPackageResource) WaitForImportCompletion(ctx context.Context, plan *ImportTransportPackageResourceModel) error {
+	if !plan.WaitForCompletion.ValueBool() {
+		plan.Status, plan.StatusMsgDescription = NullTransportStatus()
+		return nil
+	}
+
+	statusReq := openapi.TransportPackageStatusRequest{
+		Operation: "import",
+		Filename:  filepath.Base(plan.PackagePath.ValueString()),
+	}
+	if !plan.RequestID.IsNull() && plan.RequestID.ValueString() != "" {
+		statusReq.Requestid = plan.RequestID.ValueStringPointer()
+	}
+
+	tflog.Debug(ctx, "Waiting for transport package import to complete", map[string]interface{}{
+		"filename":   statusReq.Filename,
+		"request_id": plan.RequestID.ValueString(),
+	})
+
+	statusResp, err := WaitForTransportPackageStatus(ctx, r.provider, r.client, r.transportFactory, statusReq,
+		time.Duration(plan.StatusTimeout.ValueInt64())*time.Second,
+		time.Duration(plan.StatusPollInterval.ValueInt64())*time.Second)
+
+	plan.Status, plan.StatusMsgDescription = TransportStatusToModel(statusResp)
+
+	return err
+}
+
+// importTriggerChanged reports whether any attribute that drives the import itself has changed.
+// Changes to the status polling settings alone do not re-import the package.
+func importTriggerChanged(plan, state *ImportTransportPackageResourceModel) bool {
+	return !plan.PackagePath.Equal(state.PackagePath) ||
+		!plan.UpdateUser.Equal(state.UpdateUser) ||
+		!plan.BusinessJustification.Equal(state.BusinessJustification) ||
+		!plan.ImportPackageVersion.Equal(state.ImportPackageVersion)
+}
+
 // SetClient sets the client for testing purposes
 func (r *ImportTransportPackageResource) SetClient(client client.SaviyntClientInterface) {
 	r.client = client
@@ -228,6 +281,18 @@ func (r *ImportTransportPackageResource) Create(ctx context.Context, req resourc
 	// Update model from response
 	r.UpdateModelFromResponse(&plan, apiResp)
 
+	// Wait for the import to reach a terminal status
+	if err := r.WaitForImportCompletion(ctx, &plan); err != nil {
+		tflog.Error(ctx, "Import transport package did not complete successfully", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Import Transport Package Failed",
+			err.Error(),
+		)
+		return
+	}
+
 	// Add success warning if operation completed successfully
 	r.AddSuccessWarning(resp, apiResp)
 
@@ -340,6 +405,24 @@ func (r *ImportTransportPackageResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	var state ImportTransportPackageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the polling settings changed, keep the result of the previous import
+	if !importTriggerChanged(&plan, &state) {
+		plan.ID = state.ID
+		plan.RequestID = state.RequestID
+		plan.Message = state.Message
+		plan.ErrorCode = state.ErrorCode
+		plan.Status = state.Status
+		plan.StatusMsgDescription = state.StatusMsgDescription
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Call the business logic method
 	apiResp, err := r.ImportTransportPackage(ctx, &plan, "update")
 	if err != nil {
@@ -356,6 +439,18 @@ func (r *ImportTransportPackageResource) Update(ctx context.Context, req resourc
 	// Update model from response
 	r.UpdateModelFromResponse(&plan, apiResp)
 
+	// Wait for the import to reach a terminal status
+	if err := r.WaitForImportCompletion(ctx, &plan); err != nil {
+		tflog.Error(ctx, "Import transport package did not complete successfully", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Import Transport Package Failed",
+			err.Error(),
+		)
+		return
+	}
+
 	// Add success warning if operation completed successfully
 	r.AddSuccessWarning(resp, apiResp)
 
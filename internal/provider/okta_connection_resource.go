@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -39,23 +41,23 @@ var oktaErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeO
 
 type OktaConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                            types.String `tfsdk:"id"`
-	ImportUrl                     types.String `tfsdk:"import_url"`
-	AuthToken                     types.String `tfsdk:"auth_token"`
-	AuthTokenWO                   types.String `tfsdk:"auth_token_wo"`
-	AccountFieldMappings          types.String `tfsdk:"account_field_mappings"`
-	UserFieldMappings             types.String `tfsdk:"user_field_mappings"`
-	EntitlementTypesMappings      types.String `tfsdk:"entitlement_types_mappings"`
-	ImportInactiveApps            types.String `tfsdk:"import_inactive_apps"`
-	OktaApplicationSecuritySystem types.String `tfsdk:"okta_application_securitysystem"`
-	OktaGroupsFilter              types.String `tfsdk:"okta_groups_filter"`
-	AppAccountFieldMappings       types.String `tfsdk:"app_account_field_mappings"`
-	StatusThresholdConfig         types.String `tfsdk:"status_threshold_config"`
-	AuditFilter                   types.String `tfsdk:"audit_filter"`
-	ModifyUserDataJson            types.String `tfsdk:"modify_user_data_json"`
-	ActivateEndpoint              types.String `tfsdk:"activate_endpoint"`
-	ConfigJson                    types.String `tfsdk:"config_json"`
-	PamConfig                     types.String `tfsdk:"pam_config"`
+	ID                            types.String           `tfsdk:"id"`
+	ImportUrl                     types.String           `tfsdk:"import_url"`
+	AuthToken                     types.String           `tfsdk:"auth_token"`
+	AuthTokenWO                   types.String           `tfsdk:"auth_token_wo"`
+	AccountFieldMappings          customtypes.JSONString `tfsdk:"account_field_mappings"`
+	UserFieldMappings             customtypes.JSONString `tfsdk:"user_field_mappings"`
+	EntitlementTypesMappings      types.String           `tfsdk:"entitlement_types_mappings"`
+	ImportInactiveApps            types.String           `tfsdk:"import_inactive_apps"`
+	OktaApplicationSecuritySystem types.String           `tfsdk:"okta_application_securitysystem"`
+	OktaGroupsFilter              types.String           `tfsdk:"okta_groups_filter"`
+	AppAccountFieldMappings       customtypes.JSONString `tfsdk:"app_account_field_mappings"`
+	StatusThresholdConfig         customtypes.JSONString `tfsdk:"status_threshold_config"`
+	AuditFilter                   types.String           `tfsdk:"audit_filter"`
+	ModifyUserDataJson            customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	ActivateEndpoint              types.String           `tfsdk:"activate_endpoint"`
+	ConfigJson                    customtypes.JSONString `tfsdk:"config_json"`
+	PamConfig                     customtypes.JSONString `tfsdk:"pam_config"`
 }
 
 type OktaConnectionResource struct {
@@ -108,11 +110,13 @@ func OktaConnectorSchema() map[string]schema.Attribute {
 			},
 		},
 		"account_field_mappings": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Maps Okta user fields to Saviynt account fields.",
 		},
 		"user_field_mappings": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: " Maps Okta user fields to Saviynt user fields.",
@@ -137,14 +141,19 @@ func OktaConnectorSchema() map[string]schema.Attribute {
 			Description: "Filter criteria for selective group import from Okta.",
 		},
 		"app_account_field_mappings": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Maps Okta application user fields to Saviynt account field.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON config for status mapping, thresholds, and bulk operation safety controls.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"audit_filter": schema.StringAttribute{
 			Optional:    true,
@@ -152,6 +161,7 @@ func OktaConnectorSchema() map[string]schema.Attribute {
 			Description: "Filter for importing specific audit events.",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: " JSON configuration for user data modification operations during provisioning.",
@@ -162,11 +172,17 @@ func OktaConnectorSchema() map[string]schema.Attribute {
 			Description: "Auto-enables disabled endpoints based on application status.",
 		},
 		"config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "General connector configuration including timeouts, retries, and connector-specific settings.",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Privileged Access Management configuration for PAM operations and bootstrap processes.",
@@ -255,18 +271,18 @@ func (r *OktaConnectionResource) BuildOktaConnector(plan *OktaConnectorResourceM
 		AUTHTOKEN:                       authToken,
 		OKTA_APPLICATION_SECURITYSYSTEM: plan.OktaApplicationSecuritySystem.ValueString(),
 		//optional field
-		ACCOUNTFIELDMAPPINGS:     util.StringPointerOrEmpty(plan.AccountFieldMappings),
-		USERFIELDMAPPINGS:        util.StringPointerOrEmpty(plan.UserFieldMappings),
+		ACCOUNTFIELDMAPPINGS:     util.StringPointerOrEmpty(plan.AccountFieldMappings.StringValue),
+		USERFIELDMAPPINGS:        util.StringPointerOrEmpty(plan.UserFieldMappings.StringValue),
 		ENTITLEMENTTYPESMAPPINGS: util.StringPointerOrEmpty(plan.EntitlementTypesMappings),
 		IMPORT_INACTIVE_APPS:     util.StringPointerOrEmpty(plan.ImportInactiveApps),
 		OKTA_GROUPS_FILTER:       util.StringPointerOrEmpty(plan.OktaGroupsFilter),
-		APPACCOUNTFIELDMAPPINGS:  util.StringPointerOrEmpty(plan.AppAccountFieldMappings),
-		STATUS_THRESHOLD_CONFIG:  util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		APPACCOUNTFIELDMAPPINGS:  util.StringPointerOrEmpty(plan.AppAccountFieldMappings.StringValue),
+		STATUS_THRESHOLD_CONFIG:  util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		AUDIT_FILTER:             util.StringPointerOrEmpty(plan.AuditFilter),
-		MODIFYUSERDATAJSON:       util.StringPointerOrEmpty(plan.ModifyUserDataJson),
+		MODIFYUSERDATAJSON:       util.StringPointerOrEmpty(plan.ModifyUserDataJson.StringValue),
 		ACTIVATE_ENDPOINT:        util.StringPointerOrEmpty(plan.ActivateEndpoint),
-		ConfigJSON:               util.StringPointerOrEmpty(plan.ConfigJson),
-		PAM_CONFIG:               util.StringPointerOrEmpty(plan.PamConfig),
+		ConfigJSON:               util.StringPointerOrEmpty(plan.ConfigJson.StringValue),
+		PAM_CONFIG:               util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -286,18 +302,18 @@ func (r *OktaConnectionResource) UpdateModelFromCreateResponse(plan *OktaConnect
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
 	plan.ImportUrl = util.SafeStringDatasource(plan.ImportUrl.ValueStringPointer())
 	plan.OktaApplicationSecuritySystem = util.SafeStringDatasource(plan.OktaApplicationSecuritySystem.ValueStringPointer())
-	plan.AccountFieldMappings = util.SafeStringDatasource(plan.AccountFieldMappings.ValueStringPointer())
-	plan.UserFieldMappings = util.SafeStringDatasource(plan.UserFieldMappings.ValueStringPointer())
+	plan.AccountFieldMappings = customtypes.NewJSONStringPointerValue(plan.AccountFieldMappings.ValueStringPointer())
+	plan.UserFieldMappings = customtypes.NewJSONStringPointerValue(plan.UserFieldMappings.ValueStringPointer())
 	plan.EntitlementTypesMappings = util.SafeStringDatasource(plan.EntitlementTypesMappings.ValueStringPointer())
 	plan.ImportInactiveApps = util.SafeStringDatasource(plan.ImportInactiveApps.ValueStringPointer())
 	plan.OktaGroupsFilter = util.SafeStringDatasource(plan.OktaGroupsFilter.ValueStringPointer())
-	plan.AppAccountFieldMappings = util.SafeStringDatasource(plan.AppAccountFieldMappings.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.AppAccountFieldMappings = customtypes.NewJSONStringPointerValue(plan.AppAccountFieldMappings.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.AuditFilter = util.SafeStringDatasource(plan.AuditFilter.ValueStringPointer())
-	plan.ModifyUserDataJson = util.SafeStringDatasource(plan.ModifyUserDataJson.ValueStringPointer())
+	plan.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserDataJson.ValueStringPointer())
 	plan.ActivateEndpoint = util.SafeStringDatasource(plan.ActivateEndpoint.ValueStringPointer())
-	plan.ConfigJson = util.SafeStringDatasource(plan.ConfigJson.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.ConfigJson = customtypes.NewJSONStringPointerValue(plan.ConfigJson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
 	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
 }
@@ -362,7 +378,7 @@ func (r *OktaConnectionResource) CreateOktaConnection(ctx context.Context, plan
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_okta_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -490,7 +506,7 @@ func (r *OktaConnectionResource) UpdateOktaConnection(ctx context.Context, plan
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_okta_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -535,18 +551,19 @@ func (r *OktaConnectionResource) UpdateModelFromReadResponse(state *OktaConnecto
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Emailtemplate)
 	state.ImportUrl = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.IMPORTURL)
 	state.OktaApplicationSecuritySystem = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.OKTA_APPLICATION_SECURITYSYSTEM)
-	state.AccountFieldMappings = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.ACCOUNTFIELDMAPPINGS)
-	state.UserFieldMappings = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.USERFIELDMAPPINGS)
+	state.AccountFieldMappings = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.ACCOUNTFIELDMAPPINGS)
+	state.UserFieldMappings = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.USERFIELDMAPPINGS)
 	state.EntitlementTypesMappings = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.ENTITLEMENTTYPESMAPPINGS)
 	state.ImportInactiveApps = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.IMPORT_INACTIVE_APPS)
 	state.OktaGroupsFilter = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.OKTA_GROUPS_FILTER)
-	state.AppAccountFieldMappings = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.APPACCOUNTFIELDMAPPINGS)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.AppAccountFieldMappings = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.APPACCOUNTFIELDMAPPINGS)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
 	state.AuditFilter = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.AUDIT_FILTER)
-	state.ModifyUserDataJson = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
 	state.ActivateEndpoint = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.ACTIVATE_ENDPOINT)
-	state.ConfigJson = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.ConfigJSON)
-	state.PamConfig = util.SafeStringDatasource(apiResp.OktaConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.ConfigJson = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.ConfigJSON)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.OktaConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.OktaConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *OktaConnectionResource) ValidateOktaConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -595,8 +612,14 @@ func (r *OktaConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateOktaConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateOktaConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Okta connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -651,7 +674,9 @@ func (r *OktaConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.OktaConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -745,8 +770,14 @@ func (r *OktaConnectionResource) Update(ctx context.Context, req resource.Update
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateOktaConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateOktaConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Okta connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -768,7 +799,9 @@ func (r *OktaConnectionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// DefaultFetchAllPageSize is the number of records requested per page by fetch_all when max is not set
+	DefaultFetchAllPageSize = 100
+	// FetchAllParallelism bounds the number of page requests in flight
+	FetchAllParallelism = 4
+)
+
+// FetchAllDescription describes the fetch_all attribute of a list data source whose total and
+// returned counts are held in totalAttribute and countAttribute.
+func FetchAllDescription(records, totalAttribute, countAttribute string) string {
+	return fmt.Sprintf("Read every page of %s and return them as one list. 'max' sets the page size (default %d). The read "+
+		"fails when a page repeats a record of an earlier page or is empty before the total is reached, since Saviynt then did "+
+		"not page the results. '%s' holds the total reported by Saviynt and '%s' the number of records returned.",
+		records, DefaultFetchAllPageSize, totalAttribute, countAttribute)
+}
+
+// Page is one page of records returned by a list API.
+type Page[T any] struct {
+	Items []T
+	// Total is the total number of records reported by the API, or -1 when it is not reported
+	Total int
+}
+
+// PageFetcher reads the page of max records starting at offset.
+type PageFetcher[T any] func(ctx context.Context, offset, max int) (Page[T], error)
+
+// FetchAllPages reads every page of a list API and returns the records in page order. The first
+// page is read alone to learn the total count and the page size the server applies; the remaining
+// pages are then read concurrently with at most parallelism requests in flight. When the API
+// reports no total, pages are read one after another until a short page. A page that repeats a
+// record of an earlier page, or an empty page before the reported total, is an error, because the
+// API did not advance, for example since it ignored the offset. The total reported by the API is
+// returned, or the number of records when it reports none.
+func FetchAllPages[T any](ctx context.Context, pageSize, parallelism int, fetch PageFetcher[T], key func(T) string) ([]T, int, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultFetchAllPageSize
+	}
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	first, err := fetch(ctx, 0, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	// The server can cap the page size below the one requested
+	step := len(first.Items)
+	if step == 0 {
+		return nil, max(first.Total, 0), nil
+	}
+	if step > pageSize {
+		step = pageSize
+	}
+
+	pages := [][]T{first.Items}
+	seen := make(map[string]int)
+	if err := checkPageAdvances(seen, first.Items, 0, step, key); err != nil {
+		return nil, 0, err
+	}
+	if first.Total < 0 {
+		for offset := step; len(pages[len(pages)-1]) >= step; offset += step {
+			page, err := fetch(ctx, offset, step)
+			if err != nil {
+				return nil, 0, err
+			}
+			if len(page.Items) == 0 {
+				break
+			}
+			// A server that ignores the offset returns the same full page forever
+			if err := checkPageAdvances(seen, page.Items, len(pages), step, key); err != nil {
+				return nil, 0, err
+			}
+			pages = append(pages, page.Items)
+		}
+	} else if first.Total > step {
+		rest, err := fetchPagesConcurrently(ctx, step, first.Total, parallelism, fetch)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i, page := range rest {
+			if len(page) == 0 {
+				return nil, 0, fmt.Errorf("the page at offset %d is empty, but %d records were reported", (i+1)*step, first.Total)
+			}
+			if err := checkPageAdvances(seen, page, i+1, step, key); err != nil {
+				return nil, 0, err
+			}
+		}
+		pages = append(pages, rest...)
+	}
+
+	var items []T
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+
+	total := first.Total
+	if total < 0 {
+		total = len(items)
+	}
+	return items, total, nil
+}
+
+// checkPageAdvances records the keys of the page with the given index in seen and returns an error
+// when an earlier page already held one of them. Records without a key are not checked.
+func checkPageAdvances[T any](seen map[string]int, page []T, index, step int, key func(T) string) error {
+	for _, item := range page {
+		k := key(item)
+		if k == "" {
+			continue
+		}
+		if previous, ok := seen[k]; ok && previous != index {
+			return fmt.Errorf("the page at offset %d repeats the record %q of the page at offset %d, so the API did not advance "+
+				"to the next page", index*step, k, previous*step)
+		}
+		seen[k] = index
+	}
+	return nil
+}
+
+// fetchPagesConcurrently reads the pages after the first one up to total and returns them in
+// offset order. The first error cancels the requests that are still in flight.
+func fetchPagesConcurrently[T any](ctx context.Context, step, total, parallelism int, fetch PageFetcher[T]) ([][]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	count := (total - 1) / step
+	pages := make([][]T, count)
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for i := 0; i < count; i++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			page, err := fetch(ctx, (i+1)*step, step)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			pages[i] = page.Items
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// fetchAllEnabled reports whether fetch_all is set to true.
+func fetchAllEnabled(fetchAll types.Bool) bool {
+	return !fetchAll.IsNull() && !fetchAll.IsUnknown() && fetchAll.ValueBool()
+}
+
+// fetchAllPageSize returns the page size set by a max attribute that holds a number as a string.
+func fetchAllPageSize(max types.String) (int, error) {
+	value := strings.TrimSpace(max.ValueString())
+	if max.IsNull() || value == "" {
+		return DefaultFetchAllPageSize, nil
+	}
+	size, err := strconv.Atoi(value)
+	if err != nil || size <= 0 {
+		return 0, fmt.Errorf("max must be a positive number when fetch_all is true, got %q", value)
+	}
+	return size, nil
+}
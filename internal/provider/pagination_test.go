@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeList serves pages of records named "r0" to "r<n-1>" the way a list API with max and offset
+// does. cap limits the page size the server applies and reportTotal makes it return the total.
+type fakeList struct {
+	records     int
+	cap         int
+	reportTotal bool
+	ignoreSkip  bool
+	failAt      int
+
+	mu      sync.Mutex
+	offsets []int
+}
+
+func (f *fakeList) fetch(ctx context.Context, offset, max int) (Page[string], error) {
+	f.mu.Lock()
+	f.offsets = append(f.offsets, offset)
+	f.mu.Unlock()
+
+	if f.failAt > 0 && offset == f.failAt {
+		return Page[string]{}, errors.New("server error")
+	}
+	if f.ignoreSkip {
+		offset = 0
+	}
+	if f.cap > 0 && max > f.cap {
+		max = f.cap
+	}
+	var items []string
+	for i := offset; i < f.records && i < offset+max; i++ {
+		items = append(items, "r"+strconv.Itoa(i))
+	}
+	total := -1
+	if f.reportTotal {
+		total = f.records
+	}
+	return Page[string]{Items: items, Total: total}, nil
+}
+
+func records(n int) []string {
+	var items []string
+	for i := 0; i < n; i++ {
+		items = append(items, "r"+strconv.Itoa(i))
+	}
+	return items
+}
+
+func TestFetchAllPages(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      *fakeList
+		pageSize  int
+		want      []string
+		wantTotal int
+		wantErr   string
+	}{
+		{"empty", &fakeList{reportTotal: true}, 10, nil, 0, ""},
+		{"single page", &fakeList{records: 7, reportTotal: true}, 10, records(7), 7, ""},
+		{"exact pages with total", &fakeList{records: 30, reportTotal: true}, 10, records(30), 30, ""},
+		{"short last page with total", &fakeList{records: 25, reportTotal: true}, 10, records(25), 25, ""},
+		{"server caps the page size", &fakeList{records: 25, cap: 4, reportTotal: true}, 10, records(25), 25, ""},
+		{"no total", &fakeList{records: 25}, 10, records(25), 25, ""},
+		{"no total with exact pages", &fakeList{records: 20}, 10, records(20), 20, ""},
+		{"default page size", &fakeList{records: 150, reportTotal: true}, 0, records(150), 150, ""},
+		{"offset ignored with total", &fakeList{records: 25, reportTotal: true, ignoreSkip: true}, 10, nil, 0, "did not advance"},
+		{"offset ignored without total", &fakeList{records: 25, ignoreSkip: true}, 10, nil, 0, "did not advance"},
+		{"failed page", &fakeList{records: 25, reportTotal: true, failAt: 10}, 10, nil, 0, "server error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, total, err := FetchAllPages(context.Background(), tt.pageSize, 3, tt.list.fetch, func(s string) string { return s })
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(items, tt.want) {
+				t.Errorf("items = %v, want %v", items, tt.want)
+			}
+			if total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestFetchAllPagesEmptyPageBeforeTotal(t *testing.T) {
+	fetch := func(ctx context.Context, offset, max int) (Page[string], error) {
+		if offset > 0 {
+			return Page[string]{Total: 30}, nil
+		}
+		return Page[string]{Items: records(10), Total: 30}, nil
+	}
+	_, _, err := FetchAllPages(context.Background(), 10, 2, fetch, func(s string) string { return s })
+	if err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Fatalf("error = %v, want an empty page error", err)
+	}
+}
+
+func TestFetchAllPagesOffsets(t *testing.T) {
+	list := &fakeList{records: 25, cap: 5}
+	if _, _, err := FetchAllPages(context.Background(), 10, 1, list.fetch, func(s string) string { return s }); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The capped size of the first page is used as the step of the following pages
+	want := []int{0, 5, 10, 15, 20, 25}
+	if !reflect.DeepEqual(list.offsets, want) {
+		t.Errorf("offsets = %v, want %v", list.offsets, want)
+	}
+}
@@ -263,6 +263,11 @@ func (p *SaviyntProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewPrivilegeDataSource,
 		NewWorkdaySOAPConnectionsDataSource,
 		NewSFTPConnectionsDataSource,
+		NewTransportPackageDataSource,
+		NewFirefighterRolesDataSource,
+		NewRoleHistoryDataSource,
+		NewConnectionTestDataSource,
+		NewConnectionTemplateDataSource,
 	}
 }
 
@@ -283,10 +288,16 @@ func (p *SaviyntProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewGithubRestConnectionResource,
 		NewEndpointResource,
 		NewRolesResource,
+		NewRoleMembershipResource,
+		NewRoleMembersResource,
+		NewRoleEntitlementResource,
 		NewDynamicAttributeResource,
 		NewOktaConnectionResource,
+		NewConnectionResource,
 		NewEntitlementTypeResource,
 		NewEntitlementResource,
+		NewEntitlementMapResource,
+		NewEntitlementsBulkResource,
 		NewPrivilegeResource,
 		NewApplicationDataImportJobResource,
 		NewAccountsImportFullJobResource,
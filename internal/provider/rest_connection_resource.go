@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
 	"terraform-provider-Saviynt/util/errorsutil"
@@ -33,37 +35,37 @@ var restErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeR
 
 type RestConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                    types.String `tfsdk:"id"`
-	ConnectionJSON        types.String `tfsdk:"connection_json"`
-	ConnectionJSONWO      types.String `tfsdk:"connection_json_wo"`
-	ImportUserJson        types.String `tfsdk:"import_user_json"`
-	ImportAccountEntJson  types.String `tfsdk:"import_account_ent_json"`
-	StatusThresholdConfig types.String `tfsdk:"status_threshold_config"`
-	CreateAccountJson     types.String `tfsdk:"create_account_json"`
-	UpdateAccountJson     types.String `tfsdk:"update_account_json"`
-	EnableAccountJson     types.String `tfsdk:"enable_account_json"`
-	DisableAccountJson    types.String `tfsdk:"disable_account_json"`
-	AddAccessJson         types.String `tfsdk:"add_access_json"`
-	RemoveAccessJson      types.String `tfsdk:"remove_access_json"`
-	UpdateUserJson        types.String `tfsdk:"update_user_json"`
-	ChangePassJson        types.String `tfsdk:"change_pass_json"`
-	RemoveAccountJson     types.String `tfsdk:"remove_account_json"`
-	TicketStatusJson      types.String `tfsdk:"ticket_status_json"`
-	CreateTicketJson      types.String `tfsdk:"create_ticket_json"`
-	EndpointsFilter       types.String `tfsdk:"endpoints_filter"`
-	PasswdPolicyJson      types.String `tfsdk:"passwd_policy_json"`
-	ConfigJSON            types.String `tfsdk:"config_json"`
-	AddFFIDAccessJson     types.String `tfsdk:"add_ffid_access_json"`
-	RemoveFFIDAccessJson  types.String `tfsdk:"remove_ffid_access_json"`
-	ModifyUserdataJson    types.String `tfsdk:"modify_user_data_json"`
-	SendOtpJson           types.String `tfsdk:"send_otp_json"`
-	ValidateOtpJson       types.String `tfsdk:"validate_otp_json"`
-	PamConfig             types.String `tfsdk:"pam_config"`
+	ID                    types.String           `tfsdk:"id"`
+	ConnectionJSON        customtypes.JSONString `tfsdk:"connection_json"`
+	ConnectionJSONWO      types.String           `tfsdk:"connection_json_wo"`
+	ImportUserJson        customtypes.JSONString `tfsdk:"import_user_json"`
+	ImportAccountEntJson  customtypes.JSONString `tfsdk:"import_account_ent_json"`
+	StatusThresholdConfig customtypes.JSONString `tfsdk:"status_threshold_config"`
+	CreateAccountJson     customtypes.JSONString `tfsdk:"create_account_json"`
+	UpdateAccountJson     customtypes.JSONString `tfsdk:"update_account_json"`
+	EnableAccountJson     customtypes.JSONString `tfsdk:"enable_account_json"`
+	DisableAccountJson    customtypes.JSONString `tfsdk:"disable_account_json"`
+	AddAccessJson         customtypes.JSONString `tfsdk:"add_access_json"`
+	RemoveAccessJson      customtypes.JSONString `tfsdk:"remove_access_json"`
+	UpdateUserJson        customtypes.JSONString `tfsdk:"update_user_json"`
+	ChangePassJson        customtypes.JSONString `tfsdk:"change_pass_json"`
+	RemoveAccountJson     customtypes.JSONString `tfsdk:"remove_account_json"`
+	TicketStatusJson      customtypes.JSONString `tfsdk:"ticket_status_json"`
+	CreateTicketJson      customtypes.JSONString `tfsdk:"create_ticket_json"`
+	EndpointsFilter       customtypes.JSONString `tfsdk:"endpoints_filter"`
+	PasswdPolicyJson      customtypes.JSONString `tfsdk:"passwd_policy_json"`
+	ConfigJSON            customtypes.JSONString `tfsdk:"config_json"`
+	AddFFIDAccessJson     customtypes.JSONString `tfsdk:"add_ffid_access_json"`
+	RemoveFFIDAccessJson  customtypes.JSONString `tfsdk:"remove_ffid_access_json"`
+	ModifyUserdataJson    customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	SendOtpJson           customtypes.JSONString `tfsdk:"send_otp_json"`
+	ValidateOtpJson       customtypes.JSONString `tfsdk:"validate_otp_json"`
+	PamConfig             customtypes.JSONString `tfsdk:"pam_config"`
 	// TER-176
-	ApplicationDiscoveryJson types.String `tfsdk:"application_discovery_json"`
-	CreateEntitlementJson    types.String `tfsdk:"create_entitlement_json"`
-	DeleteEntitlementJson    types.String `tfsdk:"delete_entitlement_json"`
-	UpdateEntitlementJson    types.String `tfsdk:"update_entitlement_json"`
+	ApplicationDiscoveryJson customtypes.JSONString `tfsdk:"application_discovery_json"`
+	CreateEntitlementJson    customtypes.JSONString `tfsdk:"create_entitlement_json"`
+	DeleteEntitlementJson    customtypes.JSONString `tfsdk:"delete_entitlement_json"`
+	UpdateEntitlementJson    customtypes.JSONString `tfsdk:"update_entitlement_json"`
 
 	//25.B.1
 	AppType types.String `tfsdk:"app_type"`
@@ -100,11 +102,13 @@ func RestConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Resource ID.",
 		},
 		"connection_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Dynamic JSON configuration for the connection. Must be a valid JSON object string. Either the connection_json field or the connection_json_wo field must be populated to set the connection_json attribute.",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json_wo")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"connection_json_wo": schema.StringAttribute{
@@ -113,140 +117,214 @@ func RestConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Dynamic JSON configuration for the connection (write-only). Must be a valid JSON object string. Either the connection_json field or the connection_json_wo field must be populated to set the connection_json attribute.",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"import_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for importing users.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_import_user_json"),
+			},
 		},
 		"import_account_ent_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for importing accounts and entitlements.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_import_account_ent_json"),
+			},
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for status thresholds.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create an account.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update an account.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration to enable an account.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"disable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration to disable an account.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"add_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to add access.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"remove_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to remove access.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"update_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to update a user.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"change_pass_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to change a user's password.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"remove_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to remove an account.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"ticket_status_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to check ticket status.",
 		},
 		"create_ticket_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to create a ticket.",
 		},
 		"endpoints_filter": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Filter criteria for endpoints.",
 		},
 		"passwd_policy_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON defining the password policy.",
 		},
 		"config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "General configuration JSON for the REST connector.",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"add_ffid_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to add FFID access.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"remove_ffid_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to remove FFID access.",
+			Validators: []validator.String{
+				validators.JSONSchema("rest_operation_json"),
+			},
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON for modifying user data.",
 		},
 		"send_otp_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to send OTP.",
 		},
 		"validate_otp_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON to validate OTP.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "PAM configuration JSON.",
 		},
 		//TER-176
 		"application_discovery_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "The ApplicationDiscoveryJSON attribute is specifically implemented for ServiceNow application discovery, allowing automated discovery and import of applications from ServiceNow instances.",
 		},
 		"create_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "The three entitlement JSON attributes (Create, Update, Delete) are part of a comprehensive entitlement management system for REST connectors, with supporting constants and service classes.",
 		},
 		"delete_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "The three entitlement JSON attributes (Create, Update, Delete) are part of a comprehensive entitlement management system for REST connectors, with supporting constants and service classes.",
 		},
 		"update_entitlement_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "The three entitlement JSON attributes (Create, Update, Delete) are part of a comprehensive entitlement management system for REST connectors, with supporting constants and service classes.",
@@ -375,7 +453,7 @@ func (r *RestConnectionResource) CreateRESTConnection(ctx context.Context, plan
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_rest_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, restConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, restConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -431,34 +509,34 @@ func (r *RestConnectionResource) BuildRESTConnector(plan *RestConnectorResourceM
 		},
 		//optional fields
 		ConnectionJSON:          util.StringPointerOrEmpty(types.StringValue(connectionJson)),
-		ImportUserJSON:          util.StringPointerOrEmpty(plan.ImportUserJson),
-		ImportAccountEntJSON:    util.StringPointerOrEmpty(plan.ImportAccountEntJson),
-		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.StatusThresholdConfig),
-		CreateAccountJSON:       util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UpdateAccountJSON:       util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		EnableAccountJSON:       util.StringPointerOrEmpty(plan.EnableAccountJson),
-		DisableAccountJSON:      util.StringPointerOrEmpty(plan.DisableAccountJson),
-		AddAccessJSON:           util.StringPointerOrEmpty(plan.AddAccessJson),
-		RemoveAccessJSON:        util.StringPointerOrEmpty(plan.RemoveAccessJson),
-		UpdateUserJSON:          util.StringPointerOrEmpty(plan.UpdateUserJson),
-		ChangePassJSON:          util.StringPointerOrEmpty(plan.ChangePassJson),
-		RemoveAccountJSON:       util.StringPointerOrEmpty(plan.RemoveAccountJson),
-		TicketStatusJSON:        util.StringPointerOrEmpty(plan.TicketStatusJson),
-		CreateTicketJSON:        util.StringPointerOrEmpty(plan.CreateTicketJson),
-		ENDPOINTS_FILTER:        util.StringPointerOrEmpty(plan.EndpointsFilter),
-		PasswdPolicyJSON:        util.StringPointerOrEmpty(plan.PasswdPolicyJson),
-		ConfigJSON:              util.StringPointerOrEmpty(plan.ConfigJSON),
-		AddFFIDAccessJSON:       util.StringPointerOrEmpty(plan.AddFFIDAccessJson),
-		RemoveFFIDAccessJSON:    util.StringPointerOrEmpty(plan.RemoveFFIDAccessJson),
-		MODIFYUSERDATAJSON:      util.StringPointerOrEmpty(plan.ModifyUserdataJson),
-		SendOtpJSON:             util.StringPointerOrEmpty(plan.SendOtpJson),
-		ValidateOtpJSON:         util.StringPointerOrEmpty(plan.ValidateOtpJson),
-		PAM_CONFIG:              util.StringPointerOrEmpty(plan.PamConfig),
+		ImportUserJSON:          util.StringPointerOrEmpty(plan.ImportUserJson.StringValue),
+		ImportAccountEntJSON:    util.StringPointerOrEmpty(plan.ImportAccountEntJson.StringValue),
+		STATUS_THRESHOLD_CONFIG: util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
+		CreateAccountJSON:       util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UpdateAccountJSON:       util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		EnableAccountJSON:       util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
+		DisableAccountJSON:      util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
+		AddAccessJSON:           util.StringPointerOrEmpty(plan.AddAccessJson.StringValue),
+		RemoveAccessJSON:        util.StringPointerOrEmpty(plan.RemoveAccessJson.StringValue),
+		UpdateUserJSON:          util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
+		ChangePassJSON:          util.StringPointerOrEmpty(plan.ChangePassJson.StringValue),
+		RemoveAccountJSON:       util.StringPointerOrEmpty(plan.RemoveAccountJson.StringValue),
+		TicketStatusJSON:        util.StringPointerOrEmpty(plan.TicketStatusJson.StringValue),
+		CreateTicketJSON:        util.StringPointerOrEmpty(plan.CreateTicketJson.StringValue),
+		ENDPOINTS_FILTER:        util.StringPointerOrEmpty(plan.EndpointsFilter.StringValue),
+		PasswdPolicyJSON:        util.StringPointerOrEmpty(plan.PasswdPolicyJson.StringValue),
+		ConfigJSON:              util.StringPointerOrEmpty(plan.ConfigJSON.StringValue),
+		AddFFIDAccessJSON:       util.StringPointerOrEmpty(plan.AddFFIDAccessJson.StringValue),
+		RemoveFFIDAccessJSON:    util.StringPointerOrEmpty(plan.RemoveFFIDAccessJson.StringValue),
+		MODIFYUSERDATAJSON:      util.StringPointerOrEmpty(plan.ModifyUserdataJson.StringValue),
+		SendOtpJSON:             util.StringPointerOrEmpty(plan.SendOtpJson.StringValue),
+		ValidateOtpJSON:         util.StringPointerOrEmpty(plan.ValidateOtpJson.StringValue),
+		PAM_CONFIG:              util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 		//TER-176
-		ApplicationDiscoveryJSON: util.StringPointerOrEmpty(plan.ApplicationDiscoveryJson),
-		CreateEntitlementJSON:    util.StringPointerOrEmpty(plan.CreateEntitlementJson),
-		DeleteEntitlementJSON:    util.StringPointerOrEmpty(plan.DeleteEntitlementJson),
-		UpdateEntitlementJSON:    util.StringPointerOrEmpty(plan.UpdateEntitlementJson),
+		ApplicationDiscoveryJSON: util.StringPointerOrEmpty(plan.ApplicationDiscoveryJson.StringValue),
+		CreateEntitlementJSON:    util.StringPointerOrEmpty(plan.CreateEntitlementJson.StringValue),
+		DeleteEntitlementJSON:    util.StringPointerOrEmpty(plan.DeleteEntitlementJson.StringValue),
+		UpdateEntitlementJSON:    util.StringPointerOrEmpty(plan.UpdateEntitlementJson.StringValue),
 
 		AppType: util.StringPointerOrEmpty(plan.AppType),
 	}
@@ -480,34 +558,34 @@ func (r *RestConnectionResource) UpdateModelFromCreateResponse(plan *RestConnect
 	plan.Description = util.SafeStringDatasource(plan.Description.ValueStringPointer())
 	plan.DefaultSavRoles = util.SortedCommaSeparated(util.SafeStringDatasource(plan.DefaultSavRoles.ValueStringPointer()))
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
-	plan.ImportUserJson = util.SafeStringDatasource(plan.ImportUserJson.ValueStringPointer())
-	plan.ImportAccountEntJson = util.SafeStringDatasource(plan.ImportAccountEntJson.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.AddAccessJson = util.SafeStringDatasource(plan.AddAccessJson.ValueStringPointer())
-	plan.RemoveAccessJson = util.SafeStringDatasource(plan.RemoveAccessJson.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
-	plan.ChangePassJson = util.SafeStringDatasource(plan.ChangePassJson.ValueStringPointer())
-	plan.RemoveAccountJson = util.SafeStringDatasource(plan.RemoveAccountJson.ValueStringPointer())
-	plan.TicketStatusJson = util.SafeStringDatasource(plan.TicketStatusJson.ValueStringPointer())
-	plan.CreateTicketJson = util.SafeStringDatasource(plan.CreateTicketJson.ValueStringPointer())
-	plan.EndpointsFilter = util.SafeStringDatasource(plan.EndpointsFilter.ValueStringPointer())
-	plan.PasswdPolicyJson = util.SafeStringDatasource(plan.PasswdPolicyJson.ValueStringPointer())
-	plan.ConfigJSON = util.SafeStringDatasource(plan.ConfigJSON.ValueStringPointer())
-	plan.AddFFIDAccessJson = util.SafeStringDatasource(plan.AddFFIDAccessJson.ValueStringPointer())
-	plan.RemoveFFIDAccessJson = util.SafeStringDatasource(plan.RemoveFFIDAccessJson.ValueStringPointer())
-	plan.ModifyUserdataJson = util.SafeStringDatasource(plan.ModifyUserdataJson.ValueStringPointer())
-	plan.SendOtpJson = util.SafeStringDatasource(plan.SendOtpJson.ValueStringPointer())
-	plan.ValidateOtpJson = util.SafeStringDatasource(plan.ValidateOtpJson.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.ImportUserJson = customtypes.NewJSONStringPointerValue(plan.ImportUserJson.ValueStringPointer())
+	plan.ImportAccountEntJson = customtypes.NewJSONStringPointerValue(plan.ImportAccountEntJson.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.AddAccessJson = customtypes.NewJSONStringPointerValue(plan.AddAccessJson.ValueStringPointer())
+	plan.RemoveAccessJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccessJson.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
+	plan.ChangePassJson = customtypes.NewJSONStringPointerValue(plan.ChangePassJson.ValueStringPointer())
+	plan.RemoveAccountJson = customtypes.NewJSONStringPointerValue(plan.RemoveAccountJson.ValueStringPointer())
+	plan.TicketStatusJson = customtypes.NewJSONStringPointerValue(plan.TicketStatusJson.ValueStringPointer())
+	plan.CreateTicketJson = customtypes.NewJSONStringPointerValue(plan.CreateTicketJson.ValueStringPointer())
+	plan.EndpointsFilter = customtypes.NewJSONStringPointerValue(plan.EndpointsFilter.ValueStringPointer())
+	plan.PasswdPolicyJson = customtypes.NewJSONStringPointerValue(plan.PasswdPolicyJson.ValueStringPointer())
+	plan.ConfigJSON = customtypes.NewJSONStringPointerValue(plan.ConfigJSON.ValueStringPointer())
+	plan.AddFFIDAccessJson = customtypes.NewJSONStringPointerValue(plan.AddFFIDAccessJson.ValueStringPointer())
+	plan.RemoveFFIDAccessJson = customtypes.NewJSONStringPointerValue(plan.RemoveFFIDAccessJson.ValueStringPointer())
+	plan.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserdataJson.ValueStringPointer())
+	plan.SendOtpJson = customtypes.NewJSONStringPointerValue(plan.SendOtpJson.ValueStringPointer())
+	plan.ValidateOtpJson = customtypes.NewJSONStringPointerValue(plan.ValidateOtpJson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 	//TER-176
-	plan.ApplicationDiscoveryJson = util.SafeStringDatasource(plan.ApplicationDiscoveryJson.ValueStringPointer())
-	plan.CreateEntitlementJson = util.SafeStringDatasource(plan.CreateEntitlementJson.ValueStringPointer())
-	plan.DeleteEntitlementJson = util.SafeStringDatasource(plan.DeleteEntitlementJson.ValueStringPointer())
-	plan.UpdateEntitlementJson = util.SafeStringDatasource(plan.UpdateEntitlementJson.ValueStringPointer())
+	plan.ApplicationDiscoveryJson = customtypes.NewJSONStringPointerValue(plan.ApplicationDiscoveryJson.ValueStringPointer())
+	plan.CreateEntitlementJson = customtypes.NewJSONStringPointerValue(plan.CreateEntitlementJson.ValueStringPointer())
+	plan.DeleteEntitlementJson = customtypes.NewJSONStringPointerValue(plan.DeleteEntitlementJson.ValueStringPointer())
+	plan.UpdateEntitlementJson = customtypes.NewJSONStringPointerValue(plan.UpdateEntitlementJson.ValueStringPointer())
 
 	plan.AppType = util.SafeStringDatasource(plan.AppType.ValueStringPointer())
 
@@ -579,36 +657,37 @@ func (r *RestConnectionResource) UpdateModelFromReadResponse(state *RestConnecto
 	state.Description = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Description)
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.RESTConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Emailtemplate)
-	state.ImportUserJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ImportUserJSON)
-	state.ImportAccountEntJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ImportAccountEntJSON)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
-	state.CreateAccountJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.CreateAccountJSON)
-	state.UpdateAccountJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.UpdateAccountJSON)
-	state.EnableAccountJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.EnableAccountJSON)
-	state.DisableAccountJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.DisableAccountJSON)
-	state.AddAccessJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.AddAccessJSON)
-	state.RemoveAccessJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.RemoveAccessJSON)
-	state.UpdateUserJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.UpdateUserJSON)
-	state.ChangePassJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ChangePassJSON)
-	state.RemoveAccountJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.RemoveAccountJSON)
-	state.TicketStatusJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.TicketStatusJSON)
-	state.CreateTicketJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.CreateTicketJSON)
-	state.EndpointsFilter = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
-	state.PasswdPolicyJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.PasswdPolicyJSON)
-	state.ConfigJSON = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ConfigJSON)
-	state.AddFFIDAccessJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.AddFFIDAccessJSON)
-	state.RemoveFFIDAccessJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.RemoveFFIDAccessJSON)
-	state.ModifyUserdataJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
-	state.SendOtpJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.SendOtpJSON)
-	state.ValidateOtpJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ValidateOtpJSON)
-	state.PamConfig = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.ImportUserJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ImportUserJSON)
+	state.ImportAccountEntJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ImportAccountEntJSON)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.CreateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.CreateAccountJSON)
+	state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.UpdateAccountJSON)
+	state.EnableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.EnableAccountJSON)
+	state.DisableAccountJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.DisableAccountJSON)
+	state.AddAccessJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.AddAccessJSON)
+	state.RemoveAccessJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.RemoveAccessJSON)
+	state.UpdateUserJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.UpdateUserJSON)
+	state.ChangePassJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ChangePassJSON)
+	state.RemoveAccountJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.RemoveAccountJSON)
+	state.TicketStatusJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.TicketStatusJSON)
+	state.CreateTicketJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.CreateTicketJSON)
+	state.EndpointsFilter = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ENDPOINTS_FILTER)
+	state.PasswdPolicyJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.PasswdPolicyJSON)
+	state.ConfigJSON = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ConfigJSON)
+	state.AddFFIDAccessJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.AddFFIDAccessJSON)
+	state.RemoveFFIDAccessJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.RemoveFFIDAccessJSON)
+	state.ModifyUserdataJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.SendOtpJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.SendOtpJSON)
+	state.ValidateOtpJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ValidateOtpJSON)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.PAM_CONFIG)
 	//TER-176
-	state.ApplicationDiscoveryJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.ApplicationDiscoveryJSON)
-	state.CreateEntitlementJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.CreateEntitlementJSON)
-	state.DeleteEntitlementJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.DeleteEntitlementJSON)
-	state.UpdateEntitlementJson = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.UpdateEntitlementJSON)
+	state.ApplicationDiscoveryJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.ApplicationDiscoveryJSON)
+	state.CreateEntitlementJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.CreateEntitlementJSON)
+	state.DeleteEntitlementJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.DeleteEntitlementJSON)
+	state.UpdateEntitlementJson = customtypes.NewJSONStringPointerValue(apiResp.RESTConnectionResponse.Connectionattributes.UpdateEntitlementJSON)
 
 	state.AppType = util.SafeStringDatasource(apiResp.RESTConnectionResponse.Connectionattributes.AppType)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.RESTConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *RestConnectionResource) ValidateRESTConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -642,7 +721,7 @@ func (r *RestConnectionResource) UpdateRESTConnection(ctx context.Context, plan
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_rest_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, restConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, restConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -728,8 +807,14 @@ func (r *RestConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateRESTConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateRESTConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "REST connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -784,7 +869,9 @@ func (r *RestConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.RESTConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -889,8 +976,14 @@ func (r *RestConnectionResource) Update(ctx context.Context, req resource.Update
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateRESTConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateRESTConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "REST connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -912,7 +1005,9 @@ func (r *RestConnectionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -0,0 +1,381 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_role_entitlement manages the association of a single entitlement with an enterprise role.
+// The resource implements the full Terraform lifecycle:
+//   - Create: adds the entitlement to the role through the update enterprise role API.
+//   - Read: confirms the association, and removes the resource from state when a confirmed
+//     entitlement is no longer part of the role. An association that waits for the new role
+//     version to be approved is kept in state as pending.
+//   - Delete: removes the entitlement from the role through the update enterprise role API.
+//   - Import: brings an existing association under Terraform management using
+//     'role_name:endpoint:entitlement_type:entitlement_value'.
+//
+// Role definitions that are managed by saviynt_enterprise_roles_resource should set
+// manage_entitlements to false so that entitlements attached by this resource are left alone.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	endpointsutil "terraform-provider-Saviynt/util/endpointsutil"
+	"terraform-provider-Saviynt/util/rolesutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/roles"
+)
+
+var _ resource.Resource = &RoleEntitlementResource{}
+var _ resource.ResourceWithImportState = &RoleEntitlementResource{}
+
+// RoleEntitlementResourceModel defines the state for the role entitlement resource.
+type RoleEntitlementResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	RoleName         types.String `tfsdk:"role_name"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	EntitlementType  types.String `tfsdk:"entitlement_type"`
+	EntitlementValue types.String `tfsdk:"entitlement_value"`
+	Pending          types.Bool   `tfsdk:"pending"`
+}
+
+// RoleEntitlementResource implements the resource.Resource interface for a single role entitlement.
+type RoleEntitlementResource struct {
+	client      client.SaviyntClientInterface
+	token       string
+	provider    client.SaviyntProviderInterface
+	roleFactory client.RoleFactoryInterface
+	requestor   string
+}
+
+// NewRoleEntitlementResource creates a new instance of the role entitlement resource.
+func NewRoleEntitlementResource() resource.Resource {
+	return &RoleEntitlementResource{
+		roleFactory: &client.DefaultRoleFactory{},
+	}
+}
+
+func NewRoleEntitlementResourceWithFactory(factory client.RoleFactoryInterface) resource.Resource {
+	return &RoleEntitlementResource{
+		roleFactory: factory,
+	}
+}
+
+func (r *RoleEntitlementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_entitlement"
+}
+
+func (r *RoleEntitlementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		Description: util.RoleEntitlementDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the association in the format 'role_name:endpoint:entitlement_type:entitlement_value'.",
+			},
+			"role_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the enterprise role the entitlement is attached to.",
+				PlanModifiers: requiresReplace,
+			},
+			"endpoint": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the endpoint the entitlement belongs to.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlement_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the entitlement type of the entitlement.",
+				PlanModifiers: requiresReplace,
+			},
+			"entitlement_value": schema.StringAttribute{
+				Required:      true,
+				Description:   "Value of the entitlement attached to the role.",
+				PlanModifiers: requiresReplace,
+			},
+			"pending": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the entitlement was added but is not reported by Saviynt yet, because the role update created a request that must be approved before the new role version is active. A pending association is kept in state and is not requested again. When the request is rejected, replace the resource to request the entitlement again.",
+			},
+		},
+	}
+}
+
+// Configure initializes the role entitlement resource with the provider's API client and access token.
+func (r *RoleEntitlementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting role entitlement resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	if prov.client.Username != nil {
+		r.requestor = *prov.client.Username
+	}
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+	tflog.Debug(ctx, "Role entitlement resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *RoleEntitlementResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *RoleEntitlementResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *RoleEntitlementResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+// SetRequestor sets the requestor for testing purposes
+func (r *RoleEntitlementResource) SetRequestor(requestor string) {
+	r.requestor = requestor
+}
+
+func roleEntitlementID(model *RoleEntitlementResourceModel) string {
+	return strings.Join([]string{
+		model.RoleName.ValueString(),
+		model.Endpoint.ValueString(),
+		model.EntitlementType.ValueString(),
+		model.EntitlementValue.ValueString(),
+	}, ":")
+}
+
+// ChangeRoleEntitlement adds or removes the entitlement of the model on its role. The role type
+// required by the update enterprise role API is read from Saviynt, and the request is made on
+// behalf of the authenticated user as for saviynt_enterprise_roles_resource. Removing the
+// entitlement of a role that no longer exists returns no response and no error.
+func (r *RoleEntitlementResource) ChangeRoleEntitlement(ctx context.Context, model *RoleEntitlementResourceModel, updateType string) (*openapi.UpdateEnterpriseRoleResponse, error) {
+	roleName := model.RoleName.ValueString()
+
+	roleDetails, err := ReadRoleDetails(ctx, r.provider, r.client, r.roleFactory, roleName, "entitlements")
+	if err != nil {
+		return nil, err
+	}
+	if roleDetails == nil {
+		if updateType == UpdateTypeRemove {
+			tflog.Info(ctx, "Role not found, the entitlement is already removed", map[string]interface{}{"role_name": roleName})
+			return nil, nil
+		}
+		return nil, fmt.Errorf("role %s was not found", roleName)
+	}
+
+	updateReq := openapi.UpdateEnterpriseRoleRequest{
+		RoleName: roleName,
+		Roletype: endpointsutil.TranslateValue(util.SafeDeref(roleDetails.Roletype), rolesutil.RoleTypeMap),
+		Entitlements: []openapi.UpdateEntitlementPayload{
+			{
+				EntitlementValue: model.EntitlementValue.ValueStringPointer(),
+				EntitlementType:  model.EntitlementType.ValueStringPointer(),
+				Endpoint:         model.Endpoint.ValueStringPointer(),
+				UpdateType:       &updateType,
+			},
+		},
+	}
+	if requestor := r.requestor; requestor != "" {
+		updateReq.Requestor = &requestor
+	}
+
+	tflog.Debug(ctx, "Executing role entitlement update API call", map[string]interface{}{
+		"role_name":   roleName,
+		"update_type": updateType,
+	})
+	var apiResp *openapi.UpdateEnterpriseRoleResponse
+	var finalHttpResp *http.Response
+	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "update_enterprise_role_entitlement", func(token string) error {
+		roleOps := r.roleFactory.CreateRoleOperations(r.client.APIBaseURL(), token)
+		resp, httpResp, err := roleOps.UpdateEnterpriseRole(ctx, updateReq)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp // Capture final HTTP response
+		return err
+	})
+
+	var diags diag.Diagnostics
+	if rolesutil.RoleHandleHTTPError(ctx, finalHttpResp, err, "updating role entitlements", &diags) {
+		return nil, fmt.Errorf("failed to update entitlements of role %s: %v", roleName, diags.Errors())
+	}
+	if apiResp != nil && rolesutil.RoleHandleAPIError(ctx, apiResp.ErrorCode, apiResp.Message, "updating role entitlements", &diags) {
+		return nil, fmt.Errorf("API error during role entitlement update: %s", util.SafeDeref(apiResp.Message))
+	}
+
+	return apiResp, nil
+}
+
+// roleHasEntitlement reports whether the role details list the entitlement of the model
+func roleHasEntitlement(roleDetails *openapi.GetRoleDetailsResponse, model *RoleEntitlementResourceModel) bool {
+	for _, e := range roleDetails.EntitlementDetails {
+		if strings.EqualFold(util.SafeDeref(e.Endpoint), model.Endpoint.ValueString()) &&
+			strings.EqualFold(util.SafeDeref(e.EntitlementTypeName), model.EntitlementType.ValueString()) &&
+			util.SafeDeref(e.EntitlementValue) == model.EntitlementValue.ValueString() {
+			return true
+		}
+	}
+	return false
+}
+
+// addRoleUpdateWarning surfaces the pending request created by a role update
+func addRoleUpdateWarning(apiResp *openapi.UpdateEnterpriseRoleResponse, roleName string, diagnostics *diag.Diagnostics) {
+	if apiResp == nil || apiResp.GetMessage() == "" {
+		return
+	}
+	diagnostics.AddWarning(
+		"Role Updated - Manual UI Action Required",
+		fmt.Sprintf(
+			"Entitlements of role %s updated.\nMessage: %s\nErrorCode: %s\nRequestID: %s\nRequestKey: %s\n\n"+
+				"⚠️  IMPORTANT: Role update may have generated a pending request and created a new version.\n"+
+				"Please log into the Saviynt UI and approve the request. The entitlement is not reported by Saviynt until the new role version is active.",
+			roleName,
+			apiResp.GetMessage(),
+			apiResp.GetErrorCode(),
+			apiResp.GetRequestid(),
+			apiResp.GetRequestkey(),
+		),
+	)
+}
+
+func (r *RoleEntitlementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleEntitlementResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.ChangeRoleEntitlement(ctx, &plan, UpdateTypeAdd)
+	if err != nil {
+		resp.Diagnostics.AddError("Role Entitlement Creation Failed", err.Error())
+		return
+	}
+	addRoleUpdateWarning(apiResp, plan.RoleName.ValueString(), &resp.Diagnostics)
+
+	plan.ID = types.StringValue(roleEntitlementID(&plan))
+	// The association is confirmed by the next read, as the new role version may wait for approval
+	plan.Pending = types.BoolValue(true)
+	tflog.Info(ctx, "Entitlement added to role", map[string]interface{}{"id": plan.ID.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleEntitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleEntitlementResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleDetails, err := ReadRoleDetails(ctx, r.provider, r.client, r.roleFactory, state.RoleName.ValueString(), "entitlements")
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Failed", err.Error())
+		return
+	}
+	if roleDetails == nil {
+		tflog.Warn(ctx, "Role not found, removing role entitlement from state", map[string]interface{}{"id": roleEntitlementID(&state)})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !roleHasEntitlement(roleDetails, &state) {
+		// An association that was never confirmed still waits for the new role version; keep it
+		// so that the next plan does not request it again
+		if state.Pending.ValueBool() {
+			tflog.Info(ctx, "Role entitlement is pending, keeping it in state", map[string]interface{}{"id": roleEntitlementID(&state)})
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		tflog.Warn(ctx, "Confirmed role entitlement no longer reported, removing from state", map[string]interface{}{"id": roleEntitlementID(&state)})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(roleEntitlementID(&state))
+	state.Pending = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a real change because every configurable attribute requires replacement.
+func (r *RoleEntitlementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleEntitlementResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Pending = state.Pending
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleEntitlementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleEntitlementResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.ChangeRoleEntitlement(ctx, &state, UpdateTypeRemove)
+	if err != nil {
+		resp.Diagnostics.AddError("Role Entitlement Deletion Failed", err.Error())
+		return
+	}
+	addRoleUpdateWarning(apiResp, state.RoleName.ValueString(), &resp.Diagnostics)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RoleEntitlementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ":", 4)
+	if len(idParts) != 4 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			fmt.Sprintf("Expected import ID format: 'role_name:endpoint:entitlement_type:entitlement_value', got: %s\n"+
+				"Example: terraform import saviynt_role_entitlement.example finance_approver:sample-103:terraform_ent_type:terraform_ent", req.ID),
+		)
+		return
+	}
+
+	for i, attribute := range []string{"role_name", "endpoint", "entitlement_type", "entitlement_value"} {
+		value := strings.TrimSpace(idParts[i])
+		if value == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID Components",
+				"role_name, endpoint, entitlement_type and entitlement_value must be non-empty",
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_role_history retrieves the version metadata and the last certification of a role from the Saviynt
+// Security Manager. The data source supports a single Read operation and reports whether the last review of
+// the role is older than review_max_age_days so that stale certifications can be caught in a check block.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/endpointsutil"
+	"terraform-provider-Saviynt/util/rolesutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/roles"
+)
+
+// DefaultReviewMaxAgeDays is the review age after which a role is reported as overdue
+const DefaultReviewMaxAgeDays = 365
+
+// roleDateLayouts are the formats in which Saviynt returns role dates
+var roleDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.0",
+	"01/02/2006 15:04:05",
+	"2006-01-02",
+	"01/02/2006",
+}
+
+var _ datasource.DataSource = &RoleHistoryDataSource{}
+var _ datasource.DataSourceWithConfigure = &RoleHistoryDataSource{}
+
+// RoleHistoryDataSource implements the datasource.DataSource interface for the history of a role
+type RoleHistoryDataSource struct {
+	client      client.SaviyntClientInterface
+	token       string
+	provider    client.SaviyntProviderInterface
+	roleFactory client.RoleFactoryInterface
+}
+
+type RoleHistoryDataSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	RoleName                 types.String `tfsdk:"role_name"`
+	Authenticate             types.Bool   `tfsdk:"authenticate"`
+	ReviewMaxAgeDays         types.Int64  `tfsdk:"review_max_age_days"`
+	RoleKey                  types.Int64  `tfsdk:"role_key"`
+	RoleType                 types.String `tfsdk:"role_type"`
+	Version                  types.Int64  `tfsdk:"version"`
+	Status                   types.String `tfsdk:"status"`
+	UpdateDate               types.String `tfsdk:"update_date"`
+	UpdateUser               types.String `tfsdk:"update_user"`
+	LastReviewedBy           types.String `tfsdk:"last_reviewed_by"`
+	LastReviewedCampaignName types.String `tfsdk:"last_reviewed_campaign_name"`
+	LastReviewedDate         types.String `tfsdk:"last_reviewed_date"`
+	DaysSinceReview          types.Int64  `tfsdk:"days_since_review"`
+	ReviewOverdue            types.Bool   `tfsdk:"review_overdue"`
+}
+
+func NewRoleHistoryDataSource() datasource.DataSource {
+	return &RoleHistoryDataSource{
+		roleFactory: &client.DefaultRoleFactory{},
+	}
+}
+
+// NewRoleHistoryDataSourceWithFactory creates a new role history data source with custom factory
+// Used primarily for testing with mock factories
+func NewRoleHistoryDataSourceWithFactory(factory client.RoleFactoryInterface) datasource.DataSource {
+	return &RoleHistoryDataSource{
+		roleFactory: factory,
+	}
+}
+
+// SetClient sets the client for testing purposes
+func (d *RoleHistoryDataSource) SetClient(client client.SaviyntClientInterface) {
+	d.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (d *RoleHistoryDataSource) SetToken(token string) {
+	d.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (d *RoleHistoryDataSource) SetProvider(provider client.SaviyntProviderInterface) {
+	d.provider = provider
+}
+
+func (d *RoleHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_history"
+}
+
+func (d *RoleHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.RoleHistoryDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the data source.",
+			},
+			"role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the role.",
+			},
+			"authenticate": schema.BoolAttribute{
+				Required:    true,
+				Description: "Controls visibility of sensitive data in Terraform state. When 'false', the users who last updated and last certified the role are omitted from state. When 'true', they are returned in state.",
+			},
+			"review_max_age_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Number of days after which the last review of the role is considered overdue. Defaults to %d.", DefaultReviewMaxAgeDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"role_key": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Key of the role.",
+			},
+			"role_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the role.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Current version of the role definition.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the role. Either 'Active' or 'Inactive'.",
+			},
+			"update_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the role was last updated, in RFC 3339 format. Dates that cannot be parsed are returned as reported by Saviynt.",
+			},
+			"update_user": schema.StringAttribute{
+				Computed:    true,
+				Description: "User who last updated the role.",
+			},
+			"last_reviewed_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "User who last certified the role.",
+			},
+			"last_reviewed_campaign_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Campaign in which the role was last certified.",
+			},
+			"last_reviewed_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the role was last certified, in RFC 3339 format. Dates that cannot be parsed are returned as reported by Saviynt.",
+			},
+			"days_since_review": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of whole days since the role was last certified. Null when the role has never been certified.",
+			},
+			"review_overdue": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the role has never been certified or was last certified more than review_max_age_days ago.",
+			},
+		},
+	}
+}
+
+func (d *RoleHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting role history datasource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	d.client = &client.SaviyntClientWrapper{Client: prov.client}
+	d.token = prov.accessToken
+	d.provider = &client.SaviyntProviderWrapper{Provider: prov}
+	tflog.Debug(ctx, "Role history datasource configured successfully")
+}
+
+func (d *RoleHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state RoleHistoryDataSourceModel
+
+	tflog.Debug(ctx, "Starting role history datasource read")
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	item, err := ReadRoleDetails(ctx, d.provider, d.client, d.roleFactory, roleName, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Role History Read Failed",
+			fmt.Sprintf("Failed to read role %s: %s", roleName, err.Error()),
+		)
+		return
+	}
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"No Data Found",
+			fmt.Sprintf("Role %s was not found.", roleName),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("role-history-" + roleName)
+	state.RoleKey = util.SafeInt64(item.RoleKey)
+	state.RoleType = types.StringNull()
+	if item.Roletype != nil {
+		state.RoleType = types.StringValue(endpointsutil.TranslateValue(*item.Roletype, rolesutil.RoleTypeMap))
+	}
+	state.Version = RoleVersionValue(item.Version)
+	state.Status = types.StringNull()
+	if item.Status != nil {
+		state.Status = types.StringValue(endpointsutil.TranslateValue(*item.Status, rolesutil.StatusMap))
+	}
+	state.UpdateDate, _ = formatRoleDate(item.Updatedate)
+	state.UpdateUser = util.SafeStringDatasource(item.Updateuser)
+	state.LastReviewedBy = util.SafeStringDatasource(item.LastReviewedBy)
+	state.LastReviewedCampaignName = util.SafeStringDatasource(item.LastReviewedCampaignName)
+
+	var reviewed *time.Time
+	state.LastReviewedDate, reviewed = formatRoleDate(item.LastReviewedDate)
+
+	// The role details of firefighter roles do not always carry the review date, the firefighter role list does
+	if reviewed == nil && state.RoleType.ValueString() == rolesutil.RoleTypeMap["3"] {
+		roles, err := ReadFirefighterRoles(ctx, d.provider, d.client, d.roleFactory)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Role History Read Failed",
+				fmt.Sprintf("Failed to read firefighter role %s: %s", roleName, err.Error()),
+			)
+			return
+		}
+		if role := FindFirefighterRole(roles, roleName); role != nil && role.LastReviewedDate != nil && !role.LastReviewedDate.IsZero() {
+			reviewed = role.LastReviewedDate
+			state.LastReviewedDate = formatRoleTime(role.LastReviewedDate)
+			if role.LastReviewedBy != nil {
+				state.LastReviewedBy = util.SafeStringDatasource(role.LastReviewedBy)
+			}
+			if role.LastReviewedCampaignName != nil {
+				state.LastReviewedCampaignName = util.SafeStringDatasource(role.LastReviewedCampaignName)
+			}
+		}
+	}
+
+	if reviewed == nil && !state.LastReviewedDate.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Unrecognized Review Date",
+			fmt.Sprintf("The last review date %q of role %s could not be parsed; the role is reported as overdue.", state.LastReviewedDate.ValueString(), roleName),
+		)
+	}
+
+	maxAgeDays := int64(DefaultReviewMaxAgeDays)
+	if !state.ReviewMaxAgeDays.IsNull() && !state.ReviewMaxAgeDays.IsUnknown() {
+		maxAgeDays = state.ReviewMaxAgeDays.ValueInt64()
+	}
+	state.DaysSinceReview = types.Int64Null()
+	state.ReviewOverdue = types.BoolValue(true)
+	if reviewed != nil {
+		days := int64(math.Floor(time.Since(*reviewed).Hours() / 24))
+		if days < 0 {
+			days = 0
+		}
+		state.DaysSinceReview = types.Int64Value(days)
+		state.ReviewOverdue = types.BoolValue(days > maxAgeDays)
+	}
+
+	if !state.Authenticate.IsNull() && !state.Authenticate.IsUnknown() {
+		if state.Authenticate.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"Authentication Enabled",
+				"`authenticate` is true; the users who updated and certified the role will be returned in state.",
+			)
+		} else {
+			resp.Diagnostics.AddWarning(
+				"Authentication Disabled",
+				"`authenticate` is false; the users who updated and certified the role will be removed from state.",
+			)
+			state.UpdateUser = types.StringNull()
+			state.LastReviewedBy = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Role history datasource read completed successfully", map[string]interface{}{
+		"role_name":      roleName,
+		"review_overdue": state.ReviewOverdue.ValueBool(),
+	})
+}
+
+// RoleVersionValue returns the version of a role, which Saviynt reports either as a number or as a string
+func RoleVersionValue(version *openapi.GetRoleDetailsResponseVersion) types.Int64 {
+	if version == nil {
+		return types.Int64Null()
+	}
+	if version.Int32 != nil {
+		return types.Int64Value(int64(*version.Int32))
+	}
+	if version.String != nil {
+		if val, err := strconv.ParseInt(*version.String, 10, 64); err == nil {
+			return types.Int64Value(val)
+		}
+	}
+	return types.Int64Null()
+}
+
+// formatRoleDate parses a role date returned as a string and formats it in RFC 3339. Dates in an
+// unknown format are returned unchanged and without a parsed time.
+func formatRoleDate(value *string) (types.String, *time.Time) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return types.StringNull(), nil
+	}
+	raw := strings.TrimSpace(*value)
+	for _, layout := range roleDateLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return formatRoleTime(&t), &t
+		}
+	}
+	return types.StringValue(raw), nil
+}
@@ -0,0 +1,444 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_role_members authoritatively manages the users assigned to an enterprise role.
+// The resource implements the full Terraform lifecycle:
+//   - Create: assigns the configured users and removes every other user from the role.
+//   - Read: reports the users currently assigned to the role so that drift shows up in the plan.
+//     Users whose assignment or removal waits for its task to be completed are reported as
+//     configured until Saviynt reports the change.
+//   - Update: adds and removes users so that the role matches user_names.
+//   - Delete: removes the users recorded in state from the role.
+//   - Import: brings the membership of an existing role under Terraform management by role name.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RoleMembersResource{}
+var _ resource.ResourceWithImportState = &RoleMembersResource{}
+
+// RoleMembersResourceModel defines the state for the authoritative role members resource.
+type RoleMembersResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	RoleName  types.String `tfsdk:"role_name"`
+	UserNames types.Set    `tfsdk:"user_names"`
+
+	PendingAdditions types.Set `tfsdk:"pending_additions"`
+	PendingRemovals  types.Set `tfsdk:"pending_removals"`
+}
+
+// RoleMembersResource implements the resource.Resource interface for the full membership of a role.
+type RoleMembersResource struct {
+	client      client.SaviyntClientInterface
+	token       string
+	provider    client.SaviyntProviderInterface
+	roleFactory client.RoleFactoryInterface
+}
+
+// NewRoleMembersResource creates a new instance of the role members resource.
+func NewRoleMembersResource() resource.Resource {
+	return &RoleMembersResource{
+		roleFactory: &client.DefaultRoleFactory{},
+	}
+}
+
+func NewRoleMembersResourceWithFactory(factory client.RoleFactoryInterface) resource.Resource {
+	return &RoleMembersResource{
+		roleFactory: factory,
+	}
+}
+
+func (r *RoleMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_members"
+}
+
+func (r *RoleMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.RoleMembersDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the role members resource. This is the role name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the role whose membership is managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Usernames of all users assigned to the role. Users assigned to the role but missing from this set are removed from the role. An empty set removes every user.",
+			},
+			"pending_additions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Usernames that were assigned to the role but are not reported by Saviynt yet, for example because their task waits for completion in the Saviynt UI. Pending users are reported in user_names and are not assigned again. When a task is rejected, the user stays pending until it is removed from user_names.",
+			},
+			"pending_removals": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Usernames that were removed from the role but are still reported by Saviynt, for example because their task waits for completion in the Saviynt UI. Pending users are left out of user_names and are not removed again. When a task is rejected, the user stays pending until it is added to user_names.",
+			},
+		},
+	}
+}
+
+// Configure initializes the role members resource with the provider's API client and access token.
+func (r *RoleMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting role members resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+	tflog.Debug(ctx, "Role members resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *RoleMembersResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *RoleMembersResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *RoleMembersResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+// ReconcileRoleMembers adds and removes users so that the role has exactly the desired users.
+// The current membership is read from Saviynt rather than from state so that users added
+// outside Terraform are removed as well. Users in pendingAdditions and pendingRemovals were
+// changed by an earlier apply whose tasks are not completed yet and are not changed again.
+// The users whose change is still pending after the reconciliation are returned.
+func (r *RoleMembersResource) ReconcileRoleMembers(ctx context.Context, roleName string, desired, pendingAdditions, pendingRemovals []string, diagnostics *diag.Diagnostics) (additions, removals []string, err error) {
+	current, found, err := ReadRoleUserNames(ctx, r.provider, r.client, r.roleFactory, roleName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("role %s was not found", roleName)
+	}
+
+	additions, removals = []string{}, []string{}
+	var errors []string
+	var successMessages []string
+	for _, userName := range current {
+		if containsFold(desired, userName) {
+			continue
+		}
+		if containsFold(pendingRemovals, userName) {
+			removals = append(removals, userName)
+			continue
+		}
+		apiResp, err := RoleRemoveUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName)
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		removals = append(removals, userName)
+		if msg := apiResp.GetMessage(); msg != "" {
+			successMessages = append(successMessages, fmt.Sprintf("Removed user %s: %s", userName, msg))
+		}
+	}
+	for _, userName := range desired {
+		if containsFold(current, userName) {
+			continue
+		}
+		if containsFold(pendingAdditions, userName) {
+			additions = append(additions, userName)
+			continue
+		}
+		apiResp, err := RoleAddUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName)
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		additions = append(additions, userName)
+		if msg := apiResp.GetMessage(); msg != "" {
+			successMessages = append(successMessages, fmt.Sprintf("Added user %s: %s", userName, msg))
+		}
+	}
+	sort.Strings(additions)
+	sort.Strings(removals)
+
+	if len(successMessages) > 0 && diagnostics != nil {
+		diagnostics.AddWarning(
+			"User Changes Task Details - Manual UI Action Required",
+			fmt.Sprintf("User changes completed for role %s. Task details: %s\n\n"+
+				"⚠️  IMPORTANT: These operations created tasks in Saviynt that require manual completion.\n"+
+				"Please log into the Saviynt UI and navigate to the Pending Tasks section to complete these tasks.\n"+
+				"Tasks will remain pending until manually approved/completed in the UI.",
+				roleName, strings.Join(successMessages, "; ")),
+		)
+	}
+
+	if len(errors) > 0 {
+		return additions, removals, fmt.Errorf("failed user changes for role %s: %s", roleName, strings.Join(errors, "; "))
+	}
+	return additions, removals, nil
+}
+
+// AppliedRoleMembers returns the users of the role once additions are added to and removals are
+// removed from users, keeping the spelling of users.
+func AppliedRoleMembers(users, additions, removals []string) []string {
+	applied := []string{}
+	for _, userName := range users {
+		if !containsFold(removals, userName) {
+			applied = append(applied, userName)
+		}
+	}
+	for _, userName := range additions {
+		if !containsFold(applied, userName) {
+			applied = append(applied, userName)
+		}
+	}
+	sort.Strings(applied)
+	return applied
+}
+
+// SetPendingRoleMembers stores the users whose change is pending in the model.
+func SetPendingRoleMembers(ctx context.Context, model *RoleMembersResourceModel, additions, removals []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	additionSet, d := types.SetValueFrom(ctx, types.StringType, additions)
+	diags.Append(d...)
+	removalSet, d := types.SetValueFrom(ctx, types.StringType, removals)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	model.PendingAdditions = additionSet
+	model.PendingRemovals = removalSet
+	return diags
+}
+
+// stringSetElements returns the elements of a string set, or nil when the set is null or unknown.
+func stringSetElements(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var values []string
+	diagnostics.Append(set.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []string
+	resp.Diagnostics.Append(plan.UserNames.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := plan.RoleName.ValueString()
+	tflog.Debug(ctx, "Creating role members", map[string]interface{}{"role_name": roleName, "user_count": len(desired)})
+
+	additions, removals, err := r.ReconcileRoleMembers(ctx, roleName, desired, nil, nil, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Role Members Creation Failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(roleName)
+	resp.Diagnostics.Append(SetPendingRoleMembers(ctx, &plan, additions, removals)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	current, found, err := ReadRoleUserNames(ctx, r.provider, r.client, r.roleFactory, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Failed", err.Error())
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Role not found, removing role members from state", map[string]interface{}{"role_name": roleName})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	stateUsers := stringSetElements(ctx, state.UserNames, &resp.Diagnostics)
+	pendingAdditions := stringSetElements(ctx, state.PendingAdditions, &resp.Diagnostics)
+	pendingRemovals := stringSetElements(ctx, state.PendingRemovals, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the configured spelling of usernames that Saviynt reports with a different case, and
+	// report pending changes as applied so that they are not planned again
+	userNames := make([]string, 0, len(current)+len(pendingAdditions))
+	stillRemoving := []string{}
+	for _, userName := range current {
+		if containsFold(pendingRemovals, userName) {
+			stillRemoving = append(stillRemoving, userName)
+			continue
+		}
+		for _, stateUser := range stateUsers {
+			if strings.EqualFold(stateUser, userName) {
+				userName = stateUser
+				break
+			}
+		}
+		userNames = append(userNames, userName)
+	}
+	stillAdding := []string{}
+	for _, userName := range pendingAdditions {
+		if !containsFold(current, userName) {
+			stillAdding = append(stillAdding, userName)
+			userNames = append(userNames, userName)
+		}
+	}
+	sort.Strings(userNames)
+
+	userSet, diags := types.SetValueFrom(ctx, types.StringType, userNames)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(SetPendingRoleMembers(ctx, &state, stillAdding, stillRemoving)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(roleName)
+	state.UserNames = userSet
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []string
+	resp.Diagnostics.Append(plan.UserNames.ElementsAs(ctx, &desired, false)...)
+	pendingAdditions := stringSetElements(ctx, state.PendingAdditions, &resp.Diagnostics)
+	pendingRemovals := stringSetElements(ctx, state.PendingRemovals, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := plan.RoleName.ValueString()
+	additions, removals, err := r.ReconcileRoleMembers(ctx, roleName, desired, pendingAdditions, pendingRemovals, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Role Members Update Failed", err.Error())
+		if additions == nil && removals == nil {
+			return
+		}
+		// Save the users that were already changed so that the next apply only retries the
+		// failed changes instead of requesting the same tasks again
+		stateUsers := stringSetElements(ctx, state.UserNames, &resp.Diagnostics)
+		userSet, diags := types.SetValueFrom(ctx, types.StringType, AppliedRoleMembers(stateUsers, additions, removals))
+		resp.Diagnostics.Append(diags...)
+		state.ID = types.StringValue(roleName)
+		state.UserNames = userSet
+		resp.Diagnostics.Append(SetPendingRoleMembers(ctx, &state, additions, removals)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	plan.ID = types.StringValue(roleName)
+	resp.Diagnostics.Append(SetPendingRoleMembers(ctx, &plan, additions, removals)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userNames []string
+	resp.Diagnostics.Append(state.UserNames.ElementsAs(ctx, &userNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	var errors []string
+	for _, userName := range userNames {
+		if _, err := RoleRemoveUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+	if len(errors) > 0 {
+		resp.Diagnostics.AddError(
+			"Role Members Deletion Failed",
+			fmt.Sprintf("failed to remove users from role %s: %s", roleName, strings.Join(errors, "; ")),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RoleMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), req, resp)
+}
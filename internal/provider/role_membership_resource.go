@@ -0,0 +1,294 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_role_membership manages the assignment of a single user to an enterprise role.
+// The resource implements the full Terraform lifecycle:
+//   - Create: assigns the user to the role.
+//   - Read: confirms the assignment, and removes the resource from state when a confirmed
+//     assignment is no longer reported. An assignment that waits for its task to be completed
+//     is kept in state as pending.
+//   - Delete: removes the user from the role.
+//   - Import: brings an existing assignment under Terraform management using 'role_name:user_name'.
+//
+// Role definitions that are managed by saviynt_enterprise_roles_resource should set
+// manage_users to false so that membership is owned by this resource alone.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RoleMembershipResource{}
+var _ resource.ResourceWithImportState = &RoleMembershipResource{}
+
+// RoleMembershipResourceModel defines the state for the role membership resource.
+type RoleMembershipResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	RoleName  types.String `tfsdk:"role_name"`
+	UserName  types.String `tfsdk:"user_name"`
+	Pending   types.Bool   `tfsdk:"pending"`
+	Message   types.String `tfsdk:"message"`
+	ErrorCode types.String `tfsdk:"error_code"`
+}
+
+// RoleMembershipResource implements the resource.Resource interface for a single role assignment.
+type RoleMembershipResource struct {
+	client      client.SaviyntClientInterface
+	token       string
+	provider    client.SaviyntProviderInterface
+	roleFactory client.RoleFactoryInterface
+}
+
+// NewRoleMembershipResource creates a new instance of the role membership resource.
+func NewRoleMembershipResource() resource.Resource {
+	return &RoleMembershipResource{
+		roleFactory: &client.DefaultRoleFactory{},
+	}
+}
+
+func NewRoleMembershipResourceWithFactory(factory client.RoleFactoryInterface) resource.Resource {
+	return &RoleMembershipResource{
+		roleFactory: factory,
+	}
+}
+
+func (r *RoleMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *RoleMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.RoleMembershipDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the role membership in the format 'role_name:user_name'.",
+			},
+			"role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the role the user is assigned to. Changing this value assigns the user to the new role and removes the old assignment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Username of the Saviynt user assigned to the role. Changing this value assigns the new user and removes the old assignment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pending": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the assignment was requested but is not reported by Saviynt yet, for example because its task waits for completion in the Saviynt UI. A pending assignment is kept in state and is not requested again. When the task is rejected, remove the resource from the configuration or replace it to request the assignment again.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Message returned by Saviynt when the user was assigned to the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_code": schema.StringAttribute{
+				Computed:    true,
+				Description: "Error code returned by Saviynt when the user was assigned to the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the role membership resource with the provider's API client and access token.
+func (r *RoleMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Starting role membership resource configuration")
+
+	// Check if provider data is available.
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "ProviderData is nil, returning early")
+		return
+	}
+
+	// Cast provider data to your provider type.
+	prov, ok := req.ProviderData.(*SaviyntProvider)
+	if !ok {
+		tflog.Error(ctx, "Provider configuration failed - expected *SaviyntProvider, got different type")
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected *SaviyntProvider, got different type",
+		)
+		return
+	}
+
+	// Set the client and token from the provider state using interface wrapper.
+	r.client = &client.SaviyntClientWrapper{Client: prov.client}
+	r.token = prov.accessToken
+	r.provider = &client.SaviyntProviderWrapper{Provider: prov} // Store provider reference for retry logic
+	tflog.Debug(ctx, "Role membership resource configured successfully")
+}
+
+// SetClient sets the client for testing purposes
+func (r *RoleMembershipResource) SetClient(client client.SaviyntClientInterface) {
+	r.client = client
+}
+
+// SetToken sets the token for testing purposes
+func (r *RoleMembershipResource) SetToken(token string) {
+	r.token = token
+}
+
+// SetProvider sets the provider for testing purposes
+func (r *RoleMembershipResource) SetProvider(provider client.SaviyntProviderInterface) {
+	r.provider = provider
+}
+
+func (r *RoleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := plan.RoleName.ValueString()
+	userName := plan.UserName.ValueString()
+	tflog.Debug(ctx, "Creating role membership", map[string]interface{}{"role_name": roleName, "user_name": userName})
+
+	apiResp, err := RoleAddUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName)
+	if err != nil {
+		resp.Diagnostics.AddError("Role Membership Creation Failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(roleName + ":" + userName)
+	// The assignment is confirmed by the next read, as a task may have to be completed first
+	plan.Pending = types.BoolValue(true)
+	plan.Message = types.StringValue(apiResp.GetMessage())
+	plan.ErrorCode = types.StringValue(apiResp.GetErrorCode())
+
+	if apiResp.GetMessage() != "" {
+		resp.Diagnostics.AddWarning(
+			"User Addition Task Details - Manual UI Action Required",
+			fmt.Sprintf("User %s added to role %s. Task details: %s\n\n"+
+				"⚠️  IMPORTANT: This operation may have created a task in Saviynt that requires manual completion.\n"+
+				"Please log into the Saviynt UI and navigate to the Pending Tasks section to complete the task.\n"+
+				"The membership is not reported by Saviynt until the task is completed.",
+				userName, roleName, apiResp.GetMessage()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	userName := state.UserName.ValueString()
+
+	userNames, found, err := ReadRoleUserNames(ctx, r.provider, r.client, r.roleFactory, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Failed", err.Error())
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Role not found, removing role membership from state", map[string]interface{}{
+			"role_name": roleName,
+			"user_name": userName,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !containsFold(userNames, userName) {
+		// An assignment that was never confirmed still waits for its task; keep it so that the
+		// next plan does not request it again
+		if state.Pending.ValueBool() {
+			tflog.Info(ctx, "Role membership is pending, keeping it in state", map[string]interface{}{
+				"role_name": roleName,
+				"user_name": userName,
+			})
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		tflog.Warn(ctx, "Confirmed role membership no longer reported, removing from state", map[string]interface{}{
+			"role_name": roleName,
+			"user_name": userName,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(roleName + ":" + userName)
+	state.Pending = types.BoolValue(false)
+	if state.Message.IsNull() {
+		state.Message = types.StringValue("")
+	}
+	if state.ErrorCode.IsNull() {
+		state.ErrorCode = types.StringValue("")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a real change because every configurable attribute requires replacement.
+func (r *RoleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	userName := state.UserName.ValueString()
+	tflog.Debug(ctx, "Deleting role membership", map[string]interface{}{"role_name": roleName, "user_name": userName})
+
+	if _, err := RoleRemoveUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName); err != nil {
+		resp.Diagnostics.AddError("Role Membership Deletion Failed", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RoleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ":", 2)
+	if len(idParts) != 2 || strings.TrimSpace(idParts[0]) == "" || strings.TrimSpace(idParts[1]) == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			fmt.Sprintf("Expected import ID format: 'role_name:user_name', got: %s\n"+
+				"Example: terraform import saviynt_role_membership.example finance_approver:jdoe", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), strings.TrimSpace(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), strings.TrimSpace(idParts[1]))...)
+}
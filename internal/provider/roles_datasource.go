@@ -12,83 +12,23 @@ import (
 	"reflect"
 	"strconv"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/endpointsutil"
 	"terraform-provider-Saviynt/util/rolesutil"
 
 	openapi "github.com/saviynt/saviynt-api-go-client/roles"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// CustomPropertiesModel defines the 60 custom properties that can be reused across structs
-type CustomPropertiesModel struct {
-	CustomProperty1  types.String `tfsdk:"custom_property1"`
-	CustomProperty2  types.String `tfsdk:"custom_property2"`
-	CustomProperty3  types.String `tfsdk:"custom_property3"`
-	CustomProperty4  types.String `tfsdk:"custom_property4"`
-	CustomProperty5  types.String `tfsdk:"custom_property5"`
-	CustomProperty6  types.String `tfsdk:"custom_property6"`
-	CustomProperty7  types.String `tfsdk:"custom_property7"`
-	CustomProperty8  types.String `tfsdk:"custom_property8"`
-	CustomProperty9  types.String `tfsdk:"custom_property9"`
-	CustomProperty10 types.String `tfsdk:"custom_property10"`
-	CustomProperty11 types.String `tfsdk:"custom_property11"`
-	CustomProperty12 types.String `tfsdk:"custom_property12"`
-	CustomProperty13 types.String `tfsdk:"custom_property13"`
-	CustomProperty14 types.String `tfsdk:"custom_property14"`
-	CustomProperty15 types.String `tfsdk:"custom_property15"`
-	CustomProperty16 types.String `tfsdk:"custom_property16"`
-	CustomProperty17 types.String `tfsdk:"custom_property17"`
-	CustomProperty18 types.String `tfsdk:"custom_property18"`
-	CustomProperty19 types.String `tfsdk:"custom_property19"`
-	CustomProperty20 types.String `tfsdk:"custom_property20"`
-	CustomProperty21 types.String `tfsdk:"custom_property21"`
-	CustomProperty22 types.String `tfsdk:"custom_property22"`
-	CustomProperty23 types.String `tfsdk:"custom_property23"`
-	CustomProperty24 types.String `tfsdk:"custom_property24"`
-	CustomProperty25 types.String `tfsdk:"custom_property25"`
-	CustomProperty26 types.String `tfsdk:"custom_property26"`
-	CustomProperty27 types.String `tfsdk:"custom_property27"`
-	CustomProperty28 types.String `tfsdk:"custom_property28"`
-	CustomProperty29 types.String `tfsdk:"custom_property29"`
-	CustomProperty30 types.String `tfsdk:"custom_property30"`
-	CustomProperty31 types.String `tfsdk:"custom_property31"`
-	CustomProperty32 types.String `tfsdk:"custom_property32"`
-	CustomProperty33 types.String `tfsdk:"custom_property33"`
-	CustomProperty34 types.String `tfsdk:"custom_property34"`
-	CustomProperty35 types.String `tfsdk:"custom_property35"`
-	CustomProperty36 types.String `tfsdk:"custom_property36"`
-	CustomProperty37 types.String `tfsdk:"custom_property37"`
-	CustomProperty38 types.String `tfsdk:"custom_property38"`
-	CustomProperty39 types.String `tfsdk:"custom_property39"`
-	CustomProperty40 types.String `tfsdk:"custom_property40"`
-	CustomProperty41 types.String `tfsdk:"custom_property41"`
-	CustomProperty42 types.String `tfsdk:"custom_property42"`
-	CustomProperty43 types.String `tfsdk:"custom_property43"`
-	CustomProperty44 types.String `tfsdk:"custom_property44"`
-	CustomProperty45 types.String `tfsdk:"custom_property45"`
-	CustomProperty46 types.String `tfsdk:"custom_property46"`
-	CustomProperty47 types.String `tfsdk:"custom_property47"`
-	CustomProperty48 types.String `tfsdk:"custom_property48"`
-	CustomProperty49 types.String `tfsdk:"custom_property49"`
-	CustomProperty50 types.String `tfsdk:"custom_property50"`
-	CustomProperty51 types.String `tfsdk:"custom_property51"`
-	CustomProperty52 types.String `tfsdk:"custom_property52"`
-	CustomProperty53 types.String `tfsdk:"custom_property53"`
-	CustomProperty54 types.String `tfsdk:"custom_property54"`
-	CustomProperty55 types.String `tfsdk:"custom_property55"`
-	CustomProperty56 types.String `tfsdk:"custom_property56"`
-	CustomProperty57 types.String `tfsdk:"custom_property57"`
-	CustomProperty58 types.String `tfsdk:"custom_property58"`
-	CustomProperty59 types.String `tfsdk:"custom_property59"`
-	CustomProperty60 types.String `tfsdk:"custom_property60"`
-}
-
 type RolesDataSource struct {
 	client      client.SaviyntClientInterface
 	token       string
@@ -128,74 +68,19 @@ func (r *RolesDataSource) SetProvider(provider client.SaviyntProviderInterface)
 	r.provider = provider
 }
 
-// setCustomPropertiesOnRequest sets custom properties on the API request using reflection
-// This eliminates the need for 60 individual if-statements
-func (d *RolesDataSource) setCustomPropertiesOnRequest(state *RolesDataSourceModel, areq *openapi.GetRolesRequest) {
-	// Use reflection to iterate through custom properties
-	stateValue := reflect.ValueOf(state).Elem()
-	reqValue := reflect.ValueOf(areq) // Don't dereference - methods are on pointer type
-
-	for i := 1; i <= 60; i++ {
-		// Get the field from state
-		stateFieldName := fmt.Sprintf("CustomProperty%d", i)
-		stateField := stateValue.FieldByName(stateFieldName)
-
-		if !stateField.IsValid() {
-			continue
-		}
-
-		// Get the Terraform types.String value
-		tfString := stateField.Interface().(types.String)
-
-		// Check if the field has a value
-		if !tfString.IsNull() && tfString.ValueString() != "" {
-			// Get the corresponding setter method on the request
-			setterMethodName := fmt.Sprintf("SetCustomproperty%d", i)
-			setterMethod := reqValue.MethodByName(setterMethodName)
-
-			if setterMethod.IsValid() {
-				// Call the setter method with the string value
-				args := []reflect.Value{reflect.ValueOf(tfString.ValueString())}
-				setterMethod.Call(args)
-			}
-		}
+// setCustomPropertiesOnRequest sets the custom_properties filter on the API request
+func (d *RolesDataSource) setCustomPropertiesOnRequest(ctx context.Context, state *RolesDataSourceModel, areq *openapi.GetRolesRequest) error {
+	values, diags := CustomPropertyValues(ctx, state.CustomProperties, nil)
+	if diags.HasError() {
+		return fmt.Errorf("invalid custom_properties filter")
 	}
-}
-
-// mapCustomPropertiesFromResponse maps custom properties from API response to Role struct using reflection
-// This eliminates the need for 60 individual assignments
-func (d *RolesDataSource) mapCustomPropertiesFromResponse(roleState *Role, item *openapi.GetRoleDetailsResponse) {
-	// Use reflection to iterate through custom properties
-	roleValue := reflect.ValueOf(roleState).Elem()
-	itemValue := reflect.ValueOf(item).Elem()
-
-	for i := 1; i <= 60; i++ {
-		// Get the field from API response
-		itemFieldName := fmt.Sprintf("CustomProperty%d", i)
-		itemField := itemValue.FieldByName(itemFieldName)
-
-		if !itemField.IsValid() {
-			continue
-		}
-
-		// Get the field from role state
-		roleFieldName := fmt.Sprintf("CustomProperty%d", i)
-		roleField := roleValue.FieldByName(roleFieldName)
-
-		if !roleField.IsValid() || !roleField.CanSet() {
-			continue
-		}
-
-		// Get the string pointer from the API response with safe type assertion
-		stringPtr, ok := itemField.Interface().(*string)
-		if !ok {
-			continue // Skip if not a *string
+	for index, value := range values {
+		if value == "" {
+			delete(values, index)
 		}
-
-		// Use util.SafeString to convert to types.String and set on role state
-		safeString := util.SafeString(stringPtr)
-		roleField.Set(reflect.ValueOf(safeString))
 	}
+	SetCustomPropertyFields(areq, values)
+	return nil
 }
 
 // ParameterMapping defines the mapping between state field and API setter method
@@ -293,36 +178,36 @@ func (d *RolesDataSource) setRequestParametersFromState(state *RolesDataSourceMo
 }
 
 type RolesDataSourceModel struct {
-	Roledetails     []Role       `tfsdk:"roledetails"`
-	Authenticate    types.Bool   `tfsdk:"authenticate"`
-	DisplayCount    types.Int64  `tfsdk:"display_count"`
-	ErrorCode       types.String `tfsdk:"error_code"`
-	TotalCount      types.Int64  `tfsdk:"total_count"`
-	Message         types.String `tfsdk:"message"`
-	RequestedObject types.String `tfsdk:"requested_object"`
-	Username        types.String `tfsdk:"username"`
-	RoleType        types.String `tfsdk:"role_type"`
-	Requestable     types.String `tfsdk:"requestable"`
-	Status          types.String `tfsdk:"status"`
-	RoleName        types.String `tfsdk:"role_name"`
-	Description     types.String `tfsdk:"description"`
-	DisplayName     types.String `tfsdk:"display_name"`
-	Glossary        types.String `tfsdk:"glossary"`
-	MiningInstance  types.String `tfsdk:"mining_instance"`
-	Risk            types.String `tfsdk:"risk"`
-	UpdateUser      types.String `tfsdk:"update_user"`
-	SystemId        types.String `tfsdk:"system_id"`
-	SoxCritical     types.String `tfsdk:"sox_critical"`
-	SysCritical     types.String `tfsdk:"sys_critical"`
-	Level           types.String `tfsdk:"level"`
-	Privileged      types.String `tfsdk:"privileged"`
-	Confidentiality types.String `tfsdk:"confidentiality"`
-	Max             types.String `tfsdk:"max"`
-	Offset          types.String `tfsdk:"offset"`
-	RoleQuery       types.String `tfsdk:"role_query"`
-	HideBlankValues types.String `tfsdk:"hide_blank_values"`
-	// Embed the reusable custom properties model
-	CustomPropertiesModel
+	Roledetails      []Role       `tfsdk:"roledetails"`
+	Authenticate     types.Bool   `tfsdk:"authenticate"`
+	DisplayCount     types.Int64  `tfsdk:"display_count"`
+	ErrorCode        types.String `tfsdk:"error_code"`
+	TotalCount       types.Int64  `tfsdk:"total_count"`
+	Message          types.String `tfsdk:"message"`
+	RequestedObject  types.String `tfsdk:"requested_object"`
+	Username         types.String `tfsdk:"username"`
+	RoleType         types.String `tfsdk:"role_type"`
+	Requestable      types.String `tfsdk:"requestable"`
+	Status           types.String `tfsdk:"status"`
+	RoleName         types.String `tfsdk:"role_name"`
+	Description      types.String `tfsdk:"description"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	Glossary         types.String `tfsdk:"glossary"`
+	MiningInstance   types.String `tfsdk:"mining_instance"`
+	Risk             types.String `tfsdk:"risk"`
+	UpdateUser       types.String `tfsdk:"update_user"`
+	SystemId         types.String `tfsdk:"system_id"`
+	SoxCritical      types.String `tfsdk:"sox_critical"`
+	SysCritical      types.String `tfsdk:"sys_critical"`
+	Level            types.String `tfsdk:"level"`
+	Privileged       types.String `tfsdk:"privileged"`
+	Confidentiality  types.String `tfsdk:"confidentiality"`
+	Max              types.String `tfsdk:"max"`
+	Offset           types.String `tfsdk:"offset"`
+	RoleQuery        types.String `tfsdk:"role_query"`
+	HideBlankValues  types.String `tfsdk:"hide_blank_values"`
+	CustomProperties types.Map    `tfsdk:"custom_properties"`
+	FetchAll         types.Bool   `tfsdk:"fetch_all"`
 }
 
 type RoleOwner struct {
@@ -367,8 +252,7 @@ type Role struct {
 	Owners                   []RoleOwner         `tfsdk:"owners"`
 	UserDetails              []UserDetail        `tfsdk:"user_details"`
 	EntitlementDetails       []EntitlementDetail `tfsdk:"entitlement_details"`
-	// Embed the reusable custom properties model
-	CustomPropertiesModel
+	CustomProperties         types.Map           `tfsdk:"custom_properties"`
 }
 
 func RoleResultSchema() map[string]schema.Attribute {
@@ -518,14 +402,7 @@ func RoleResultSchema() map[string]schema.Attribute {
 			},
 		},
 	}
-	// Add custom properties 1-60
-	for i := 1; i <= 60; i++ {
-		key := fmt.Sprintf("custom_property%d", i)
-		attrs[key] = schema.StringAttribute{
-			Computed:    true,
-			Description: fmt.Sprintf("Custom property %d value for the role.", i),
-		}
-	}
+	attrs["custom_properties"] = CustomPropertiesDataSourceSchema()
 
 	return attrs
 }
@@ -626,6 +503,13 @@ func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Optional:    true,
 				Description: "Offset for pagination",
 			},
+			"fetch_all": schema.BoolAttribute{
+				Optional:    true,
+				Description: FetchAllDescription("roles", "total_count", "display_count"),
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("offset")),
+				},
+			},
 			"message": schema.StringAttribute{
 				Computed:    true,
 				Description: "API response message",
@@ -656,13 +540,13 @@ func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		},
 	}
 
-	// Add custom property filter attributes
-	for i := 1; i <= 60; i++ {
-		key := fmt.Sprintf("custom_property%d", i)
-		resp.Schema.Attributes[key] = schema.StringAttribute{
-			Optional:    true,
-			Description: fmt.Sprintf("Custom property %d for additional metadata", i),
-		}
+	resp.Schema.Attributes["custom_properties"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Filter roles by custom property values, keyed by the custom property number from 1 to 60, for example { \"3\" = \"Finance\" }.",
+		Validators: []validator.Map{
+			validators.CustomPropertyKeys(roleCustomPropertyCount),
+		},
 	}
 }
 func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -707,7 +591,13 @@ func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Execute API call to get roles details
-	rolesResponse, err := d.ReadRolesDetails(ctx, &state)
+	var rolesResponse *openapi.GetRolesResponse
+	var err error
+	if fetchAllEnabled(state.FetchAll) {
+		rolesResponse, err = d.ReadAllRolesDetails(ctx, &state)
+	} else {
+		rolesResponse, err = d.ReadRolesDetails(ctx, &state)
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to read roles details", map[string]interface{}{"error": err.Error()})
 		resp.Diagnostics.AddError(
@@ -764,8 +654,10 @@ func (d *RolesDataSource) ReadRolesDetails(ctx context.Context, state *RolesData
 		return nil, fmt.Errorf("failed to set request parameters: %v", err)
 	}
 
-	// Add custom properties 1-60 using reflection-based helper
-	d.setCustomPropertiesOnRequest(state, &areq)
+	// Add the custom properties filter
+	if err := d.setCustomPropertiesOnRequest(ctx, state, &areq); err != nil {
+		return nil, err
+	}
 
 	tflog.Debug(ctx, "Executing roles API request")
 	var rolesResponse *openapi.GetRolesResponse
@@ -807,6 +699,55 @@ func (d *RolesDataSource) ReadRolesDetails(ctx context.Context, state *RolesData
 	return rolesResponse, nil
 }
 
+// ReadAllRolesDetails reads every page of roles matching the filters of state and returns them
+// as a single response
+func (d *RolesDataSource) ReadAllRolesDetails(ctx context.Context, state *RolesDataSourceModel) (*openapi.GetRolesResponse, error) {
+	pageSize, err := fetchAllPageSize(state.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pages use the max and offset fields of GetRolesRequest in the OpenAPI description
+	var firstResp *openapi.GetRolesResponse
+	roles, total, err := FetchAllPages(ctx, pageSize, FetchAllParallelism,
+		func(ctx context.Context, offset, max int) (Page[openapi.GetRoleDetailsResponse], error) {
+			pageState := *state
+			pageState.Offset = types.StringValue(strconv.Itoa(offset))
+			pageState.Max = types.StringValue(strconv.Itoa(max))
+			resp, err := d.ReadRolesDetails(ctx, &pageState)
+			if err == nil {
+				err = d.ValidateRolesResponse(resp)
+			}
+			if err != nil {
+				return Page[openapi.GetRoleDetailsResponse]{}, err
+			}
+			if offset == 0 {
+				firstResp = resp
+			}
+			total := -1
+			if resp.TotalCount != nil {
+				total = int(*resp.TotalCount)
+			}
+			return Page[openapi.GetRoleDetailsResponse]{Items: resp.Roledetails, Total: total}, nil
+		},
+		func(r openapi.GetRoleDetailsResponse) string { return util.SafeDeref(r.RoleName) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	allResp := openapi.GetRolesResponse{Roledetails: roles}
+	if firstResp != nil {
+		allResp.Msg = firstResp.Msg
+		allResp.ErrorCode = firstResp.ErrorCode
+	}
+	allResp.SetDisplayCount(int32(len(roles)))
+	allResp.SetTotalCount(int32(total))
+
+	tflog.Debug(ctx, "Read all role pages", map[string]interface{}{"count": len(roles), "total": total})
+	return &allResp, nil
+}
+
 // ValidateRolesResponse validates that the API response contains valid roles data
 // Returns standardized error if validation fails
 func (d *RolesDataSource) ValidateRolesResponse(rolesResponse *openapi.GetRolesResponse) error {
@@ -881,8 +822,8 @@ func (d *RolesDataSource) ProcessSingleRole(item openapi.GetRoleDetailsResponse)
 		LastReviewedBy:           util.SafeStringDatasource(item.LastReviewedBy),
 	}
 
-	// Map custom properties using reflection-based helper
-	d.mapCustomPropertiesFromResponse(&roleState, &item)
+	// Map custom properties that have a value
+	roleState.CustomProperties, _ = CustomPropertiesDataSourceValue(context.Background(), CustomPropertyFields(&item, roleCustomPropertyCount))
 
 	// Process version field with special handling
 	d.ProcessRoleVersion(&roleState, &item)
@@ -900,22 +841,7 @@ func (d *RolesDataSource) ProcessSingleRole(item openapi.GetRoleDetailsResponse)
 
 // ProcessRoleVersion handles the complex version field processing
 func (d *RolesDataSource) ProcessRoleVersion(roleState *Role, item *openapi.GetRoleDetailsResponse) {
-	if item.Version != nil {
-		if item.Version.Int32 != nil {
-			roleState.Version = types.Int64Value(int64(*item.Version.Int32))
-		} else if item.Version.String != nil {
-			val, err := strconv.ParseInt(*item.Version.String, 10, 64)
-			if err == nil {
-				roleState.Version = types.Int64Value(val)
-			} else {
-				roleState.Version = types.Int64Null()
-			}
-		} else {
-			roleState.Version = types.Int64Null()
-		}
-	} else {
-		roleState.Version = types.Int64Null()
-	}
+	roleState.Version = RoleVersionValue(item.Version)
 }
 
 // ProcessRoleAttributes handles role attributes mapping (sox critical, sys critical, etc.)
@@ -1039,32 +965,10 @@ func (d *RolesDataSource) HandleConditionalRequestAttributes(ctx context.Context
 	d.HandleConditionalCustomProperties(&config, state)
 }
 
-// HandleConditionalCustomProperties handles custom properties conditionally
+// HandleConditionalCustomProperties nulls the custom_properties filter in state when it is not configured
 func (d *RolesDataSource) HandleConditionalCustomProperties(config *RolesDataSourceModel, state *RolesDataSourceModel) {
-	// Use reflection to handle custom properties dynamically
-	configValue := reflect.ValueOf(config).Elem()
-	stateValue := reflect.ValueOf(state).Elem()
-
-	nulledCustomProps := 0
-	for i := 1; i <= 60; i++ {
-		fieldName := fmt.Sprintf("CustomProperty%d", i)
-
-		configField := configValue.FieldByName(fieldName)
-		stateField := stateValue.FieldByName(fieldName)
-
-		if configField.IsValid() && stateField.IsValid() && stateField.CanSet() {
-			configTfString := configField.Interface().(types.String)
-			if configTfString.IsNull() {
-				stateField.Set(reflect.ValueOf(types.StringNull()))
-				nulledCustomProps++
-			}
-		}
-	}
-
-	if nulledCustomProps > 0 {
-		tflog.Debug(context.Background(), "Custom properties conditional state management completed", map[string]interface{}{
-			"custom_properties_nulled": nulledCustomProps,
-		})
+	if config.CustomProperties.IsNull() {
+		state.CustomProperties = types.MapNull(types.StringType)
 	}
 }
 
@@ -13,7 +13,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
+	"strconv"
 	"strings"
 
 	"terraform-provider-Saviynt/internal/client"
@@ -26,6 +26,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -37,11 +40,17 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RolesResource{}
 var _ resource.ResourceWithImportState = &RolesResource{}
+var _ resource.ResourceWithValidateConfig = &RolesResource{}
+var _ resource.ResourceWithUpgradeState = &RolesResource{}
+
+// roleCustomPropertyCount is the number of custom properties of a role
+const roleCustomPropertyCount = 60
 
 // Operation constants for update types
 const (
 	UpdateTypeAdd    = "ADD"
 	UpdateTypeRemove = "REMOVE"
+	UpdateTypeUpdate = "UPDATE"
 )
 
 // ChangeProcessor is a generic processor for handling add/remove operations on collections
@@ -123,68 +132,10 @@ type RolesResourceModel struct {
 	RoleName         types.String `tfsdk:"role_name"`
 	Requestor        types.String `tfsdk:"requestor"`
 	Owners           types.Set    `tfsdk:"owners"`
-	CustomProperty1  types.String `tfsdk:"custom_property1"`
-	CustomProperty2  types.String `tfsdk:"custom_property2"`
-	CustomProperty3  types.String `tfsdk:"custom_property3"`
-	CustomProperty4  types.String `tfsdk:"custom_property4"`
-	CustomProperty5  types.String `tfsdk:"custom_property5"`
-	CustomProperty6  types.String `tfsdk:"custom_property6"`
-	CustomProperty7  types.String `tfsdk:"custom_property7"`
-	CustomProperty8  types.String `tfsdk:"custom_property8"`
-	CustomProperty9  types.String `tfsdk:"custom_property9"`
-	CustomProperty10 types.String `tfsdk:"custom_property10"`
-	CustomProperty11 types.String `tfsdk:"custom_property11"`
-	CustomProperty12 types.String `tfsdk:"custom_property12"`
-	CustomProperty13 types.String `tfsdk:"custom_property13"`
-	CustomProperty14 types.String `tfsdk:"custom_property14"`
-	CustomProperty15 types.String `tfsdk:"custom_property15"`
-	CustomProperty16 types.String `tfsdk:"custom_property16"`
-	CustomProperty17 types.String `tfsdk:"custom_property17"`
-	CustomProperty18 types.String `tfsdk:"custom_property18"`
-	CustomProperty19 types.String `tfsdk:"custom_property19"`
-	CustomProperty20 types.String `tfsdk:"custom_property20"`
-	CustomProperty21 types.String `tfsdk:"custom_property21"`
-	CustomProperty22 types.String `tfsdk:"custom_property22"`
-	CustomProperty23 types.String `tfsdk:"custom_property23"`
-	CustomProperty24 types.String `tfsdk:"custom_property24"`
-	CustomProperty25 types.String `tfsdk:"custom_property25"`
-	CustomProperty26 types.String `tfsdk:"custom_property26"`
-	CustomProperty27 types.String `tfsdk:"custom_property27"`
-	CustomProperty28 types.String `tfsdk:"custom_property28"`
-	CustomProperty29 types.String `tfsdk:"custom_property29"`
-	CustomProperty30 types.String `tfsdk:"custom_property30"`
-	CustomProperty31 types.String `tfsdk:"custom_property31"`
-	CustomProperty32 types.String `tfsdk:"custom_property32"`
-	CustomProperty33 types.String `tfsdk:"custom_property33"`
-	CustomProperty34 types.String `tfsdk:"custom_property34"`
-	CustomProperty35 types.String `tfsdk:"custom_property35"`
-	CustomProperty36 types.String `tfsdk:"custom_property36"`
-	CustomProperty37 types.String `tfsdk:"custom_property37"`
-	CustomProperty38 types.String `tfsdk:"custom_property38"`
-	CustomProperty39 types.String `tfsdk:"custom_property39"`
-	CustomProperty40 types.String `tfsdk:"custom_property40"`
-	CustomProperty41 types.String `tfsdk:"custom_property41"`
-	CustomProperty42 types.String `tfsdk:"custom_property42"`
-	CustomProperty43 types.String `tfsdk:"custom_property43"`
-	CustomProperty44 types.String `tfsdk:"custom_property44"`
-	CustomProperty45 types.String `tfsdk:"custom_property45"`
-	CustomProperty46 types.String `tfsdk:"custom_property46"`
-	CustomProperty47 types.String `tfsdk:"custom_property47"`
-	CustomProperty48 types.String `tfsdk:"custom_property48"`
-	CustomProperty49 types.String `tfsdk:"custom_property49"`
-	CustomProperty50 types.String `tfsdk:"custom_property50"`
-	CustomProperty51 types.String `tfsdk:"custom_property51"`
-	CustomProperty52 types.String `tfsdk:"custom_property52"`
-	CustomProperty53 types.String `tfsdk:"custom_property53"`
-	CustomProperty54 types.String `tfsdk:"custom_property54"`
-	CustomProperty55 types.String `tfsdk:"custom_property55"`
-	CustomProperty56 types.String `tfsdk:"custom_property56"`
-	CustomProperty57 types.String `tfsdk:"custom_property57"`
-	CustomProperty58 types.String `tfsdk:"custom_property58"`
-	CustomProperty59 types.String `tfsdk:"custom_property59"`
-	CustomProperty60 types.String `tfsdk:"custom_property60"`
+	CustomProperties types.Map    `tfsdk:"custom_properties"`
 	EndpointName     types.String `tfsdk:"endpoint_name"`
 	DefaultTimeFrame types.String `tfsdk:"default_time_frame"`
+	MaxTimeFrame     types.String `tfsdk:"max_time_frame"`
 	Description      types.String `tfsdk:"description"`
 	DisplayName      types.String `tfsdk:"display_name"`
 	Glossary         types.String `tfsdk:"glossary"`
@@ -200,6 +151,14 @@ type RolesResourceModel struct {
 	Entitlements     types.Set    `tfsdk:"entitlements"`
 	ChildRoles       types.Set    `tfsdk:"child_roles"`
 	Users            types.Set    `tfsdk:"users"`
+	ManageUsers      types.Bool   `tfsdk:"manage_users"`
+	DeletionPolicy   types.String `tfsdk:"deletion_policy"`
+
+	// Entitlements attached by saviynt_role_entitlement are left alone when this is false
+	ManageEntitlements types.Bool `tfsdk:"manage_entitlements"`
+
+	// Firefighter IDs are mapped in Saviynt and only read
+	FirefighterAccountKeys types.List `tfsdk:"firefighter_account_keys"`
 }
 
 // Entitlement defines the structure for entitlements associated with a role.
@@ -257,6 +216,7 @@ func (r *RolesResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *RolesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: util.RoleDescription,
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -298,6 +258,11 @@ func (r *RolesResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"max_time_frame": schema.StringAttribute{
+				Description: "Specify the maximum time frame (in hours) for which access to the role can be requested. Used by FIREFIGHTER roles to limit emergency access. Must not be lower than default_time_frame.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"description": schema.StringAttribute{
 				Description: "Displays the description of the role. You can change the description, as required. This helps users understand what the role is for and what permissions it grants.",
 				Optional:    true,
@@ -390,16 +355,46 @@ func (r *RolesResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"manage_users": schema.BoolAttribute{
+				Description: "Whether this resource manages the users assigned to the role. Set to false when membership is managed with saviynt_role_membership or saviynt_role_members; users are then neither read, imported nor changed by this resource and 'users' must not be set. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"manage_entitlements": schema.BoolAttribute{
+				Description: "Whether this resource manages the entitlements of the role. Set to false when entitlements are attached with saviynt_role_entitlement; entitlements are then neither read, imported nor changed by this resource and 'entitlements' must not be set. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"firefighter_account_keys": schema.ListAttribute{
+				Description: "Keys of the firefighter ID accounts mapped to the role. Only set for FIREFIGHTER roles. Read-only: the roles API has no operation to map firefighter IDs, so map them in the Saviynt UI.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
-	// Add custom properties to the schema.
-	for i := 1; i <= 60; i++ {
-		key := fmt.Sprintf("custom_property%d", i)
-		resp.Schema.Attributes[key] = schema.StringAttribute{
-			Optional:    true,
-			Computed:    true,
-			Description: fmt.Sprintf("Custom Property %d.", i),
-		}
+	// Roles have no configured custom property labels, so keys have to be property numbers
+	customProperties := CustomPropertiesSchema(roleCustomPropertyCount)
+	customProperties.Description += " Labels cannot be used as keys because Saviynt configures no custom property labels for roles."
+	resp.Schema.Attributes["custom_properties"] = customProperties
+
+	// The update enterprise role API documents no status field, so the role stays active
+	deletionPolicy := DeletionPolicySchema("removes the users and entitlements in state from the role")
+	deletionPolicy.Description += " 'deactivate' leaves the role status unchanged; set the role inactive in the Saviynt UI."
+	resp.Schema.Attributes["deletion_policy"] = deletionPolicy
+}
+
+// UpgradeState moves the custom_property1 to custom_property60 attributes of schema version 0 into
+// custom_properties.
+func (r *RolesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return map[int64]resource.StateUpgrader{
+		0: CustomPropertiesStateUpgrader(schemaResp.Schema, "custom_property%d", roleCustomPropertyCount),
 	}
 }
 
@@ -455,260 +450,12 @@ func (r *RolesResource) SetRequestor(requestor string) {
 	r.requestor = requestor
 }
 
-// buildCustomPropertiesForCreate sets all custom properties for the create request
-func (r *RolesResource) buildCustomPropertiesForCreate(plan *RolesResourceModel, createReq *openapi.CreateEnterpriseRoleRequest) {
-	createReq.Customproperty1 = util.StringPointerOrEmpty(plan.CustomProperty1)
-	createReq.Customproperty2 = util.StringPointerOrEmpty(plan.CustomProperty2)
-	createReq.Customproperty3 = util.StringPointerOrEmpty(plan.CustomProperty3)
-	createReq.Customproperty4 = util.StringPointerOrEmpty(plan.CustomProperty4)
-	createReq.Customproperty5 = util.StringPointerOrEmpty(plan.CustomProperty5)
-	createReq.Customproperty6 = util.StringPointerOrEmpty(plan.CustomProperty6)
-	createReq.Customproperty7 = util.StringPointerOrEmpty(plan.CustomProperty7)
-	createReq.Customproperty8 = util.StringPointerOrEmpty(plan.CustomProperty8)
-	createReq.Customproperty9 = util.StringPointerOrEmpty(plan.CustomProperty9)
-	createReq.Customproperty10 = util.StringPointerOrEmpty(plan.CustomProperty10)
-	createReq.Customproperty11 = util.StringPointerOrEmpty(plan.CustomProperty11)
-	createReq.Customproperty12 = util.StringPointerOrEmpty(plan.CustomProperty12)
-	createReq.Customproperty13 = util.StringPointerOrEmpty(plan.CustomProperty13)
-	createReq.Customproperty14 = util.StringPointerOrEmpty(plan.CustomProperty14)
-	createReq.Customproperty15 = util.StringPointerOrEmpty(plan.CustomProperty15)
-	createReq.Customproperty16 = util.StringPointerOrEmpty(plan.CustomProperty16)
-	createReq.Customproperty17 = util.StringPointerOrEmpty(plan.CustomProperty17)
-	createReq.Customproperty18 = util.StringPointerOrEmpty(plan.CustomProperty18)
-	createReq.Customproperty19 = util.StringPointerOrEmpty(plan.CustomProperty19)
-	createReq.Customproperty20 = util.StringPointerOrEmpty(plan.CustomProperty20)
-	createReq.Customproperty21 = util.StringPointerOrEmpty(plan.CustomProperty21)
-	createReq.Customproperty22 = util.StringPointerOrEmpty(plan.CustomProperty22)
-	createReq.Customproperty23 = util.StringPointerOrEmpty(plan.CustomProperty23)
-	createReq.Customproperty24 = util.StringPointerOrEmpty(plan.CustomProperty24)
-	createReq.Customproperty25 = util.StringPointerOrEmpty(plan.CustomProperty25)
-	createReq.Customproperty26 = util.StringPointerOrEmpty(plan.CustomProperty26)
-	createReq.Customproperty27 = util.StringPointerOrEmpty(plan.CustomProperty27)
-	createReq.Customproperty28 = util.StringPointerOrEmpty(plan.CustomProperty28)
-	createReq.Customproperty29 = util.StringPointerOrEmpty(plan.CustomProperty29)
-	createReq.Customproperty30 = util.StringPointerOrEmpty(plan.CustomProperty30)
-	createReq.Customproperty31 = util.StringPointerOrEmpty(plan.CustomProperty31)
-	createReq.Customproperty32 = util.StringPointerOrEmpty(plan.CustomProperty32)
-	createReq.Customproperty33 = util.StringPointerOrEmpty(plan.CustomProperty33)
-	createReq.Customproperty34 = util.StringPointerOrEmpty(plan.CustomProperty34)
-	createReq.Customproperty35 = util.StringPointerOrEmpty(plan.CustomProperty35)
-	createReq.Customproperty36 = util.StringPointerOrEmpty(plan.CustomProperty36)
-	createReq.Customproperty37 = util.StringPointerOrEmpty(plan.CustomProperty37)
-	createReq.Customproperty38 = util.StringPointerOrEmpty(plan.CustomProperty38)
-	createReq.Customproperty39 = util.StringPointerOrEmpty(plan.CustomProperty39)
-	createReq.Customproperty40 = util.StringPointerOrEmpty(plan.CustomProperty40)
-	createReq.Customproperty41 = util.StringPointerOrEmpty(plan.CustomProperty41)
-	createReq.Customproperty42 = util.StringPointerOrEmpty(plan.CustomProperty42)
-	createReq.Customproperty43 = util.StringPointerOrEmpty(plan.CustomProperty43)
-	createReq.Customproperty44 = util.StringPointerOrEmpty(plan.CustomProperty44)
-	createReq.Customproperty45 = util.StringPointerOrEmpty(plan.CustomProperty45)
-	createReq.Customproperty46 = util.StringPointerOrEmpty(plan.CustomProperty46)
-	createReq.Customproperty47 = util.StringPointerOrEmpty(plan.CustomProperty47)
-	createReq.Customproperty48 = util.StringPointerOrEmpty(plan.CustomProperty48)
-	createReq.Customproperty49 = util.StringPointerOrEmpty(plan.CustomProperty49)
-	createReq.Customproperty50 = util.StringPointerOrEmpty(plan.CustomProperty50)
-	createReq.Customproperty51 = util.StringPointerOrEmpty(plan.CustomProperty51)
-	createReq.Customproperty52 = util.StringPointerOrEmpty(plan.CustomProperty52)
-	createReq.Customproperty53 = util.StringPointerOrEmpty(plan.CustomProperty53)
-	createReq.Customproperty54 = util.StringPointerOrEmpty(plan.CustomProperty54)
-	createReq.Customproperty55 = util.StringPointerOrEmpty(plan.CustomProperty55)
-	createReq.Customproperty56 = util.StringPointerOrEmpty(plan.CustomProperty56)
-	createReq.Customproperty57 = util.StringPointerOrEmpty(plan.CustomProperty57)
-	createReq.Customproperty58 = util.StringPointerOrEmpty(plan.CustomProperty58)
-	createReq.Customproperty59 = util.StringPointerOrEmpty(plan.CustomProperty59)
-	createReq.Customproperty60 = util.StringPointerOrEmpty(plan.CustomProperty60)
-}
-
-// setCustomPropertiesInPlan sets all custom properties in the plan from their current values
-func (r *RolesResource) setCustomPropertiesInPlan(plan *RolesResourceModel) {
-	plan.CustomProperty1 = util.SafeString(plan.CustomProperty1.ValueStringPointer())
-	plan.CustomProperty2 = util.SafeString(plan.CustomProperty2.ValueStringPointer())
-	plan.CustomProperty3 = util.SafeString(plan.CustomProperty3.ValueStringPointer())
-	plan.CustomProperty4 = util.SafeString(plan.CustomProperty4.ValueStringPointer())
-	plan.CustomProperty5 = util.SafeString(plan.CustomProperty5.ValueStringPointer())
-	plan.CustomProperty6 = util.SafeString(plan.CustomProperty6.ValueStringPointer())
-	plan.CustomProperty7 = util.SafeString(plan.CustomProperty7.ValueStringPointer())
-	plan.CustomProperty8 = util.SafeString(plan.CustomProperty8.ValueStringPointer())
-	plan.CustomProperty9 = util.SafeString(plan.CustomProperty9.ValueStringPointer())
-	plan.CustomProperty10 = util.SafeString(plan.CustomProperty10.ValueStringPointer())
-	plan.CustomProperty11 = util.SafeString(plan.CustomProperty11.ValueStringPointer())
-	plan.CustomProperty12 = util.SafeString(plan.CustomProperty12.ValueStringPointer())
-	plan.CustomProperty13 = util.SafeString(plan.CustomProperty13.ValueStringPointer())
-	plan.CustomProperty14 = util.SafeString(plan.CustomProperty14.ValueStringPointer())
-	plan.CustomProperty15 = util.SafeString(plan.CustomProperty15.ValueStringPointer())
-	plan.CustomProperty16 = util.SafeString(plan.CustomProperty16.ValueStringPointer())
-	plan.CustomProperty17 = util.SafeString(plan.CustomProperty17.ValueStringPointer())
-	plan.CustomProperty18 = util.SafeString(plan.CustomProperty18.ValueStringPointer())
-	plan.CustomProperty19 = util.SafeString(plan.CustomProperty19.ValueStringPointer())
-	plan.CustomProperty20 = util.SafeString(plan.CustomProperty20.ValueStringPointer())
-	plan.CustomProperty21 = util.SafeString(plan.CustomProperty21.ValueStringPointer())
-	plan.CustomProperty22 = util.SafeString(plan.CustomProperty22.ValueStringPointer())
-	plan.CustomProperty23 = util.SafeString(plan.CustomProperty23.ValueStringPointer())
-	plan.CustomProperty24 = util.SafeString(plan.CustomProperty24.ValueStringPointer())
-	plan.CustomProperty25 = util.SafeString(plan.CustomProperty25.ValueStringPointer())
-	plan.CustomProperty26 = util.SafeString(plan.CustomProperty26.ValueStringPointer())
-	plan.CustomProperty27 = util.SafeString(plan.CustomProperty27.ValueStringPointer())
-	plan.CustomProperty28 = util.SafeString(plan.CustomProperty28.ValueStringPointer())
-	plan.CustomProperty29 = util.SafeString(plan.CustomProperty29.ValueStringPointer())
-	plan.CustomProperty30 = util.SafeString(plan.CustomProperty30.ValueStringPointer())
-	plan.CustomProperty31 = util.SafeString(plan.CustomProperty31.ValueStringPointer())
-	plan.CustomProperty32 = util.SafeString(plan.CustomProperty32.ValueStringPointer())
-	plan.CustomProperty33 = util.SafeString(plan.CustomProperty33.ValueStringPointer())
-	plan.CustomProperty34 = util.SafeString(plan.CustomProperty34.ValueStringPointer())
-	plan.CustomProperty35 = util.SafeString(plan.CustomProperty35.ValueStringPointer())
-	plan.CustomProperty36 = util.SafeString(plan.CustomProperty36.ValueStringPointer())
-	plan.CustomProperty37 = util.SafeString(plan.CustomProperty37.ValueStringPointer())
-	plan.CustomProperty38 = util.SafeString(plan.CustomProperty38.ValueStringPointer())
-	plan.CustomProperty39 = util.SafeString(plan.CustomProperty39.ValueStringPointer())
-	plan.CustomProperty40 = util.SafeString(plan.CustomProperty40.ValueStringPointer())
-	plan.CustomProperty41 = util.SafeString(plan.CustomProperty41.ValueStringPointer())
-	plan.CustomProperty42 = util.SafeString(plan.CustomProperty42.ValueStringPointer())
-	plan.CustomProperty43 = util.SafeString(plan.CustomProperty43.ValueStringPointer())
-	plan.CustomProperty44 = util.SafeString(plan.CustomProperty44.ValueStringPointer())
-	plan.CustomProperty45 = util.SafeString(plan.CustomProperty45.ValueStringPointer())
-	plan.CustomProperty46 = util.SafeString(plan.CustomProperty46.ValueStringPointer())
-	plan.CustomProperty47 = util.SafeString(plan.CustomProperty47.ValueStringPointer())
-	plan.CustomProperty48 = util.SafeString(plan.CustomProperty48.ValueStringPointer())
-	plan.CustomProperty49 = util.SafeString(plan.CustomProperty49.ValueStringPointer())
-	plan.CustomProperty50 = util.SafeString(plan.CustomProperty50.ValueStringPointer())
-	plan.CustomProperty51 = util.SafeString(plan.CustomProperty51.ValueStringPointer())
-	plan.CustomProperty52 = util.SafeString(plan.CustomProperty52.ValueStringPointer())
-	plan.CustomProperty53 = util.SafeString(plan.CustomProperty53.ValueStringPointer())
-	plan.CustomProperty54 = util.SafeString(plan.CustomProperty54.ValueStringPointer())
-	plan.CustomProperty55 = util.SafeString(plan.CustomProperty55.ValueStringPointer())
-	plan.CustomProperty56 = util.SafeString(plan.CustomProperty56.ValueStringPointer())
-	plan.CustomProperty57 = util.SafeString(plan.CustomProperty57.ValueStringPointer())
-	plan.CustomProperty58 = util.SafeString(plan.CustomProperty58.ValueStringPointer())
-	plan.CustomProperty59 = util.SafeString(plan.CustomProperty59.ValueStringPointer())
-	plan.CustomProperty60 = util.SafeString(plan.CustomProperty60.ValueStringPointer())
-}
-
-// setCustomPropertiesFromResponse sets all custom properties from API response to the model
-func (r *RolesResource) setCustomPropertiesFromResponse(model *RolesResourceModel, roleDetails *openapi.GetRoleDetailsResponse) {
-	model.CustomProperty1 = util.SafeString(roleDetails.CustomProperty1)
-	model.CustomProperty2 = util.SafeString(roleDetails.CustomProperty2)
-	model.CustomProperty3 = util.SafeString(roleDetails.CustomProperty3)
-	model.CustomProperty4 = util.SafeString(roleDetails.CustomProperty4)
-	model.CustomProperty5 = util.SafeString(roleDetails.CustomProperty5)
-	model.CustomProperty6 = util.SafeString(roleDetails.CustomProperty6)
-	model.CustomProperty7 = util.SafeString(roleDetails.CustomProperty7)
-	model.CustomProperty8 = util.SafeString(roleDetails.CustomProperty8)
-	model.CustomProperty9 = util.SafeString(roleDetails.CustomProperty9)
-	model.CustomProperty10 = util.SafeString(roleDetails.CustomProperty10)
-	model.CustomProperty11 = util.SafeString(roleDetails.CustomProperty11)
-	model.CustomProperty12 = util.SafeString(roleDetails.CustomProperty12)
-	model.CustomProperty13 = util.SafeString(roleDetails.CustomProperty13)
-	model.CustomProperty14 = util.SafeString(roleDetails.CustomProperty14)
-	model.CustomProperty15 = util.SafeString(roleDetails.CustomProperty15)
-	model.CustomProperty16 = util.SafeString(roleDetails.CustomProperty16)
-	model.CustomProperty17 = util.SafeString(roleDetails.CustomProperty17)
-	model.CustomProperty18 = util.SafeString(roleDetails.CustomProperty18)
-	model.CustomProperty19 = util.SafeString(roleDetails.CustomProperty19)
-	model.CustomProperty20 = util.SafeString(roleDetails.CustomProperty20)
-	model.CustomProperty21 = util.SafeString(roleDetails.CustomProperty21)
-	model.CustomProperty22 = util.SafeString(roleDetails.CustomProperty22)
-	model.CustomProperty23 = util.SafeString(roleDetails.CustomProperty23)
-	model.CustomProperty24 = util.SafeString(roleDetails.CustomProperty24)
-	model.CustomProperty25 = util.SafeString(roleDetails.CustomProperty25)
-	model.CustomProperty26 = util.SafeString(roleDetails.CustomProperty26)
-	model.CustomProperty27 = util.SafeString(roleDetails.CustomProperty27)
-	model.CustomProperty28 = util.SafeString(roleDetails.CustomProperty28)
-	model.CustomProperty29 = util.SafeString(roleDetails.CustomProperty29)
-	model.CustomProperty30 = util.SafeString(roleDetails.CustomProperty30)
-	model.CustomProperty31 = util.SafeString(roleDetails.CustomProperty31)
-	model.CustomProperty32 = util.SafeString(roleDetails.CustomProperty32)
-	model.CustomProperty33 = util.SafeString(roleDetails.CustomProperty33)
-	model.CustomProperty34 = util.SafeString(roleDetails.CustomProperty34)
-	model.CustomProperty35 = util.SafeString(roleDetails.CustomProperty35)
-	model.CustomProperty36 = util.SafeString(roleDetails.CustomProperty36)
-	model.CustomProperty37 = util.SafeString(roleDetails.CustomProperty37)
-	model.CustomProperty38 = util.SafeString(roleDetails.CustomProperty38)
-	model.CustomProperty39 = util.SafeString(roleDetails.CustomProperty39)
-	model.CustomProperty40 = util.SafeString(roleDetails.CustomProperty40)
-	model.CustomProperty41 = util.SafeString(roleDetails.CustomProperty41)
-	model.CustomProperty42 = util.SafeString(roleDetails.CustomProperty42)
-	model.CustomProperty43 = util.SafeString(roleDetails.CustomProperty43)
-	model.CustomProperty44 = util.SafeString(roleDetails.CustomProperty44)
-	model.CustomProperty45 = util.SafeString(roleDetails.CustomProperty45)
-	model.CustomProperty46 = util.SafeString(roleDetails.CustomProperty46)
-	model.CustomProperty47 = util.SafeString(roleDetails.CustomProperty47)
-	model.CustomProperty48 = util.SafeString(roleDetails.CustomProperty48)
-	model.CustomProperty49 = util.SafeString(roleDetails.CustomProperty49)
-	model.CustomProperty50 = util.SafeString(roleDetails.CustomProperty50)
-	model.CustomProperty51 = util.SafeString(roleDetails.CustomProperty51)
-	model.CustomProperty52 = util.SafeString(roleDetails.CustomProperty52)
-	model.CustomProperty53 = util.SafeString(roleDetails.CustomProperty53)
-	model.CustomProperty54 = util.SafeString(roleDetails.CustomProperty54)
-	model.CustomProperty55 = util.SafeString(roleDetails.CustomProperty55)
-	model.CustomProperty56 = util.SafeString(roleDetails.CustomProperty56)
-	model.CustomProperty57 = util.SafeString(roleDetails.CustomProperty57)
-	model.CustomProperty58 = util.SafeString(roleDetails.CustomProperty58)
-	model.CustomProperty59 = util.SafeString(roleDetails.CustomProperty59)
-	model.CustomProperty60 = util.SafeString(roleDetails.CustomProperty60)
-}
-
-// buildCustomPropertiesForUpdate sets all custom properties for the update request
-func (r *RolesResource) buildCustomPropertiesForUpdate(plan *RolesResourceModel, updateReq *openapi.UpdateEnterpriseRoleRequest) {
-	updateReq.Customproperty1 = util.StringPointerOrEmpty(plan.CustomProperty1)
-	updateReq.Customproperty2 = util.StringPointerOrEmpty(plan.CustomProperty2)
-	updateReq.Customproperty3 = util.StringPointerOrEmpty(plan.CustomProperty3)
-	updateReq.Customproperty4 = util.StringPointerOrEmpty(plan.CustomProperty4)
-	updateReq.Customproperty5 = util.StringPointerOrEmpty(plan.CustomProperty5)
-	updateReq.Customproperty6 = util.StringPointerOrEmpty(plan.CustomProperty6)
-	updateReq.Customproperty7 = util.StringPointerOrEmpty(plan.CustomProperty7)
-	updateReq.Customproperty8 = util.StringPointerOrEmpty(plan.CustomProperty8)
-	updateReq.Customproperty9 = util.StringPointerOrEmpty(plan.CustomProperty9)
-	updateReq.Customproperty10 = util.StringPointerOrEmpty(plan.CustomProperty10)
-	updateReq.Customproperty11 = util.StringPointerOrEmpty(plan.CustomProperty11)
-	updateReq.Customproperty12 = util.StringPointerOrEmpty(plan.CustomProperty12)
-	updateReq.Customproperty13 = util.StringPointerOrEmpty(plan.CustomProperty13)
-	updateReq.Customproperty14 = util.StringPointerOrEmpty(plan.CustomProperty14)
-	updateReq.Customproperty15 = util.StringPointerOrEmpty(plan.CustomProperty15)
-	updateReq.Customproperty16 = util.StringPointerOrEmpty(plan.CustomProperty16)
-	updateReq.Customproperty17 = util.StringPointerOrEmpty(plan.CustomProperty17)
-	updateReq.Customproperty18 = util.StringPointerOrEmpty(plan.CustomProperty18)
-	updateReq.Customproperty19 = util.StringPointerOrEmpty(plan.CustomProperty19)
-	updateReq.Customproperty20 = util.StringPointerOrEmpty(plan.CustomProperty20)
-	updateReq.Customproperty21 = util.StringPointerOrEmpty(plan.CustomProperty21)
-	updateReq.Customproperty22 = util.StringPointerOrEmpty(plan.CustomProperty22)
-	updateReq.Customproperty23 = util.StringPointerOrEmpty(plan.CustomProperty23)
-	updateReq.Customproperty24 = util.StringPointerOrEmpty(plan.CustomProperty24)
-	updateReq.Customproperty25 = util.StringPointerOrEmpty(plan.CustomProperty25)
-	updateReq.Customproperty26 = util.StringPointerOrEmpty(plan.CustomProperty26)
-	updateReq.Customproperty27 = util.StringPointerOrEmpty(plan.CustomProperty27)
-	updateReq.Customproperty28 = util.StringPointerOrEmpty(plan.CustomProperty28)
-	updateReq.Customproperty29 = util.StringPointerOrEmpty(plan.CustomProperty29)
-	updateReq.Customproperty30 = util.StringPointerOrEmpty(plan.CustomProperty30)
-	updateReq.Customproperty31 = util.StringPointerOrEmpty(plan.CustomProperty31)
-	updateReq.Customproperty32 = util.StringPointerOrEmpty(plan.CustomProperty32)
-	updateReq.Customproperty33 = util.StringPointerOrEmpty(plan.CustomProperty33)
-	updateReq.Customproperty34 = util.StringPointerOrEmpty(plan.CustomProperty34)
-	updateReq.Customproperty35 = util.StringPointerOrEmpty(plan.CustomProperty35)
-	updateReq.Customproperty36 = util.StringPointerOrEmpty(plan.CustomProperty36)
-	updateReq.Customproperty37 = util.StringPointerOrEmpty(plan.CustomProperty37)
-	updateReq.Customproperty38 = util.StringPointerOrEmpty(plan.CustomProperty38)
-	updateReq.Customproperty39 = util.StringPointerOrEmpty(plan.CustomProperty39)
-	updateReq.Customproperty40 = util.StringPointerOrEmpty(plan.CustomProperty40)
-	updateReq.Customproperty41 = util.StringPointerOrEmpty(plan.CustomProperty41)
-	updateReq.Customproperty42 = util.StringPointerOrEmpty(plan.CustomProperty42)
-	updateReq.Customproperty43 = util.StringPointerOrEmpty(plan.CustomProperty43)
-	updateReq.Customproperty44 = util.StringPointerOrEmpty(plan.CustomProperty44)
-	updateReq.Customproperty45 = util.StringPointerOrEmpty(plan.CustomProperty45)
-	updateReq.Customproperty46 = util.StringPointerOrEmpty(plan.CustomProperty46)
-	updateReq.Customproperty47 = util.StringPointerOrEmpty(plan.CustomProperty47)
-	updateReq.Customproperty48 = util.StringPointerOrEmpty(plan.CustomProperty48)
-	updateReq.Customproperty49 = util.StringPointerOrEmpty(plan.CustomProperty49)
-	updateReq.Customproperty50 = util.StringPointerOrEmpty(plan.CustomProperty50)
-	updateReq.Customproperty51 = util.StringPointerOrEmpty(plan.CustomProperty51)
-	updateReq.Customproperty52 = util.StringPointerOrEmpty(plan.CustomProperty52)
-	updateReq.Customproperty53 = util.StringPointerOrEmpty(plan.CustomProperty53)
-	updateReq.Customproperty54 = util.StringPointerOrEmpty(plan.CustomProperty54)
-	updateReq.Customproperty55 = util.StringPointerOrEmpty(plan.CustomProperty55)
-	updateReq.Customproperty56 = util.StringPointerOrEmpty(plan.CustomProperty56)
-	updateReq.Customproperty57 = util.StringPointerOrEmpty(plan.CustomProperty57)
-	updateReq.Customproperty58 = util.StringPointerOrEmpty(plan.CustomProperty58)
-	updateReq.Customproperty59 = util.StringPointerOrEmpty(plan.CustomProperty59)
-	updateReq.Customproperty60 = util.StringPointerOrEmpty(plan.CustomProperty60)
+// setCustomPropertiesFromResponse sets the custom properties from the API response to the model. The
+// custom_properties value already in the model decides how keys are spelled.
+func (r *RolesResource) setCustomPropertiesFromResponse(model *RolesResourceModel, roleDetails *openapi.GetRoleDetailsResponse, diagnostics *diag.Diagnostics) {
+	properties, diags := CustomPropertiesValue(context.Background(), CustomPropertyFields(roleDetails, roleCustomPropertyCount), model.CustomProperties, nil)
+	diagnostics.Append(diags...)
+	model.CustomProperties = properties
 }
 
 // RoleResourceMapRoleDetailsToModel maps role details from API response to the Terraform model
@@ -730,6 +477,7 @@ func (r *RolesResource) RoleResourceMapRoleDetailsToModel(model *RolesResourceMo
 	model.Description = util.SafeString(roleDetails.Description)
 	model.DisplayName = util.SafeString(roleDetails.Displayname)
 	model.DefaultTimeFrame = util.SafeString(roleDetails.DefaultTimeFrameHrs)
+	model.MaxTimeFrame = util.SafeString(roleDetails.MaxTimeFrameHrs)
 	model.Glossary = util.SafeString(roleDetails.Glossary)
 
 	// Mapping of soxcritical, syscritical, and other role attributes
@@ -810,7 +558,7 @@ func (r *RolesResource) RoleResourceMapRoleDetailsToModel(model *RolesResourceMo
 		}
 		// If state was null and API has no owners, leave it null
 	}
-	if isImport {
+	if isImport && roleManagesEntitlements(model) {
 		// Import: Use all entitlements from API
 		var entitlementsArr []openapi.GetEntitlementDetailsResponse
 		if roleDetails.EntitlementDetails != nil {
@@ -862,8 +610,8 @@ func (r *RolesResource) RoleResourceMapRoleDetailsToModel(model *RolesResourceMo
 		// If state was null and API has no entitlements, leave it null
 	}
 
-	// Process users: only set if import (state.Users is null)
-	if isImport {
+	// Process users: only set if import (state.Users is null) and users are managed by this resource
+	if isImport && roleManagesUsers(model) {
 		// Import: Use all users from API
 		var userObjects []attr.Value
 		if roleDetails.UserDetails != nil {
@@ -910,7 +658,7 @@ func (r *RolesResource) RoleResourceMapRoleDetailsToModel(model *RolesResourceMo
 	}
 
 	// Set all custom properties using helper function
-	r.setCustomPropertiesFromResponse(model, roleDetails)
+	r.setCustomPropertiesFromResponse(model, roleDetails, diagnostics)
 
 	return nil
 }
@@ -1115,6 +863,7 @@ func (r *RolesResource) CreateRole(ctx context.Context, plan *RolesResourceModel
 		// Optional fields
 		Endpointname:     util.StringPointerOrEmpty(plan.EndpointName),
 		Defaulttimeframe: util.StringPointerOrEmpty(plan.DefaultTimeFrame),
+		Maxtimeframe:     util.StringPointerOrEmpty(plan.MaxTimeFrame),
 		Description:      util.StringPointerOrEmpty(plan.Description),
 		Displayname:      util.StringPointerOrEmpty(plan.DisplayName),
 		Glossary:         util.StringPointerOrEmpty(plan.Glossary),
@@ -1129,8 +878,13 @@ func (r *RolesResource) CreateRole(ctx context.Context, plan *RolesResourceModel
 		Checksod:         util.StringPointerOrEmpty(plan.CheckSod),
 	}
 
-	// Set all custom properties using helper function
-	r.buildCustomPropertiesForCreate(plan, &createReq)
+	// Set the configured custom properties
+	customProperties, propertyDiags := CustomPropertyValues(ctx, plan.CustomProperties, nil)
+	diagnostics.Append(propertyDiags...)
+	if propertyDiags.HasError() {
+		return nil, fmt.Errorf("invalid custom_properties")
+	}
+	SetCustomPropertyFields(&createReq, customProperties)
 
 	// Execute the API call with retry logic
 	tflog.Debug(ctx, "Executing role creation API call")
@@ -1381,6 +1135,7 @@ func (r *RolesResource) UpdateRole(ctx context.Context, plan *RolesResourceModel
 		ChildRoles:       childRoles,
 		Endpointname:     util.StringPointerOrEmpty(plan.EndpointName),
 		Defaulttimeframe: util.StringPointerOrEmpty(plan.DefaultTimeFrame),
+		Maxtimeframe:     util.StringPointerOrEmpty(plan.MaxTimeFrame),
 		Description:      util.StringPointerOrEmpty(plan.Description),
 		Displayname:      util.StringPointerOrEmpty(plan.DisplayName),
 		Glossary:         util.StringPointerOrEmpty(plan.Glossary),
@@ -1405,8 +1160,13 @@ func (r *RolesResource) UpdateRole(ctx context.Context, plan *RolesResourceModel
 		updateReq.ChildRoles = childRoles
 	}
 
-	// Set all custom properties using helper function
-	r.buildCustomPropertiesForUpdate(plan, &updateReq)
+	// Set the changed custom properties, clearing the ones removed from custom_properties
+	customProperties, propertyDiags := CustomPropertyChanges(ctx, plan.CustomProperties, state.CustomProperties, nil)
+	diagnostics.Append(propertyDiags...)
+	if propertyDiags.HasError() {
+		return nil, fmt.Errorf("invalid custom_properties")
+	}
+	SetCustomPropertyFields(&updateReq, customProperties)
 
 	// Execute the update API call
 	tflog.Debug(ctx, "Executing role update API call")
@@ -1534,6 +1294,11 @@ func (r *RolesResource) AddEntitlementsAndChildRoles(ctx context.Context, plan *
 
 // RoleResourceProcessEntitlementChanges processes entitlement changes for update operations using the generic processor
 func (r *RolesResource) RoleResourceProcessEntitlementChanges(ctx context.Context, plan *RolesResourceModel, state *RolesResourceModel) ([]openapi.UpdateEntitlementPayload, error) {
+	// Entitlements are owned by saviynt_role_entitlement
+	if !roleManagesEntitlements(plan) {
+		return nil, nil
+	}
+
 	processor := &ChangeProcessor[Entitlement, string, openapi.UpdateEntitlementPayload]{
 		KeyExtractor: func(e Entitlement) string {
 			return e.EntitlementValue.ValueString() + "|" + e.EntitlementType.ValueString() + "|" + e.Endpoint.ValueString()
@@ -1593,6 +1358,12 @@ func (r *RolesResource) processChildRoleChanges(ctx context.Context, plan *Roles
 func (r *RolesResource) RoleResourceProcessUserChanges(ctx context.Context, plan *RolesResourceModel, state *RolesResourceModel, diagnostics *diag.Diagnostics) (*openapi.AddOrRemoveRoleResponse, error) {
 	roleName := plan.RoleName.ValueString()
 
+	// Membership is owned by saviynt_role_membership or saviynt_role_members
+	if !roleManagesUsers(plan) {
+		tflog.Debug(ctx, "Users are not managed by the role resource, skipping user changes", map[string]interface{}{"role_name": roleName})
+		return nil, nil
+	}
+
 	// Variable to capture the last API response for message/errorcode
 	var lastApiResp *openapi.AddOrRemoveRoleResponse
 
@@ -1778,10 +1549,11 @@ func (r *RolesResource) RoleResourceHasRealNonUserChanges(ctx context.Context, p
 		realChange(plan.DisplayName, state.DisplayName) ||
 		realChange(plan.Requestor, state.Requestor) ||
 		realChange(plan.Owners, state.Owners) ||
-		realChange(plan.Entitlements, state.Entitlements) ||
+		(roleManagesEntitlements(plan) && realChange(plan.Entitlements, state.Entitlements)) ||
 		realChange(plan.ChildRoles, state.ChildRoles) ||
 		realChange(plan.EndpointName, state.EndpointName) ||
 		realChange(plan.DefaultTimeFrame, state.DefaultTimeFrame) ||
+		realChange(plan.MaxTimeFrame, state.MaxTimeFrame) ||
 		realChange(plan.Glossary, state.Glossary) ||
 		realChange(plan.Risk, state.Risk) ||
 		realChange(plan.Level, state.Level) ||
@@ -1794,67 +1566,7 @@ func (r *RolesResource) RoleResourceHasRealNonUserChanges(ctx context.Context, p
 		realChange(plan.CheckSod, state.CheckSod) ||
 		// Computed fields that should be ignored
 		realChange(plan.ID, state.ID) ||
-		// Custom properties 1-60
-		realChange(plan.CustomProperty1, state.CustomProperty1) ||
-		realChange(plan.CustomProperty2, state.CustomProperty2) ||
-		realChange(plan.CustomProperty3, state.CustomProperty3) ||
-		realChange(plan.CustomProperty4, state.CustomProperty4) ||
-		realChange(plan.CustomProperty5, state.CustomProperty5) ||
-		realChange(plan.CustomProperty6, state.CustomProperty6) ||
-		realChange(plan.CustomProperty7, state.CustomProperty7) ||
-		realChange(plan.CustomProperty8, state.CustomProperty8) ||
-		realChange(plan.CustomProperty9, state.CustomProperty9) ||
-		realChange(plan.CustomProperty10, state.CustomProperty10) ||
-		realChange(plan.CustomProperty11, state.CustomProperty11) ||
-		realChange(plan.CustomProperty12, state.CustomProperty12) ||
-		realChange(plan.CustomProperty13, state.CustomProperty13) ||
-		realChange(plan.CustomProperty14, state.CustomProperty14) ||
-		realChange(plan.CustomProperty15, state.CustomProperty15) ||
-		realChange(plan.CustomProperty16, state.CustomProperty16) ||
-		realChange(plan.CustomProperty17, state.CustomProperty17) ||
-		realChange(plan.CustomProperty18, state.CustomProperty18) ||
-		realChange(plan.CustomProperty19, state.CustomProperty19) ||
-		realChange(plan.CustomProperty20, state.CustomProperty20) ||
-		realChange(plan.CustomProperty21, state.CustomProperty21) ||
-		realChange(plan.CustomProperty22, state.CustomProperty22) ||
-		realChange(plan.CustomProperty23, state.CustomProperty23) ||
-		realChange(plan.CustomProperty24, state.CustomProperty24) ||
-		realChange(plan.CustomProperty25, state.CustomProperty25) ||
-		realChange(plan.CustomProperty26, state.CustomProperty26) ||
-		realChange(plan.CustomProperty27, state.CustomProperty27) ||
-		realChange(plan.CustomProperty28, state.CustomProperty28) ||
-		realChange(plan.CustomProperty29, state.CustomProperty29) ||
-		realChange(plan.CustomProperty30, state.CustomProperty30) ||
-		realChange(plan.CustomProperty31, state.CustomProperty31) ||
-		realChange(plan.CustomProperty32, state.CustomProperty32) ||
-		realChange(plan.CustomProperty33, state.CustomProperty33) ||
-		realChange(plan.CustomProperty34, state.CustomProperty34) ||
-		realChange(plan.CustomProperty35, state.CustomProperty35) ||
-		realChange(plan.CustomProperty36, state.CustomProperty36) ||
-		realChange(plan.CustomProperty37, state.CustomProperty37) ||
-		realChange(plan.CustomProperty38, state.CustomProperty38) ||
-		realChange(plan.CustomProperty39, state.CustomProperty39) ||
-		realChange(plan.CustomProperty40, state.CustomProperty40) ||
-		realChange(plan.CustomProperty41, state.CustomProperty41) ||
-		realChange(plan.CustomProperty42, state.CustomProperty42) ||
-		realChange(plan.CustomProperty43, state.CustomProperty43) ||
-		realChange(plan.CustomProperty44, state.CustomProperty44) ||
-		realChange(plan.CustomProperty45, state.CustomProperty45) ||
-		realChange(plan.CustomProperty46, state.CustomProperty46) ||
-		realChange(plan.CustomProperty47, state.CustomProperty47) ||
-		realChange(plan.CustomProperty48, state.CustomProperty48) ||
-		realChange(plan.CustomProperty49, state.CustomProperty49) ||
-		realChange(plan.CustomProperty50, state.CustomProperty50) ||
-		realChange(plan.CustomProperty51, state.CustomProperty51) ||
-		realChange(plan.CustomProperty52, state.CustomProperty52) ||
-		realChange(plan.CustomProperty53, state.CustomProperty53) ||
-		realChange(plan.CustomProperty54, state.CustomProperty54) ||
-		realChange(plan.CustomProperty55, state.CustomProperty55) ||
-		realChange(plan.CustomProperty56, state.CustomProperty56) ||
-		realChange(plan.CustomProperty57, state.CustomProperty57) ||
-		realChange(plan.CustomProperty58, state.CustomProperty58) ||
-		realChange(plan.CustomProperty59, state.CustomProperty59) ||
-		realChange(plan.CustomProperty60, state.CustomProperty60)
+		realChange(plan.CustomProperties, state.CustomProperties)
 }
 
 // ReadRoleStateFromAPI reads current role state from API and updates the model (post-update sync)
@@ -1886,6 +1598,9 @@ func (r *RolesResource) updateModelFromCreateResponse(plan *RolesResourceModel)
 	plan.Requestor = types.StringValue(plan.Requestor.ValueString())
 	plan.EndpointName = types.StringValue(util.SafeDeref(plan.EndpointName.ValueStringPointer()))
 	plan.DefaultTimeFrame = types.StringValue(util.SafeDeref(plan.DefaultTimeFrame.ValueStringPointer()))
+	plan.MaxTimeFrame = types.StringValue(util.SafeDeref(plan.MaxTimeFrame.ValueStringPointer()))
+	// Firefighter IDs are mapped in Saviynt once the role is approved and are picked up by the next read
+	plan.FirefighterAccountKeys = types.ListNull(types.Int64Type)
 	plan.Description = util.SafeString(plan.Description.ValueStringPointer())
 	plan.DisplayName = types.StringValue(util.SafeDeref(plan.DisplayName.ValueStringPointer()))
 	plan.Glossary = util.SafeString(plan.Glossary.ValueStringPointer())
@@ -1896,8 +1611,10 @@ func (r *RolesResource) updateModelFromCreateResponse(plan *RolesResourceModel)
 	plan.Requestable = util.SafeString(plan.Requestable.ValueStringPointer())
 	plan.ShowDynamicAttrs = util.SafeString(plan.ShowDynamicAttrs.ValueStringPointer())
 	plan.Risk = util.SafeString(plan.Risk.ValueStringPointer())
-	// Set all custom properties using helper function
-	r.setCustomPropertiesInPlan(plan)
+	// Custom properties that were not configured are read back on the next refresh
+	if plan.CustomProperties.IsUnknown() {
+		plan.CustomProperties = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 }
 
 // RoleResourceFetchEndpointName fetches endpoint name using endpoint key
@@ -1929,6 +1646,25 @@ func (r *RolesResource) RoleResourceFetchEndpointName(ctx context.Context, endpo
 	}
 }
 
+// RoleResourceReadFirefighterAccounts sets the firefighter ID accounts mapped to a FIREFIGHTER role.
+// Other role types have no firefighter IDs and get a null list.
+func (r *RolesResource) RoleResourceReadFirefighterAccounts(ctx context.Context, model *RolesResourceModel, diagnostics *diag.Diagnostics) {
+	if !strings.EqualFold(model.RoleType.ValueString(), "FIREFIGHTER") {
+		model.FirefighterAccountKeys = types.ListNull(types.Int64Type)
+		return
+	}
+
+	roles, err := ReadFirefighterRoles(ctx, r.provider, r.client, r.roleFactory)
+	if err != nil {
+		diagnostics.AddError("Firefighter Roles Read Failed", err.Error())
+		return
+	}
+
+	keys, diags := types.ListValueFrom(ctx, types.Int64Type, FirefighterAccountKeys(FindFirefighterRole(roles, model.RoleName.ValueString())))
+	diagnostics.Append(diags...)
+	model.FirefighterAccountKeys = keys
+}
+
 // Create implements the resource.Resource interface for creating a new role in Saviynt.
 func (r *RolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RolesResourceModel
@@ -2094,6 +1830,10 @@ func (r *RolesResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.Diagnostics.AddError("Mapping Error", err.Error())
 		return
 	}
+	r.RoleResourceReadFirefighterAccounts(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -2133,7 +1873,7 @@ func (r *RolesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Detect what changed - use new simplified logic
-	hasUserChanges := !plan.Users.Equal(state.Users)
+	hasUserChanges := roleManagesUsers(&plan) && !plan.Users.Equal(state.Users)
 	hasRealNonUserChanges := r.RoleResourceHasRealNonUserChanges(ctx, &plan, &state)
 
 	// Declare apiResp at function level to be available for later use
@@ -2197,6 +1937,10 @@ func (r *RolesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if err := r.RoleResourceMapRoleDetailsToModel(&plan, &roleDetails, &state, &resp.Diagnostics, true); err != nil {
 		return
 	}
+	r.RoleResourceReadFirefighterAccounts(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Add success message
 	if apiResp != nil && apiResp.Requestid != nil && *apiResp.Requestid == "" && apiResp.Requestkey != nil && *apiResp.Requestkey == "" {
@@ -2232,7 +1976,7 @@ func (r *RolesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	} else if hasUserChanges && !hasRealNonUserChanges {
 		// User-only changes - role API was skipped, this is normal
 		tflog.Info(ctx, "User-only changes completed successfully, role API was skipped")
-	} else {
+	} else if hasRealNonUserChanges {
 		resp.Diagnostics.AddWarning(
 			"Info",
 			"Provider error: received unexpected response from Saviynt API.Please retry or contact support.",
@@ -2245,17 +1989,151 @@ func (r *RolesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 }
 
-// We do not support deletion of roles in Saviynt, so this function is intentionally left empty.
+// Saviynt does not support deleting roles. Delete follows the deletion_policy of the role.
 func (r *RolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// resp.State.RemoveResource(ctx)
-	if os.Getenv("TF_ACC") == "1" {
-		resp.State.RemoveResource(ctx)
+	var state RolesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.AddError(
-		"Delete Not Supported",
-		"Resource deletion is not supported by this provider. Please remove the resource manually if required, or contact your administrator.",
+
+	roleName := state.RoleName.ValueString()
+	switch ResolveDeletionPolicy(state.DeletionPolicy) {
+	case DeletionPolicyAbandon:
+		tflog.Info(ctx, "Abandoning role, removing it from state only", map[string]interface{}{"role_name": roleName})
+		resp.State.RemoveResource(ctx)
+	case DeletionPolicyDeactivate:
+		if err := r.DeactivateRole(ctx, &state, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("Role Deactivation Failed", err.Error())
+			return
+		}
+		resp.State.RemoveResource(ctx)
+	default:
+		resp.Diagnostics.AddError(
+			"Delete Not Supported",
+			fmt.Sprintf("Role %s cannot be deleted in Saviynt. Set deletion_policy to \"abandon\" to remove it from state only, "+
+				"or to \"deactivate\" to remove the users and entitlements it manages, and apply before destroying it.", roleName),
+		)
+	}
+}
+
+// DeactivateRole decommissions a role that is removed from the configuration. The users and
+// entitlements in state that Saviynt still reports for the role are removed; members and
+// entitlements that this resource does not manage are left alone. The update enterprise role
+// API documents no status field, so the role stays active until it is set inactive in the
+// Saviynt UI.
+func (r *RolesResource) DeactivateRole(ctx context.Context, state *RolesResourceModel, diagnostics *diag.Diagnostics) error {
+	roleName := state.RoleName.ValueString()
+	tflog.Debug(ctx, "Deactivating role", map[string]interface{}{"role_name": roleName})
+
+	roleDetails, err := ReadRoleDetails(ctx, r.provider, r.client, r.roleFactory, roleName, "users,entitlements")
+	if err != nil {
+		return err
+	}
+	if roleDetails == nil {
+		tflog.Warn(ctx, "Role not found, nothing to deactivate", map[string]interface{}{"role_name": roleName})
+		return nil
+	}
+
+	if roleManagesUsers(state) && !state.Users.IsNull() && !state.Users.IsUnknown() {
+		var stateUsers []Users
+		if diags := state.Users.ElementsAs(ctx, &stateUsers, false); diags.HasError() {
+			return fmt.Errorf("failed to extract state users: %v", diags.Errors())
+		}
+		var current []string
+		for _, u := range roleDetails.UserDetails {
+			if u.GetUserDetailsResponse != nil && u.GetUserDetailsResponse.Username != nil {
+				current = append(current, *u.GetUserDetailsResponse.Username)
+			}
+		}
+		var errors []string
+		for _, u := range stateUsers {
+			userName := u.UserName.ValueString()
+			if !containsFold(current, userName) {
+				continue
+			}
+			if _, err := RoleRemoveUser(ctx, r.provider, r.client, r.roleFactory, roleName, userName); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+		if len(errors) > 0 {
+			return fmt.Errorf("failed to remove users from role %s: %s", roleName, strings.Join(errors, "; "))
+		}
+	}
+
+	var removedEntitlements []openapi.UpdateEntitlementPayload
+	if roleManagesEntitlements(state) && !state.Entitlements.IsNull() && !state.Entitlements.IsUnknown() {
+		var stateEntitlements []Entitlement
+		if diags := state.Entitlements.ElementsAs(ctx, &stateEntitlements, false); diags.HasError() {
+			return fmt.Errorf("failed to extract state entitlements: %v", diags.Errors())
+		}
+		updateType := UpdateTypeRemove
+		for _, e := range stateEntitlements {
+			reported := roleHasEntitlement(roleDetails, &RoleEntitlementResourceModel{
+				Endpoint:         e.Endpoint,
+				EntitlementType:  e.EntitlementType,
+				EntitlementValue: e.EntitlementValue,
+			})
+			if !reported {
+				continue
+			}
+			removedEntitlements = append(removedEntitlements, openapi.UpdateEntitlementPayload{
+				EntitlementValue: e.EntitlementValue.ValueStringPointer(),
+				EntitlementType:  e.EntitlementType.ValueStringPointer(),
+				Endpoint:         e.Endpoint.ValueStringPointer(),
+				UpdateType:       &updateType,
+			})
+		}
+	}
+
+	var apiResp *openapi.UpdateEnterpriseRoleResponse
+	if len(removedEntitlements) > 0 {
+		updateReq := openapi.UpdateEnterpriseRoleRequest{
+			RoleName:     roleName,
+			Roletype:     endpointsutil.TranslateValue(util.SafeDeref(roleDetails.Roletype), rolesutil.RoleTypeMap),
+			Entitlements: removedEntitlements,
+		}
+		if requestor := state.Requestor.ValueString(); requestor != "" {
+			updateReq.Requestor = &requestor
+		}
+
+		var finalHttpResp *http.Response
+		err = r.provider.AuthenticatedAPICallWithRetry(ctx, "deactivate_enterprise_role", func(token string) error {
+			roleOps := r.roleFactory.CreateRoleOperations(r.client.APIBaseURL(), token)
+			resp, httpResp, err := roleOps.UpdateEnterpriseRole(ctx, updateReq)
+			if httpResp != nil && httpResp.StatusCode == 401 {
+				return fmt.Errorf("401 unauthorized")
+			}
+			apiResp = resp
+			finalHttpResp = httpResp // Capture final HTTP response
+			return err
+		})
+
+		if rolesutil.RoleHandleHTTPError(ctx, finalHttpResp, err, "deactivating role", diagnostics) {
+			return fmt.Errorf("failed to deactivate role %s", roleName)
+		}
+		if apiResp != nil && rolesutil.RoleHandleAPIError(ctx, apiResp.ErrorCode, apiResp.Message, "deactivating role", diagnostics) {
+			return fmt.Errorf("API error during role deactivation: %s", util.SafeDeref(apiResp.Message))
+		}
+	}
+
+	details := ""
+	if apiResp != nil {
+		details = fmt.Sprintf("Message: %s\nErrorCode: %s\nRequestID: %s\nRequestKey: %s\n\n",
+			apiResp.GetMessage(), apiResp.GetErrorCode(), apiResp.GetRequestid(), apiResp.GetRequestkey())
+	}
+	diagnostics.AddWarning(
+		"Role Deactivated - Manual UI Action Required",
+		fmt.Sprintf(
+			"The users and entitlements of role %s managed by this resource were removed and the role was removed from state.\n%s"+
+				"⚠️  IMPORTANT: The update enterprise role API has no status field, so the role is still active.\n"+
+				"Please log into the Saviynt UI to set the role inactive, and approve the request if the role update created a new version.",
+			roleName, details,
+		),
 	)
+	tflog.Info(ctx, "Role deactivated", map[string]interface{}{"role_name": roleName})
+	return nil
 }
 
 // ImportState implements the resource.Resource interface for importing existing roles into the Terraform state.
@@ -2264,4 +2142,62 @@ func (r *RolesResource) ImportState(ctx context.Context, req resource.ImportStat
 	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), req, resp)
 	// Set the requestor to the current authenticated user
 	resp.State.SetAttribute(ctx, path.Root("requestor"), r.requestor)
+	resp.State.SetAttribute(ctx, path.Root("manage_users"), true)
+	resp.State.SetAttribute(ctx, path.Root("manage_entitlements"), true)
+	resp.State.SetAttribute(ctx, path.Root("deletion_policy"), DeletionPolicyError)
+}
+
+// ValidateConfig rejects users and entitlements on roles whose membership or entitlements are
+// managed by another resource, and a default time frame above the maximum time frame.
+func (r *RolesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RolesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ManageUsers.IsNull() && !config.ManageUsers.IsUnknown() && !config.ManageUsers.ValueBool() && !config.Users.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Conflicting User Configuration",
+			"users cannot be set when manage_users is false. Manage the membership with saviynt_role_membership or saviynt_role_members instead.",
+		)
+	}
+	if !config.ManageEntitlements.IsNull() && !config.ManageEntitlements.IsUnknown() && !config.ManageEntitlements.ValueBool() && !config.Entitlements.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entitlements"),
+			"Conflicting Entitlement Configuration",
+			"entitlements cannot be set when manage_entitlements is false. Attach entitlements with saviynt_role_entitlement instead.",
+		)
+	}
+
+	// Time frames are configured as strings; only compare them once both are known numbers
+	defaultHrs, defaultErr := strconv.Atoi(config.DefaultTimeFrame.ValueString())
+	maxHrs, maxErr := strconv.Atoi(config.MaxTimeFrame.ValueString())
+	if !config.MaxTimeFrame.IsNull() && !config.MaxTimeFrame.IsUnknown() && maxErr != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_time_frame"),
+			"Invalid Time Frame",
+			fmt.Sprintf("max_time_frame must be a whole number of hours, got %q.", config.MaxTimeFrame.ValueString()),
+		)
+	}
+	if defaultErr == nil && maxErr == nil && defaultHrs > maxHrs {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_time_frame"),
+			"Invalid Time Frame",
+			fmt.Sprintf("default_time_frame (%d hours) must not exceed max_time_frame (%d hours).", defaultHrs, maxHrs),
+		)
+	}
+}
+
+// roleManagesUsers reports whether the role resource owns the users of the role. Unset values,
+// for example in state written before manage_users existed, count as managed.
+func roleManagesUsers(model *RolesResourceModel) bool {
+	return model.ManageUsers.IsNull() || model.ManageUsers.IsUnknown() || model.ManageUsers.ValueBool()
+}
+
+// roleManagesEntitlements reports whether the role resource owns the entitlements of the role.
+// Unset values count as managed.
+func roleManagesEntitlements(model *RolesResourceModel) bool {
+	return model.ManageEntitlements.IsNull() || model.ManageEntitlements.IsUnknown() || model.ManageEntitlements.ValueBool()
 }
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Helpers shared by the enterprise role resources and data sources to read a role and to add and
+// remove its users.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/rolesutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/roles"
+)
+
+// RoleAddUser assigns a user to a role. A non-zero error code returned by Saviynt is an error.
+func RoleAddUser(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface, roleName, userName string) (*openapi.AddOrRemoveRoleResponse, error) {
+	return roleChangeUser(ctx, provider, apiClient, factory, roleName, userName, UpdateTypeAdd)
+}
+
+// RoleRemoveUser removes a user from a role. A non-zero error code returned by Saviynt is an error.
+func RoleRemoveUser(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface, roleName, userName string) (*openapi.AddOrRemoveRoleResponse, error) {
+	return roleChangeUser(ctx, provider, apiClient, factory, roleName, userName, UpdateTypeRemove)
+}
+
+func roleChangeUser(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface, roleName, userName, updateType string) (*openapi.AddOrRemoveRoleResponse, error) {
+	operation, action := "add_user_to_role", "adding user"
+	if updateType == UpdateTypeRemove {
+		operation, action = "remove_user_from_role", "removing user"
+	}
+
+	var apiResp *openapi.AddOrRemoveRoleResponse
+	var finalHttpResp *http.Response
+	err := provider.AuthenticatedAPICallWithRetry(ctx, operation, func(token string) error {
+		roleOps := factory.CreateRoleOperations(apiClient.APIBaseURL(), token)
+		var resp *openapi.AddOrRemoveRoleResponse
+		var httpResp *http.Response
+		var err error
+		if updateType == UpdateTypeRemove {
+			resp, httpResp, err = roleOps.RemoveUserFromRole(ctx, userName, roleName)
+		} else {
+			resp, httpResp, err = roleOps.AddUserToRole(ctx, userName, roleName)
+		}
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp // Capture final HTTP response
+		return err
+	})
+
+	var diags diag.Diagnostics
+	if rolesutil.RoleHandleHTTPError(ctx, finalHttpResp, err, action, &diags) {
+		return nil, fmt.Errorf("failed %s %s for role %s: %v", action, userName, roleName, diags.Errors())
+	}
+
+	if apiResp != nil && apiResp.ErrorCode != nil && *apiResp.ErrorCode != "0" {
+		return apiResp, fmt.Errorf("error %s %s for role %s: %s", action, userName, roleName, apiResp.GetMessage())
+	}
+
+	tflog.Info(ctx, "Role membership changed", map[string]interface{}{
+		"role_name":   roleName,
+		"user_name":   userName,
+		"update_type": updateType,
+		"message":     apiResp.GetMessage(),
+	})
+	return apiResp, nil
+}
+
+// ReadRoleDetails fetches a role by name together with the requested related objects, for example
+// "users" or "entitlements". A nil result without error means the role does not exist.
+func ReadRoleDetails(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface, roleName, requestedObject string) (*openapi.GetRoleDetailsResponse, error) {
+	reqParams := openapi.GetRolesRequest{}
+	reqParams.SetRoleName(roleName)
+	if requestedObject != "" {
+		reqParams.SetRequestedObject(requestedObject)
+	}
+
+	var apiResp *openapi.GetRolesResponse
+	var finalHttpResp *http.Response
+	err := provider.AuthenticatedAPICallWithRetry(ctx, "get_roles", func(token string) error {
+		roleOps := factory.CreateRoleOperations(apiClient.APIBaseURL(), token)
+		resp, httpResp, err := roleOps.GetRoles(ctx, reqParams)
+		if httpResp != nil && httpResp.StatusCode == 401 {
+			return fmt.Errorf("401 unauthorized")
+		}
+		apiResp = resp
+		finalHttpResp = httpResp // Capture final HTTP response
+		return err
+	})
+
+	var diags diag.Diagnostics
+	if rolesutil.RoleHandleHTTPError(ctx, finalHttpResp, err, "reading role", &diags) {
+		return nil, fmt.Errorf("failed to read role %s: %v", roleName, diags.Errors())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading role %s: %v", roleName, err)
+	}
+
+	if apiResp == nil {
+		return nil, nil
+	}
+	for i := range apiResp.Roledetails {
+		if strings.EqualFold(util.SafeDeref(apiResp.Roledetails[i].RoleName), roleName) {
+			return &apiResp.Roledetails[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ReadRoleUserNames returns the usernames assigned to a role. The found result is false when the
+// role does not exist.
+func ReadRoleUserNames(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.RoleFactoryInterface, roleName string) (userNames []string, found bool, err error) {
+	roleDetails, err := ReadRoleDetails(ctx, provider, apiClient, factory, roleName, "users")
+	if err != nil || roleDetails == nil {
+		return nil, false, err
+	}
+
+	userNames = []string{}
+	for _, u := range roleDetails.UserDetails {
+		if u.GetUserDetailsResponse != nil && u.GetUserDetailsResponse.Username != nil {
+			userNames = append(userNames, *u.GetUserDetailsResponse.Username)
+		}
+	}
+	return userNames, true, nil
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
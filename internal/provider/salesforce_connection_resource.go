@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
 	"terraform-provider-Saviynt/util/errorsutil"
@@ -38,25 +40,25 @@ var salesforceErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.Connecto
 
 type SalesforceConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                     types.String `tfsdk:"id"`
-	ClientId               types.String `tfsdk:"client_id"`
-	ClientSecret           types.String `tfsdk:"client_secret"`
-	ClientSecretWO         types.String `tfsdk:"client_secret_wo"`
-	RefreshToken           types.String `tfsdk:"refresh_token"`
-	RefreshTokenWO         types.String `tfsdk:"refresh_token_wo"`
-	RedirectUri            types.String `tfsdk:"redirect_uri"`
-	InstanceUrl            types.String `tfsdk:"instance_url"`
-	ObjectToBeImported     types.String `tfsdk:"object_to_be_imported"`
-	FeatureLicenseJson     types.String `tfsdk:"feature_license_json"`
-	CustomCreateaccountUrl types.String `tfsdk:"custom_createaccount_url"`
-	Createaccountjson      types.String `tfsdk:"createaccountjson"`
-	AccountFilterQuery     types.String `tfsdk:"account_filter_query"`
-	AccountFieldQuery      types.String `tfsdk:"account_field_query"`
-	FieldMappingJson       types.String `tfsdk:"field_mapping_json"`
-	Modifyaccountjson      types.String `tfsdk:"modifyaccountjson"`
-	StatusThresholdConfig  types.String `tfsdk:"status_threshold_config"`
-	Customconfigjson       types.String `tfsdk:"customconfigjson"`
-	PamConfig              types.String `tfsdk:"pam_config"`
+	ID                     types.String           `tfsdk:"id"`
+	ClientId               types.String           `tfsdk:"client_id"`
+	ClientSecret           types.String           `tfsdk:"client_secret"`
+	ClientSecretWO         types.String           `tfsdk:"client_secret_wo"`
+	RefreshToken           types.String           `tfsdk:"refresh_token"`
+	RefreshTokenWO         types.String           `tfsdk:"refresh_token_wo"`
+	RedirectUri            types.String           `tfsdk:"redirect_uri"`
+	InstanceUrl            types.String           `tfsdk:"instance_url"`
+	ObjectToBeImported     types.String           `tfsdk:"object_to_be_imported"`
+	FeatureLicenseJson     customtypes.JSONString `tfsdk:"feature_license_json"`
+	CustomCreateaccountUrl types.String           `tfsdk:"custom_createaccount_url"`
+	Createaccountjson      customtypes.JSONString `tfsdk:"createaccountjson"`
+	AccountFilterQuery     types.String           `tfsdk:"account_filter_query"`
+	AccountFieldQuery      types.String           `tfsdk:"account_field_query"`
+	FieldMappingJson       customtypes.JSONString `tfsdk:"field_mapping_json"`
+	Modifyaccountjson      customtypes.JSONString `tfsdk:"modifyaccountjson"`
+	StatusThresholdConfig  customtypes.JSONString `tfsdk:"status_threshold_config"`
+	Customconfigjson       customtypes.JSONString `tfsdk:"customconfigjson"`
+	PamConfig              customtypes.JSONString `tfsdk:"pam_config"`
 }
 
 type SalesforceConnectionResource struct {
@@ -141,6 +143,7 @@ func SalesforceConnectorResourceSchema() map[string]schema.Attribute {
 			Description: `Comma-separated list of Salesforce objects to import. Example: "Profile,Role,Group,PermissionSet"`,
 		},
 		"feature_license_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON mapping of feature licenses to permission fields in Salesforce.",
@@ -151,6 +154,7 @@ func SalesforceConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Custom URL used when creating a Salesforce account.",
 		},
 		"createaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template used for account creation in Salesforce.",
@@ -166,26 +170,34 @@ func SalesforceConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Fields to retrieve for Salesforce accounts. Example: Id, Username, LastName, FirstName, etc.",
 		},
 		"field_mapping_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON mapping of local fields to Salesforce fields with data types.",
 		},
 		"modifyaccountjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON template used for modifying Salesforce accounts.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration to define active/inactive thresholds and lock statuses.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"customconfigjson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Custom configuration options for Salesforce connector.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Privileged Access Management (PAM) configuration in JSON format.",
@@ -307,7 +319,7 @@ func (r *SalesforceConnectionResource) CreateSalesforceConnection(ctx context.Co
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_salesforce_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, salesforceConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, salesforceConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -372,16 +384,16 @@ func (r *SalesforceConnectionResource) BuildSalesforceConnector(plan *Salesforce
 		REDIRECT_URI:             util.StringPointerOrEmpty(plan.RedirectUri),
 		INSTANCE_URL:             util.StringPointerOrEmpty(plan.InstanceUrl),
 		OBJECT_TO_BE_IMPORTED:    util.StringPointerOrEmpty(plan.ObjectToBeImported),
-		FEATURE_LICENSE_JSON:     util.StringPointerOrEmpty(plan.FeatureLicenseJson),
+		FEATURE_LICENSE_JSON:     util.StringPointerOrEmpty(plan.FeatureLicenseJson.StringValue),
 		CUSTOM_CREATEACCOUNT_URL: util.StringPointerOrEmpty(plan.CustomCreateaccountUrl),
-		CREATEACCOUNTJSON:        util.StringPointerOrEmpty(plan.Createaccountjson),
+		CREATEACCOUNTJSON:        util.StringPointerOrEmpty(plan.Createaccountjson.StringValue),
 		ACCOUNT_FILTER_QUERY:     util.StringPointerOrEmpty(plan.AccountFilterQuery),
 		ACCOUNT_FIELD_QUERY:      util.StringPointerOrEmpty(plan.AccountFieldQuery),
-		FIELD_MAPPING_JSON:       util.StringPointerOrEmpty(plan.FieldMappingJson),
-		MODIFYACCOUNTJSON:        util.StringPointerOrEmpty(plan.Modifyaccountjson),
-		STATUS_THRESHOLD_CONFIG:  util.StringPointerOrEmpty(plan.StatusThresholdConfig),
-		CUSTOMCONFIGJSON:         util.StringPointerOrEmpty(plan.Customconfigjson),
-		PAM_CONFIG:               util.StringPointerOrEmpty(plan.PamConfig),
+		FIELD_MAPPING_JSON:       util.StringPointerOrEmpty(plan.FieldMappingJson.StringValue),
+		MODIFYACCOUNTJSON:        util.StringPointerOrEmpty(plan.Modifyaccountjson.StringValue),
+		STATUS_THRESHOLD_CONFIG:  util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
+		CUSTOMCONFIGJSON:         util.StringPointerOrEmpty(plan.Customconfigjson.StringValue),
+		PAM_CONFIG:               util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -405,16 +417,16 @@ func (r *SalesforceConnectionResource) UpdateModelFromCreateResponse(plan *Sales
 	plan.RedirectUri = util.SafeStringDatasource(plan.RedirectUri.ValueStringPointer())
 	plan.InstanceUrl = util.SafeStringDatasource(plan.InstanceUrl.ValueStringPointer())
 	plan.ObjectToBeImported = util.SafeStringDatasource(plan.ObjectToBeImported.ValueStringPointer())
-	plan.FeatureLicenseJson = util.SafeStringDatasource(plan.FeatureLicenseJson.ValueStringPointer())
+	plan.FeatureLicenseJson = customtypes.NewJSONStringPointerValue(plan.FeatureLicenseJson.ValueStringPointer())
 	plan.CustomCreateaccountUrl = util.SafeStringDatasource(plan.CustomCreateaccountUrl.ValueStringPointer())
-	plan.Createaccountjson = util.SafeStringDatasource(plan.Createaccountjson.ValueStringPointer())
+	plan.Createaccountjson = customtypes.NewJSONStringPointerValue(plan.Createaccountjson.ValueStringPointer())
 	plan.AccountFilterQuery = util.SafeStringDatasource(plan.AccountFilterQuery.ValueStringPointer())
 	plan.AccountFieldQuery = util.SafeStringDatasource(plan.AccountFieldQuery.ValueStringPointer())
-	plan.FieldMappingJson = util.SafeStringDatasource(plan.FieldMappingJson.ValueStringPointer())
-	plan.Modifyaccountjson = util.SafeStringDatasource(plan.Modifyaccountjson.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
-	plan.Customconfigjson = util.SafeStringDatasource(plan.Customconfigjson.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.FieldMappingJson = customtypes.NewJSONStringPointerValue(plan.FieldMappingJson.ValueStringPointer())
+	plan.Modifyaccountjson = customtypes.NewJSONStringPointerValue(plan.Modifyaccountjson.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.Customconfigjson = customtypes.NewJSONStringPointerValue(plan.Customconfigjson.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 
 	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
 	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
@@ -484,19 +496,20 @@ func (r *SalesforceConnectionResource) UpdateModelFromReadResponse(state *Salesf
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Emailtemplate)
 	state.ObjectToBeImported = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.OBJECT_TO_BE_IMPORTED)
-	state.FeatureLicenseJson = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.FEATURE_LICENSE_JSON)
-	state.Createaccountjson = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
+	state.FeatureLicenseJson = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.FEATURE_LICENSE_JSON)
+	state.Createaccountjson = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
 	state.RedirectUri = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.REDIRECT_URI)
-	state.Modifyaccountjson = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.MODIFYACCOUNTJSON)
+	state.Modifyaccountjson = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.MODIFYACCOUNTJSON)
 	state.ClientId = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.CLIENT_ID)
-	state.PamConfig = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.PAM_CONFIG)
-	state.Customconfigjson = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.CUSTOMCONFIGJSON)
-	state.FieldMappingJson = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.FIELD_MAPPING_JSON)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.Customconfigjson = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.CUSTOMCONFIGJSON)
+	state.FieldMappingJson = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.FIELD_MAPPING_JSON)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.SalesforceConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
 	state.AccountFieldQuery = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.ACCOUNT_FIELD_QUERY)
 	state.CustomCreateaccountUrl = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.CUSTOM_CREATEACCOUNT_URL)
 	state.AccountFilterQuery = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.ACCOUNT_FILTER_QUERY)
 	state.InstanceUrl = util.SafeStringDatasource(apiResp.SalesforceConnectionResponse.Connectionattributes.INSTANCE_URL)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.SalesforceConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *SalesforceConnectionResource) ValidateSalesforceConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -530,7 +543,7 @@ func (r *SalesforceConnectionResource) UpdateSalesforceConnection(ctx context.Co
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_salesforce_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, salesforceConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, salesforceConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -605,8 +618,14 @@ func (r *SalesforceConnectionResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateSalesforceConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateSalesforceConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Salesforce connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -661,7 +680,9 @@ func (r *SalesforceConnectionResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.SalesforceConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -755,8 +776,14 @@ func (r *SalesforceConnectionResource) Update(ctx context.Context, req resource.
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateSalesforceConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateSalesforceConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Salesforce connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -778,7 +805,9 @@ func (r *SalesforceConnectionResource) Update(ctx context.Context, req resource.
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -39,69 +41,69 @@ var sapErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeSA
 
 type SapConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                             types.String `tfsdk:"id"`
-	Messageserver                  types.String `tfsdk:"message_server"`
-	JcoAshost                      types.String `tfsdk:"jco_ashost"`
-	JcoSysnr                       types.String `tfsdk:"jco_sysnr"`
-	JcoClient                      types.String `tfsdk:"jco_client"`
-	JcoUser                        types.String `tfsdk:"jco_user"`
-	Password                       types.String `tfsdk:"password"`
-	PasswordWo                     types.String `tfsdk:"password_wo"`
-	JcoLang                        types.String `tfsdk:"jco_lang"`
-	JcoR3Name                      types.String `tfsdk:"jco_r3name"`
-	JcoMshost                      types.String `tfsdk:"jco_mshost"`
-	JcoMsserv                      types.String `tfsdk:"jco_msserv"`
-	JcoGroup                       types.String `tfsdk:"jco_group"`
-	Snc                            types.String `tfsdk:"snc"`
-	JcoSncMode                     types.String `tfsdk:"jco_snc_mode"`
-	JcoSncPartnername              types.String `tfsdk:"jco_snc_partnername"`
-	JcoSncMyname                   types.String `tfsdk:"jco_snc_myname"`
-	JcoSncLibrary                  types.String `tfsdk:"jco_snc_library"`
-	JcoSncQop                      types.String `tfsdk:"jco_snc_qop"`
-	Tables                         types.String `tfsdk:"tables"`
-	Systemname                     types.String `tfsdk:"system_name"`
-	Terminatedusergroup            types.String `tfsdk:"terminated_user_group"`
-	TerminatedUserRoleAction       types.String `tfsdk:"terminated_user_role_action"`
-	Createaccountjson              types.String `tfsdk:"create_account_json"`
-	ProvJcoAshost                  types.String `tfsdk:"prov_jco_ashost"`
-	ProvJcoSysnr                   types.String `tfsdk:"prov_jco_sysnr"`
-	ProvJcoClient                  types.String `tfsdk:"prov_jco_client"`
-	ProvJcoUser                    types.String `tfsdk:"prov_jco_user"`
-	ProvPassword                   types.String `tfsdk:"prov_password"`
-	ProvPasswordWo                 types.String `tfsdk:"prov_password_wo"`
-	ProvJcoLang                    types.String `tfsdk:"prov_jco_lang"`
-	ProvJcoR3Name                  types.String `tfsdk:"prov_jco_r3name"`
-	ProvJcoMshost                  types.String `tfsdk:"prov_jco_mshost"`
-	ProvJcoMsserv                  types.String `tfsdk:"prov_jco_msserv"`
-	ProvJcoGroup                   types.String `tfsdk:"prov_jco_group"`
-	ProvCuaEnabled                 types.String `tfsdk:"prov_cua_enabled"`
-	ProvCuaSnc                     types.String `tfsdk:"prov_cua_snc"`
-	ResetPwdForNewaccount          types.String `tfsdk:"reset_pwd_for_newaccount"`
-	Enforcepasswordchange          types.String `tfsdk:"enforce_password_change"`
-	PasswordMinLength              types.String `tfsdk:"password_min_length"`
-	PasswordMaxLength              types.String `tfsdk:"password_max_length"`
-	PasswordNoofcapsalpha          types.String `tfsdk:"password_no_of_caps_alpha"`
-	PasswordNoofdigits             types.String `tfsdk:"password_no_of_digits"`
-	PasswordNoofsplchars           types.String `tfsdk:"password_no_of_spl_chars"`
-	Hanareftablejson               types.String `tfsdk:"hanareftablejson"`
-	Enableaccountjson              types.String `tfsdk:"enable_account_json"`
-	Updateaccountjson              types.String `tfsdk:"update_account_json"`
-	Userimportjson                 types.String `tfsdk:"user_import_json"`
-	StatusThresholdConfig          types.String `tfsdk:"status_threshold_config"`
-	Setcuasystem                   types.String `tfsdk:"set_cua_system"`
-	FirefighteridGrantAccessJson   types.String `tfsdk:"fire_fighter_id_grant_access_json"`
-	FirefighteridRevokeAccessJson  types.String `tfsdk:"fire_fighter_id_revoke_access_json"`
-	Modifyuserdatajson             types.String `tfsdk:"modify_user_data_json"`
-	ExternalSodEvalJson            types.String `tfsdk:"external_sod_eval_json"`
-	ExternalSodEvalJsonDetail      types.String `tfsdk:"external_sod_eval_json_detail"`
-	LogsTableFilter                types.String `tfsdk:"logs_table_filter"`
-	PamConfig                      types.String `tfsdk:"pam_config"`
-	SaptableFilterLang             types.String `tfsdk:"saptable_filter_lang"`
-	AlternateOutputParameterEtData types.String `tfsdk:"alternate_output_parameter_et_data"`
-	AuditLogJson                   types.String `tfsdk:"audit_log_json"`
-	EccOrS4Hana                    types.String `tfsdk:"ecc_or_s4hana"`
-	DataImportFilter               types.String `tfsdk:"data_import_filter"`
-	Configjson                     types.String `tfsdk:"config_json"`
+	ID                             types.String           `tfsdk:"id"`
+	Messageserver                  types.String           `tfsdk:"message_server"`
+	JcoAshost                      types.String           `tfsdk:"jco_ashost"`
+	JcoSysnr                       types.String           `tfsdk:"jco_sysnr"`
+	JcoClient                      types.String           `tfsdk:"jco_client"`
+	JcoUser                        types.String           `tfsdk:"jco_user"`
+	Password                       types.String           `tfsdk:"password"`
+	PasswordWo                     types.String           `tfsdk:"password_wo"`
+	JcoLang                        types.String           `tfsdk:"jco_lang"`
+	JcoR3Name                      types.String           `tfsdk:"jco_r3name"`
+	JcoMshost                      types.String           `tfsdk:"jco_mshost"`
+	JcoMsserv                      types.String           `tfsdk:"jco_msserv"`
+	JcoGroup                       types.String           `tfsdk:"jco_group"`
+	Snc                            types.String           `tfsdk:"snc"`
+	JcoSncMode                     types.String           `tfsdk:"jco_snc_mode"`
+	JcoSncPartnername              types.String           `tfsdk:"jco_snc_partnername"`
+	JcoSncMyname                   types.String           `tfsdk:"jco_snc_myname"`
+	JcoSncLibrary                  types.String           `tfsdk:"jco_snc_library"`
+	JcoSncQop                      types.String           `tfsdk:"jco_snc_qop"`
+	Tables                         types.String           `tfsdk:"tables"`
+	Systemname                     types.String           `tfsdk:"system_name"`
+	Terminatedusergroup            types.String           `tfsdk:"terminated_user_group"`
+	TerminatedUserRoleAction       types.String           `tfsdk:"terminated_user_role_action"`
+	Createaccountjson              customtypes.JSONString `tfsdk:"create_account_json"`
+	ProvJcoAshost                  types.String           `tfsdk:"prov_jco_ashost"`
+	ProvJcoSysnr                   types.String           `tfsdk:"prov_jco_sysnr"`
+	ProvJcoClient                  types.String           `tfsdk:"prov_jco_client"`
+	ProvJcoUser                    types.String           `tfsdk:"prov_jco_user"`
+	ProvPassword                   types.String           `tfsdk:"prov_password"`
+	ProvPasswordWo                 types.String           `tfsdk:"prov_password_wo"`
+	ProvJcoLang                    types.String           `tfsdk:"prov_jco_lang"`
+	ProvJcoR3Name                  types.String           `tfsdk:"prov_jco_r3name"`
+	ProvJcoMshost                  types.String           `tfsdk:"prov_jco_mshost"`
+	ProvJcoMsserv                  types.String           `tfsdk:"prov_jco_msserv"`
+	ProvJcoGroup                   types.String           `tfsdk:"prov_jco_group"`
+	ProvCuaEnabled                 types.String           `tfsdk:"prov_cua_enabled"`
+	ProvCuaSnc                     types.String           `tfsdk:"prov_cua_snc"`
+	ResetPwdForNewaccount          types.String           `tfsdk:"reset_pwd_for_newaccount"`
+	Enforcepasswordchange          types.String           `tfsdk:"enforce_password_change"`
+	PasswordMinLength              types.String           `tfsdk:"password_min_length"`
+	PasswordMaxLength              types.String           `tfsdk:"password_max_length"`
+	PasswordNoofcapsalpha          types.String           `tfsdk:"password_no_of_caps_alpha"`
+	PasswordNoofdigits             types.String           `tfsdk:"password_no_of_digits"`
+	PasswordNoofsplchars           types.String           `tfsdk:"password_no_of_spl_chars"`
+	Hanareftablejson               customtypes.JSONString `tfsdk:"hanareftablejson"`
+	Enableaccountjson              customtypes.JSONString `tfsdk:"enable_account_json"`
+	Updateaccountjson              customtypes.JSONString `tfsdk:"update_account_json"`
+	Userimportjson                 customtypes.JSONString `tfsdk:"user_import_json"`
+	StatusThresholdConfig          customtypes.JSONString `tfsdk:"status_threshold_config"`
+	Setcuasystem                   types.String           `tfsdk:"set_cua_system"`
+	FirefighteridGrantAccessJson   customtypes.JSONString `tfsdk:"fire_fighter_id_grant_access_json"`
+	FirefighteridRevokeAccessJson  customtypes.JSONString `tfsdk:"fire_fighter_id_revoke_access_json"`
+	Modifyuserdatajson             customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	ExternalSodEvalJson            customtypes.JSONString `tfsdk:"external_sod_eval_json"`
+	ExternalSodEvalJsonDetail      customtypes.JSONString `tfsdk:"external_sod_eval_json_detail"`
+	LogsTableFilter                types.String           `tfsdk:"logs_table_filter"`
+	PamConfig                      customtypes.JSONString `tfsdk:"pam_config"`
+	SaptableFilterLang             types.String           `tfsdk:"saptable_filter_lang"`
+	AlternateOutputParameterEtData types.String           `tfsdk:"alternate_output_parameter_et_data"`
+	AuditLogJson                   customtypes.JSONString `tfsdk:"audit_log_json"`
+	EccOrS4Hana                    types.String           `tfsdk:"ecc_or_s4hana"`
+	DataImportFilter               types.String           `tfsdk:"data_import_filter"`
+	Configjson                     customtypes.JSONString `tfsdk:"config_json"`
 
 	//25.B.1
 	RoleDefaultDate types.String `tfsdk:"role_default_date"`
@@ -254,6 +256,7 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Terminateduserroleaction.",
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Createaccountjson.",
@@ -365,29 +368,37 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Passwordnoofsplchars.",
 		},
 		"hanareftablejson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Hanareftablejson.",
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Enableaccountjson.",
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Updateaccountjson.",
 		},
 		"user_import_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Userimportjson.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Statusthresholdconfig.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"set_cua_system": schema.StringAttribute{
 			Optional:    true,
@@ -395,26 +406,31 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Setcuasystem.",
 		},
 		"fire_fighter_id_grant_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Firefighteridgrantaccessjson.",
 		},
 		"fire_fighter_id_revoke_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Firefighteridrevokeaccessjson.",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Modifyuserdatajson.",
 		},
 		"external_sod_eval_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Externalsodevaljson.",
 		},
 		"external_sod_eval_json_detail": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Externalsodevaljsondetail.",
@@ -425,6 +441,7 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Logstablefilter.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Pamconfig.",
@@ -440,6 +457,7 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Alternateoutputparameteretdata.",
 		},
 		"audit_log_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Auditlogjson.",
@@ -455,9 +473,14 @@ func SapConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Data import filter.",
 		},
 		"config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Config json.",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"role_default_date": schema.StringAttribute{
 			Optional:    true,
@@ -582,7 +605,7 @@ func (r *SapConnectionResource) CreateSAPConnection(ctx context.Context, plan *S
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_sap_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, sapConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, sapConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -662,7 +685,7 @@ func (r *SapConnectionResource) BuildSAPConnector(plan *SapConnectorResourceMode
 		SYSTEMNAME:                         util.StringPointerOrEmpty(plan.Systemname),
 		TERMINATEDUSERGROUP:                util.StringPointerOrEmpty(plan.Terminatedusergroup),
 		TERMINATED_USER_ROLE_ACTION:        util.StringPointerOrEmpty(plan.TerminatedUserRoleAction),
-		CREATEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Createaccountjson),
+		CREATEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Createaccountjson.StringValue),
 		PROV_JCO_ASHOST:                    util.StringPointerOrEmpty(plan.ProvJcoAshost),
 		PROV_JCO_SYSNR:                     util.StringPointerOrEmpty(plan.ProvJcoSysnr),
 		PROV_JCO_CLIENT:                    util.StringPointerOrEmpty(plan.ProvJcoClient),
@@ -682,25 +705,25 @@ func (r *SapConnectionResource) BuildSAPConnector(plan *SapConnectorResourceMode
 		PASSWORD_NOOFCAPSALPHA:             util.StringPointerOrEmpty(plan.PasswordNoofcapsalpha),
 		PASSWORD_NOOFDIGITS:                util.StringPointerOrEmpty(plan.PasswordNoofdigits),
 		PASSWORD_NOOFSPLCHARS:              util.StringPointerOrEmpty(plan.PasswordNoofsplchars),
-		HANAREFTABLEJSON:                   util.StringPointerOrEmpty(plan.Hanareftablejson),
-		ENABLEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Enableaccountjson),
-		UPDATEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Updateaccountjson),
-		USERIMPORTJSON:                     util.StringPointerOrEmpty(plan.Userimportjson),
-		STATUS_THRESHOLD_CONFIG:            util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		HANAREFTABLEJSON:                   util.StringPointerOrEmpty(plan.Hanareftablejson.StringValue),
+		ENABLEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Enableaccountjson.StringValue),
+		UPDATEACCOUNTJSON:                  util.StringPointerOrEmpty(plan.Updateaccountjson.StringValue),
+		USERIMPORTJSON:                     util.StringPointerOrEmpty(plan.Userimportjson.StringValue),
+		STATUS_THRESHOLD_CONFIG:            util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		SETCUASYSTEM:                       util.StringPointerOrEmpty(plan.Setcuasystem),
-		FIREFIGHTERID_GRANT_ACCESS_JSON:    util.StringPointerOrEmpty(plan.FirefighteridGrantAccessJson),
-		FIREFIGHTERID_REVOKE_ACCESS_JSON:   util.StringPointerOrEmpty(plan.FirefighteridRevokeAccessJson),
-		MODIFYUSERDATAJSON:                 util.StringPointerOrEmpty(plan.Modifyuserdatajson),
-		EXTERNAL_SOD_EVAL_JSON:             util.StringPointerOrEmpty(plan.ExternalSodEvalJson),
-		EXTERNAL_SOD_EVAL_JSON_DETAIL:      util.StringPointerOrEmpty(plan.ExternalSodEvalJsonDetail),
+		FIREFIGHTERID_GRANT_ACCESS_JSON:    util.StringPointerOrEmpty(plan.FirefighteridGrantAccessJson.StringValue),
+		FIREFIGHTERID_REVOKE_ACCESS_JSON:   util.StringPointerOrEmpty(plan.FirefighteridRevokeAccessJson.StringValue),
+		MODIFYUSERDATAJSON:                 util.StringPointerOrEmpty(plan.Modifyuserdatajson.StringValue),
+		EXTERNAL_SOD_EVAL_JSON:             util.StringPointerOrEmpty(plan.ExternalSodEvalJson.StringValue),
+		EXTERNAL_SOD_EVAL_JSON_DETAIL:      util.StringPointerOrEmpty(plan.ExternalSodEvalJsonDetail.StringValue),
 		LOGS_TABLE_FILTER:                  util.StringPointerOrEmpty(plan.LogsTableFilter),
-		PAM_CONFIG:                         util.StringPointerOrEmpty(plan.PamConfig),
+		PAM_CONFIG:                         util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 		SAPTABLE_FILTER_LANG:               util.StringPointerOrEmpty(plan.SaptableFilterLang),
 		ALTERNATE_OUTPUT_PARAMETER_ET_DATA: util.StringPointerOrEmpty(plan.AlternateOutputParameterEtData),
-		AUDIT_LOG_JSON:                     util.StringPointerOrEmpty(plan.AuditLogJson),
+		AUDIT_LOG_JSON:                     util.StringPointerOrEmpty(plan.AuditLogJson.StringValue),
 		ECCORS4HANA:                        util.StringPointerOrEmpty(plan.EccOrS4Hana),
 		DATA_IMPORT_FILTER:                 util.StringPointerOrEmpty(plan.DataImportFilter),
-		ConfigJSON:                         util.StringPointerOrEmpty(plan.Configjson),
+		ConfigJSON:                         util.StringPointerOrEmpty(plan.Configjson.StringValue),
 
 		ROLE_DEFAULT_DATE: util.StringPointerOrEmpty(plan.RoleDefaultDate),
 	}
@@ -742,7 +765,7 @@ func (r *SapConnectionResource) UpdateModelFromCreateResponse(plan *SapConnector
 	plan.Systemname = util.SafeStringDatasource(plan.Systemname.ValueStringPointer())
 	plan.Terminatedusergroup = util.SafeStringDatasource(plan.Terminatedusergroup.ValueStringPointer())
 	plan.TerminatedUserRoleAction = util.SafeStringDatasource(plan.TerminatedUserRoleAction.ValueStringPointer())
-	plan.Createaccountjson = util.SafeStringDatasource(plan.Createaccountjson.ValueStringPointer())
+	plan.Createaccountjson = customtypes.NewJSONStringPointerValue(plan.Createaccountjson.ValueStringPointer())
 	plan.ProvJcoAshost = util.SafeStringDatasource(plan.ProvJcoAshost.ValueStringPointer())
 	plan.ProvJcoSysnr = util.SafeStringDatasource(plan.ProvJcoSysnr.ValueStringPointer())
 	plan.ProvJcoClient = util.SafeStringDatasource(plan.ProvJcoClient.ValueStringPointer())
@@ -761,25 +784,25 @@ func (r *SapConnectionResource) UpdateModelFromCreateResponse(plan *SapConnector
 	plan.PasswordNoofcapsalpha = util.SafeStringDatasource(plan.PasswordNoofcapsalpha.ValueStringPointer())
 	plan.PasswordNoofdigits = util.SafeStringDatasource(plan.PasswordNoofdigits.ValueStringPointer())
 	plan.PasswordNoofsplchars = util.SafeStringDatasource(plan.PasswordNoofsplchars.ValueStringPointer())
-	plan.Hanareftablejson = util.SafeStringDatasource(plan.Hanareftablejson.ValueStringPointer())
-	plan.Enableaccountjson = util.SafeStringDatasource(plan.Enableaccountjson.ValueStringPointer())
-	plan.Updateaccountjson = util.SafeStringDatasource(plan.Updateaccountjson.ValueStringPointer())
-	plan.Userimportjson = util.SafeStringDatasource(plan.Userimportjson.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.Hanareftablejson = customtypes.NewJSONStringPointerValue(plan.Hanareftablejson.ValueStringPointer())
+	plan.Enableaccountjson = customtypes.NewJSONStringPointerValue(plan.Enableaccountjson.ValueStringPointer())
+	plan.Updateaccountjson = customtypes.NewJSONStringPointerValue(plan.Updateaccountjson.ValueStringPointer())
+	plan.Userimportjson = customtypes.NewJSONStringPointerValue(plan.Userimportjson.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.Setcuasystem = util.SafeStringDatasource(plan.Setcuasystem.ValueStringPointer())
-	plan.FirefighteridGrantAccessJson = util.SafeStringDatasource(plan.FirefighteridGrantAccessJson.ValueStringPointer())
-	plan.FirefighteridRevokeAccessJson = util.SafeStringDatasource(plan.FirefighteridRevokeAccessJson.ValueStringPointer())
-	plan.Modifyuserdatajson = util.SafeStringDatasource(plan.Modifyuserdatajson.ValueStringPointer())
-	plan.ExternalSodEvalJson = util.SafeStringDatasource(plan.ExternalSodEvalJson.ValueStringPointer())
-	plan.ExternalSodEvalJsonDetail = util.SafeStringDatasource(plan.ExternalSodEvalJsonDetail.ValueStringPointer())
+	plan.FirefighteridGrantAccessJson = customtypes.NewJSONStringPointerValue(plan.FirefighteridGrantAccessJson.ValueStringPointer())
+	plan.FirefighteridRevokeAccessJson = customtypes.NewJSONStringPointerValue(plan.FirefighteridRevokeAccessJson.ValueStringPointer())
+	plan.Modifyuserdatajson = customtypes.NewJSONStringPointerValue(plan.Modifyuserdatajson.ValueStringPointer())
+	plan.ExternalSodEvalJson = customtypes.NewJSONStringPointerValue(plan.ExternalSodEvalJson.ValueStringPointer())
+	plan.ExternalSodEvalJsonDetail = customtypes.NewJSONStringPointerValue(plan.ExternalSodEvalJsonDetail.ValueStringPointer())
 	plan.LogsTableFilter = util.SafeStringDatasource(plan.LogsTableFilter.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 	plan.SaptableFilterLang = util.SafeStringDatasource(plan.SaptableFilterLang.ValueStringPointer())
 	plan.AlternateOutputParameterEtData = util.SafeStringDatasource(plan.AlternateOutputParameterEtData.ValueStringPointer())
-	plan.AuditLogJson = util.SafeStringDatasource(plan.AuditLogJson.ValueStringPointer())
+	plan.AuditLogJson = customtypes.NewJSONStringPointerValue(plan.AuditLogJson.ValueStringPointer())
 	plan.EccOrS4Hana = util.SafeStringDatasource(plan.EccOrS4Hana.ValueStringPointer())
 	plan.DataImportFilter = util.SafeStringDatasource(plan.DataImportFilter.ValueStringPointer())
-	plan.Configjson = util.SafeStringDatasource(plan.Configjson.ValueStringPointer())
+	plan.Configjson = customtypes.NewJSONStringPointerValue(plan.Configjson.ValueStringPointer())
 
 	plan.RoleDefaultDate = util.SafeStringDatasource(plan.RoleDefaultDate.ValueStringPointer())
 
@@ -851,29 +874,29 @@ func (r *SapConnectionResource) UpdateModelFromReadResponse(state *SapConnectorR
 	state.Description = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Description)
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.SAPConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Emailtemplate)
-	state.Createaccountjson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
-	state.AuditLogJson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.AUDIT_LOG_JSON)
+	state.Createaccountjson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.CREATEACCOUNTJSON)
+	state.AuditLogJson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.AUDIT_LOG_JSON)
 	state.SaptableFilterLang = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.SAPTABLE_FILTER_LANG)
 	state.PasswordNoofsplchars = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PASSWORD_NOOFSPLCHARS)
 	state.Terminatedusergroup = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.TERMINATEDUSERGROUP)
 	state.LogsTableFilter = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.LOGS_TABLE_FILTER)
 	state.EccOrS4Hana = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ECCORS4HANA)
-	state.FirefighteridRevokeAccessJson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.FIREFIGHTERID_REVOKE_ACCESS_JSON)
-	state.Configjson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ConfigJSON)
-	state.FirefighteridGrantAccessJson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.FIREFIGHTERID_GRANT_ACCESS_JSON)
+	state.FirefighteridRevokeAccessJson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.FIREFIGHTERID_REVOKE_ACCESS_JSON)
+	state.Configjson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.ConfigJSON)
+	state.FirefighteridGrantAccessJson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.FIREFIGHTERID_GRANT_ACCESS_JSON)
 	state.JcoSncLibrary = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SNC_LIBRARY)
 	state.JcoR3Name = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCOR3NAME)
-	state.ExternalSodEvalJson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.EXTERNAL_SOD_EVAL_JSON)
+	state.ExternalSodEvalJson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.EXTERNAL_SOD_EVAL_JSON)
 	state.JcoAshost = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_ASHOST)
 	state.PasswordNoofdigits = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PASSWORD_NOOFDIGITS)
 	state.ProvJcoMshost = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROV_JCO_MSHOST)
-	state.PamConfig = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.PAM_CONFIG)
 	state.JcoSncMyname = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SNC_MYNAME)
 	state.Enforcepasswordchange = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ENFORCEPASSWORDCHANGE)
 	state.JcoUser = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_USER)
 	state.JcoSncMode = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SNC_MODE)
 	state.ProvJcoMsserv = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROV_JCO_MSSERV)
-	state.Hanareftablejson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.HANAREFTABLEJSON)
+	state.Hanareftablejson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.HANAREFTABLEJSON)
 	state.PasswordMinLength = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PASSWORD_MIN_LENGTH)
 	state.JcoClient = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_CLIENT)
 	state.TerminatedUserRoleAction = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.TERMINATED_USER_ROLE_ACTION)
@@ -885,7 +908,7 @@ func (r *SapConnectionResource) UpdateModelFromReadResponse(state *SapConnectorR
 	state.ProvJcoUser = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROV_JCO_USER)
 	state.JcoLang = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_LANG)
 	state.JcoSncPartnername = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SNC_PARTNERNAME)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
 	state.ProvJcoSysnr = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROV_JCO_SYSNR)
 	state.Setcuasystem = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.SETCUASYSTEM)
 	state.Messageserver = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.MESSAGESERVER)
@@ -895,22 +918,23 @@ func (r *SapConnectionResource) UpdateModelFromReadResponse(state *SapConnectorR
 	state.JcoMshost = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_MSHOST)
 	state.ProvJcoR3Name = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROVJCOR3NAME)
 	state.PasswordNoofcapsalpha = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PASSWORD_NOOFCAPSALPHA)
-	state.Modifyuserdatajson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.Modifyuserdatajson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
 	state.JcoSncQop = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SNC_QOP)
 	state.Tables = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.TABLES)
 	state.ProvJcoLang = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PROV_JCO_LANG)
 	state.JcoSysnr = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_SYSNR)
-	state.ExternalSodEvalJsonDetail = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.EXTERNAL_SOD_EVAL_JSON_DETAIL)
+	state.ExternalSodEvalJsonDetail = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.EXTERNAL_SOD_EVAL_JSON_DETAIL)
 	state.DataImportFilter = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.DATA_IMPORT_FILTER)
-	state.Enableaccountjson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
+	state.Enableaccountjson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.ENABLEACCOUNTJSON)
 	state.AlternateOutputParameterEtData = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ALTERNATE_OUTPUT_PARAMETER_ET_DATA)
 	state.JcoGroup = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.JCO_GROUP)
 	state.PasswordMaxLength = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.PASSWORD_MAX_LENGTH)
-	state.Userimportjson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.USERIMPORTJSON)
+	state.Userimportjson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.USERIMPORTJSON)
 	state.Systemname = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.SYSTEMNAME)
-	state.Updateaccountjson = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
+	state.Updateaccountjson = customtypes.NewJSONStringPointerValue(apiResp.SAPConnectionResponse.Connectionattributes.UPDATEACCOUNTJSON)
 
 	state.RoleDefaultDate = util.SafeStringDatasource(apiResp.SAPConnectionResponse.Connectionattributes.ROLE_DEFAULT_DATE)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.SAPConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *SapConnectionResource) ValidateSAPConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -944,7 +968,7 @@ func (r *SapConnectionResource) UpdateSAPConnection(ctx context.Context, plan *S
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_sap_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, sapConnRequest)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, sapConnRequest, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -1025,8 +1049,14 @@ func (r *SapConnectionResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateSAPConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateSAPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "SAP connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1081,7 +1111,9 @@ func (r *SapConnectionResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.SAPConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -1181,8 +1213,14 @@ func (r *SapConnectionResource) Update(ctx context.Context, req resource.UpdateR
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateSAPConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateSAPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "SAP connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1204,7 +1242,9 @@ func (r *SapConnectionResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
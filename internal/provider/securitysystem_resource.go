@@ -16,6 +16,7 @@ import (
 	"os"
 
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
 	"terraform-provider-Saviynt/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,34 +32,34 @@ var _ resource.ResourceWithImportState = &SecuritySystemResource{}
 
 // securitySystemResourceModel defines the state for our security system resource.
 type SecuritySystemResourceModel struct {
-	ID                                 types.String `tfsdk:"id"`
-	Systemname                         types.String `tfsdk:"systemname"`
-	DisplayName                        types.String `tfsdk:"display_name"`
-	Hostname                           types.String `tfsdk:"hostname"`
-	Port                               types.String `tfsdk:"port"`
-	AccessAddWorkflow                  types.String `tfsdk:"access_add_workflow"`
-	AccessRemoveWorkflow               types.String `tfsdk:"access_remove_workflow"`
-	AddServiceAccountWorkflow          types.String `tfsdk:"add_service_account_workflow"`
-	RemoveServiceAccountWorkflow       types.String `tfsdk:"remove_service_account_workflow"`
-	Connectionparameters               types.String `tfsdk:"connection_parameters"`
-	AutomatedProvisioning              types.String `tfsdk:"automated_provisioning"`
-	UseOpenConnector                   types.Bool   `tfsdk:"use_open_connector"`
-	ReconApplication                   types.String `tfsdk:"recon_application"`
-	InstantProvision                   types.String `tfsdk:"instant_provision"`
-	ProvisioningTries                  types.String `tfsdk:"provisioning_tries"`
-	Provisioningcomments               types.String `tfsdk:"provisioning_comments"`
-	ProposedAccountOwnersWorkflow      types.String `tfsdk:"proposed_account_owners_workflow"`
-	FirefighterIDWorkflow              types.String `tfsdk:"firefighterid_workflow"`
-	FirefighterIDRequestAccessWorkflow types.String `tfsdk:"firefighterid_request_access_workflow"`
-	PolicyRule                         types.String `tfsdk:"policy_rule"`
-	PolicyRuleServiceAccount           types.String `tfsdk:"policy_rule_service_account"`
-	Connectionname                     types.String `tfsdk:"connectionname"`
-	ProvisioningConnection             types.String `tfsdk:"provisioning_connection"`
-	ServiceDeskConnection              types.String `tfsdk:"service_desk_connection"`
-	ExternalRiskConnectionJson         types.String `tfsdk:"external_risk_connection_json"`
-	InherentSODReportFields            types.Set    `tfsdk:"inherent_sod_report_fields"`
-	Msg                                types.String `tfsdk:"msg"`
-	ErrorCode                          types.String `tfsdk:"error_code"`
+	ID                                 types.String           `tfsdk:"id"`
+	Systemname                         types.String           `tfsdk:"systemname"`
+	DisplayName                        types.String           `tfsdk:"display_name"`
+	Hostname                           types.String           `tfsdk:"hostname"`
+	Port                               types.String           `tfsdk:"port"`
+	AccessAddWorkflow                  types.String           `tfsdk:"access_add_workflow"`
+	AccessRemoveWorkflow               types.String           `tfsdk:"access_remove_workflow"`
+	AddServiceAccountWorkflow          types.String           `tfsdk:"add_service_account_workflow"`
+	RemoveServiceAccountWorkflow       types.String           `tfsdk:"remove_service_account_workflow"`
+	Connectionparameters               types.String           `tfsdk:"connection_parameters"`
+	AutomatedProvisioning              types.String           `tfsdk:"automated_provisioning"`
+	UseOpenConnector                   types.Bool             `tfsdk:"use_open_connector"`
+	ReconApplication                   types.String           `tfsdk:"recon_application"`
+	InstantProvision                   types.String           `tfsdk:"instant_provision"`
+	ProvisioningTries                  types.String           `tfsdk:"provisioning_tries"`
+	Provisioningcomments               types.String           `tfsdk:"provisioning_comments"`
+	ProposedAccountOwnersWorkflow      types.String           `tfsdk:"proposed_account_owners_workflow"`
+	FirefighterIDWorkflow              types.String           `tfsdk:"firefighterid_workflow"`
+	FirefighterIDRequestAccessWorkflow types.String           `tfsdk:"firefighterid_request_access_workflow"`
+	PolicyRule                         types.String           `tfsdk:"policy_rule"`
+	PolicyRuleServiceAccount           types.String           `tfsdk:"policy_rule_service_account"`
+	Connectionname                     types.String           `tfsdk:"connectionname"`
+	ProvisioningConnection             types.String           `tfsdk:"provisioning_connection"`
+	ServiceDeskConnection              types.String           `tfsdk:"service_desk_connection"`
+	ExternalRiskConnectionJson         customtypes.JSONString `tfsdk:"external_risk_connection_json"`
+	InherentSODReportFields            types.Set              `tfsdk:"inherent_sod_report_fields"`
+	Msg                                types.String           `tfsdk:"msg"`
+	ErrorCode                          types.String           `tfsdk:"error_code"`
 }
 
 type SecuritySystemResource struct {
@@ -208,6 +209,7 @@ func (r *SecuritySystemResource) Schema(ctx context.Context, req resource.Schema
 				Description: "Use this flag to prevent users from raising duplicate requests for the same applications.",
 			},
 			"external_risk_connection_json": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Contains JSON configuration for external risk connections and is applicable only for a few connections like SAP.",
@@ -428,7 +430,7 @@ func (r *SecuritySystemResource) BuildUpdateSecuritySystemRequest(plan *Security
 		Connectionname:                     util.StringPointerOrEmpty(plan.Connectionname),
 		ProvisioningConnection:             util.StringPointerOrEmpty(plan.ProvisioningConnection),
 		ServiceDeskConnection:              util.StringPointerOrEmpty(plan.ServiceDeskConnection),
-		ExternalRiskConnectionJson:         util.StringPointerOrEmpty(plan.ExternalRiskConnectionJson),
+		ExternalRiskConnectionJson:         util.StringPointerOrEmpty(plan.ExternalRiskConnectionJson.StringValue),
 		InherentSODReportFields:            util.StringsFromSet(plan.InherentSODReportFields),
 	}
 }
@@ -470,7 +472,7 @@ func (r *SecuritySystemResource) UpdateModelFromCreateResponse(plan *SecuritySys
 	plan.ServiceDeskConnection = util.SafeString(plan.ServiceDeskConnection.ValueStringPointer())
 	plan.PolicyRule = util.SafeString(plan.PolicyRule.ValueStringPointer())
 	plan.PolicyRuleServiceAccount = util.SafeString(plan.PolicyRuleServiceAccount.ValueStringPointer())
-	plan.ExternalRiskConnectionJson = util.SafeString(plan.ExternalRiskConnectionJson.ValueStringPointer())
+	plan.ExternalRiskConnectionJson = customtypes.NewJSONStringValue(util.SafeDeref(plan.ExternalRiskConnectionJson.ValueStringPointer()))
 	plan.InherentSODReportFields = util.NormalizeTFSetString(plan.InherentSODReportFields)
 
 	// Set API response fields
@@ -645,7 +647,7 @@ func (r *SecuritySystemResource) UpdateModelFromReadResponse(plan *SecuritySyste
 	plan.Connectionname = util.SafeString(details.Connection)
 	plan.ProvisioningConnection = util.SafeString(details.ProvisioningConnection)
 	plan.ServiceDeskConnection = util.SafeString(details.ServiceDeskConnection)
-	plan.ExternalRiskConnectionJson = util.SafeString(details.ExternalRiskConnectionJson)
+	plan.ExternalRiskConnectionJson = customtypes.NewJSONStringValue(util.SafeDeref(details.ExternalRiskConnectionJson))
 	plan.InherentSODReportFields = util.StringsToSet(details.InherentSODReportFields)
 
 	// Set API response fields
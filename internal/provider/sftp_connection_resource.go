@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -39,18 +40,18 @@ var sftpErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeS
 
 type SFTPConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                    types.String `tfsdk:"id"`
-	HostName              types.String `tfsdk:"host_name"`
-	PortNumber            types.String `tfsdk:"port_number"`
-	Username              types.String `tfsdk:"username"`
-	AuthCredentialType    types.String `tfsdk:"auth_credential_type"`
-	AuthCredentialValue   types.String `tfsdk:"auth_credential_value"`
-	AuthCredentialValueWo types.String `tfsdk:"auth_credential_value_wo"`
-	Passphrase            types.String `tfsdk:"passphrase"`
-	PassphraseWo          types.String `tfsdk:"passphrase_wo"`
-	FilesToGet            types.String `tfsdk:"files_to_get"`
-	FilesToPut            types.String `tfsdk:"files_to_put"`
-	PamConfig             types.String `tfsdk:"pam_config"`
+	ID                    types.String           `tfsdk:"id"`
+	HostName              types.String           `tfsdk:"host_name"`
+	PortNumber            types.String           `tfsdk:"port_number"`
+	Username              types.String           `tfsdk:"username"`
+	AuthCredentialType    types.String           `tfsdk:"auth_credential_type"`
+	AuthCredentialValue   types.String           `tfsdk:"auth_credential_value"`
+	AuthCredentialValueWo types.String           `tfsdk:"auth_credential_value_wo"`
+	Passphrase            types.String           `tfsdk:"passphrase"`
+	PassphraseWo          types.String           `tfsdk:"passphrase_wo"`
+	FilesToGet            types.String           `tfsdk:"files_to_get"`
+	FilesToPut            types.String           `tfsdk:"files_to_put"`
+	PamConfig             customtypes.JSONString `tfsdk:"pam_config"`
 }
 
 type SftpConnectionResource struct {
@@ -141,6 +142,7 @@ func SFTPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Files to upload to SFTP server. Example: \"upload/*.txt\"",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "PAM configuration for SFTP connection.",
@@ -241,7 +243,7 @@ func (r *SftpConnectionResource) BuildSFTPConnector(plan *SFTPConnectorResourceM
 		PASSPHRASE:   util.StringPointerOrEmpty(types.StringValue(passphrase)),
 		FILES_TO_GET: util.StringPointerOrEmpty(plan.FilesToGet),
 		FILES_TO_PUT: util.StringPointerOrEmpty(plan.FilesToPut),
-		PAM_CONFIG:   util.StringPointerOrEmpty(plan.PamConfig),
+		PAM_CONFIG:   util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 	}
 
 	if plan.VaultConnection.ValueString() != "" {
@@ -265,7 +267,7 @@ func (r *SftpConnectionResource) UpdateModelFromCreateResponse(plan *SFTPConnect
 
 	plan.FilesToGet = util.SafeStringDatasource(plan.FilesToGet.ValueStringPointer())
 	plan.FilesToPut = util.SafeStringDatasource(plan.FilesToPut.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 }
 
 func (r *SftpConnectionResource) CreateSFTPConnection(ctx context.Context, plan *SFTPConnectorResourceModel, config *SFTPConnectorResourceModel) (*openapi.CreateOrUpdateResponse, error) {
@@ -327,7 +329,7 @@ func (r *SftpConnectionResource) CreateSFTPConnection(ctx context.Context, plan
 	tflog.Debug(ctx, "Executing create operation")
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_sftp_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -442,7 +444,7 @@ func (r *SftpConnectionResource) UpdateSFTPConnection(ctx context.Context, plan
 	tflog.Debug(logCtx, "Executing update operation")
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_sftp_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -493,7 +495,8 @@ func (r *SftpConnectionResource) UpdateModelFromReadResponse(state *SFTPConnecto
 	state.AuthCredentialType = util.SafeStringDatasource(apiResp.SFTPConnectionResponse.Connectionattributes.AUTH_CREDENTIAL_TYPE)
 	state.FilesToGet = util.SafeStringDatasource(apiResp.SFTPConnectionResponse.Connectionattributes.FILES_TO_GET)
 	state.FilesToPut = util.SafeStringDatasource(apiResp.SFTPConnectionResponse.Connectionattributes.FILES_TO_PUT)
-	state.PamConfig = util.SafeStringDatasource(apiResp.SFTPConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.PamConfig = customtypes.NewJSONStringPointerValue(apiResp.SFTPConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.SFTPConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 	// Note: AUTH_CREDENTIAL_VALUE and PASSPHRASE are not read from API for security reasons
 }
 
@@ -543,8 +546,14 @@ func (r *SftpConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateSFTPConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateSFTPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "SFTP connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -599,7 +608,9 @@ func (r *SftpConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 	apiMessage := util.SafeDeref(apiResp.SFTPConnectionResponse.Msg)
 	if apiMessage == "success" {
 		state.Msg = types.StringValue("Connection Read Successful")
@@ -692,8 +703,14 @@ func (r *SftpConnectionResource) Update(ctx context.Context, req resource.Update
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateSFTPConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateSFTPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "SFTP connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -715,7 +732,9 @@ func (r *SftpConnectionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// saviynt_transport_package inspects an exported transport package on local disk and lists the
+// objects it contains together with their checksums. No calls are made to the Saviynt server, so
+// the contents of a package can be reviewed in the plan before it is imported.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-Saviynt/util"
+	"terraform-provider-Saviynt/util/transportutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &transportPackageDataSource{}
+
+// transportPackageDataSource reads transport packages from local disk
+type transportPackageDataSource struct{}
+
+type TransportPackageDataSourceModel struct {
+	ID            types.String                    `tfsdk:"id"`
+	PackagePath   types.String                    `tfsdk:"package_path"`
+	ObjectTypes   types.Set                       `tfsdk:"object_types"`
+	FileName      types.String                    `tfsdk:"file_name"`
+	PackageSHA256 types.String                    `tfsdk:"package_sha256"`
+	PackageSize   types.Int64                     `tfsdk:"package_size"`
+	ObjectCount   types.Int64                     `tfsdk:"object_count"`
+	ObjectNames   map[string][]types.String       `tfsdk:"object_names"`
+	Objects       []TransportPackageObjectDetails `tfsdk:"objects"`
+}
+
+// TransportPackageObjectDetails represents a single object within a transport package
+type TransportPackageObjectDetails struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	Path       types.String `tfsdk:"path"`
+	SHA256     types.String `tfsdk:"sha256"`
+	Size       types.Int64  `tfsdk:"size"`
+}
+
+// NewTransportPackageDataSource creates a new transport package data source
+func NewTransportPackageDataSource() datasource.DataSource {
+	return &transportPackageDataSource{}
+}
+
+func (d *transportPackageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transport_package"
+}
+
+func (d *transportPackageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: util.TransportPackageDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 checksum of the transport package.",
+			},
+			"package_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Local path of the exported transport package zip file.",
+			},
+			"object_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list objects of these types. Valid values are 'roles', 'sav_roles', 'workflows', 'connections', 'security_systems', 'email_templates', 'analytics' and 'other'.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(transportutil.ObjectTypes...)),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "File name of the transport package.",
+			},
+			"package_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 checksum of the transport package.",
+			},
+			"package_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the transport package in bytes.",
+			},
+			"object_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of objects listed in objects.",
+			},
+			"object_names": schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Computed:    true,
+				Description: "Names of the listed objects grouped by object type.",
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Objects contained in the transport package, ordered by type and name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type": schema.StringAttribute{Computed: true, Description: "Type of the object."},
+						"object_name": schema.StringAttribute{Computed: true, Description: "Name of the object."},
+						"path":        schema.StringAttribute{Computed: true, Description: "Path of the object within the package."},
+						"sha256":      schema.StringAttribute{Computed: true, Description: "SHA256 checksum of the object content."},
+						"size":        schema.Int64Attribute{Computed: true, Description: "Uncompressed size of the object in bytes."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *transportPackageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TransportPackageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objectTypes []string
+	if !state.ObjectTypes.IsNull() && !state.ObjectTypes.IsUnknown() {
+		resp.Diagnostics.Append(state.ObjectTypes.ElementsAs(ctx, &objectTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	packagePath := state.PackagePath.ValueString()
+	tflog.Debug(ctx, "Inspecting transport package", map[string]interface{}{
+		"package_path": packagePath,
+	})
+
+	pkg, err := transportutil.Inspect(packagePath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Transport Package Inspection Failed",
+			fmt.Sprintf("Unable to inspect transport package: %v", err),
+		)
+		return
+	}
+
+	objects := pkg.Filter(objectTypes)
+	objectNames := make(map[string][]types.String)
+	state.Objects = make([]TransportPackageObjectDetails, 0, len(objects))
+	for _, obj := range objects {
+		state.Objects = append(state.Objects, TransportPackageObjectDetails{
+			ObjectType: types.StringValue(obj.Type),
+			ObjectName: types.StringValue(obj.Name),
+			Path:       types.StringValue(obj.Path),
+			SHA256:     types.StringValue(obj.SHA256),
+			Size:       types.Int64Value(obj.Size),
+		})
+		objectNames[obj.Type] = append(objectNames[obj.Type], types.StringValue(obj.Name))
+	}
+
+	state.ID = types.StringValue(pkg.SHA256)
+	state.FileName = types.StringValue(pkg.FileName)
+	state.PackageSHA256 = types.StringValue(pkg.SHA256)
+	state.PackageSize = types.Int64Value(pkg.Size)
+	state.ObjectCount = types.Int64Value(int64(len(objects)))
+	state.ObjectNames = objectNames
+
+	tflog.Info(ctx, "Transport package inspected", map[string]interface{}{
+		"package_path": packagePath,
+		"object_count": len(objects),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// transport_package_status.go contains the shared status polling used by the
+// import and export transport package resources to wait for a transport request
+// to reach a terminal state.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"terraform-provider-Saviynt/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapi "github.com/saviynt/saviynt-api-go-client/transports"
+)
+
+const (
+	defaultTransportStatusTimeout      = 1800
+	defaultTransportStatusPollInterval = 15
+)
+
+// TransportStatusSchemaAttributes returns the attributes shared by the transport package
+// resources that control and report status polling.
+func TransportStatusSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"wait_for_completion": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Wait for the transport request to reach a terminal status before completing the apply. Defaults to true.",
+		},
+		"status_timeout": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(defaultTransportStatusTimeout),
+			Description: "Maximum time in seconds to wait for the transport request to complete. Defaults to 1800.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"status_poll_interval": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(defaultTransportStatusPollInterval),
+			Description: "Interval in seconds between transport status checks. Defaults to 15.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Final status of the transport request, the msg field of the transport status API.",
+		},
+		"status_msg_description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Detailed description returned by the transport status API.",
+		},
+	}
+}
+
+// WaitForTransportPackageStatus polls the transport status API until the request reaches a
+// terminal status or the timeout expires. The last status response is returned together with
+// an error when the request failed or did not complete in time.
+func WaitForTransportPackageStatus(ctx context.Context, provider client.SaviyntProviderInterface, apiClient client.SaviyntClientInterface, factory client.TransportFactoryInterface, statusReq openapi.TransportPackageStatusRequest, timeout, interval time.Duration) (*openapi.TransportPackageStatusResponse, error) {
+	deadline := time.Now().Add(timeout)
+	var statusResp *openapi.TransportPackageStatusResponse
+
+	for {
+		err := provider.AuthenticatedAPICallWithRetry(ctx, fmt.Sprintf("%s_transport_package_status", statusReq.Operation), func(token string) error {
+			transportOps := factory.CreateTransportOperations(apiClient.APIBaseURL(), token)
+			resp, httpResp, err := transportOps.TransportPackageStatus(ctx, statusReq)
+			if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
+				return fmt.Errorf("401 unauthorized")
+			}
+			statusResp = resp
+			return err
+		})
+		if err != nil {
+			return statusResp, fmt.Errorf("failed to fetch transport package status: %w", err)
+		}
+
+		terminal, failed := TransportStatusIsTerminal(statusResp)
+		tflog.Debug(ctx, "Polled transport package status", map[string]interface{}{
+			"operation": statusReq.Operation,
+			"filename":  statusReq.Filename,
+			"status":    transportStatusText(statusResp),
+			"terminal":  terminal,
+		})
+		if terminal {
+			if failed {
+				return statusResp, fmt.Errorf("transport package %s failed - Status: %s, Description: %s",
+					statusReq.Operation, transportStatusText(statusResp), statusResp.GetMsgDescription())
+			}
+			return statusResp, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return statusResp, fmt.Errorf("timed out after %s waiting for transport package %s to complete, last status: %q, description: %q",
+				timeout, statusReq.Operation, transportStatusText(statusResp), statusResp.GetMsgDescription())
+		}
+
+		select {
+		case <-ctx.Done():
+			return statusResp, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// TransportStatusIsTerminal reports whether the status response describes a finished transport
+// request and, if so, whether it failed. The transport status API documents only msg,
+// msgDescription and errorCode, so the state is read from the text of msg and msgDescription.
+// A non-zero error code or a failure text is a failure, a completion text without a pending text
+// is a success, and an empty or unrecognised text is not terminal, so polling continues until the
+// timeout instead of reporting success.
+func TransportStatusIsTerminal(resp *openapi.TransportPackageStatusResponse) (terminal bool, failed bool) {
+	if resp == nil {
+		return false, false
+	}
+	if resp.ErrorCode != nil && *resp.ErrorCode != 0 {
+		return true, true
+	}
+
+	text := strings.ToLower(resp.GetMsg() + " " + resp.GetMsgDescription())
+	if containsAnyTransportStatus(text, pendingTransportStatuses) {
+		return false, false
+	}
+	if containsAnyTransportStatus(text, failedTransportStatuses) {
+		return true, true
+	}
+	if containsAnyTransportStatus(text, completedTransportStatuses) {
+		return true, false
+	}
+	return false, false
+}
+
+var (
+	pendingTransportStatuses   = []string{"progress", "pending", "queued", "running", "submitted", "initiated", "processing"}
+	failedTransportStatuses    = []string{"fail", "error", "abort"}
+	completedTransportStatuses = []string{"success", "complete", "done", "finished"}
+)
+
+func containsAnyTransportStatus(text string, statuses []string) bool {
+	for _, s := range statuses {
+		if strings.Contains(text, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// transportStatusText returns the msg field of the status response
+func transportStatusText(resp *openapi.TransportPackageStatusResponse) string {
+	if resp == nil {
+		return ""
+	}
+	return resp.GetMsg()
+}
+
+// TransportStatusToModel converts a status response into the computed status attributes
+func TransportStatusToModel(resp *openapi.TransportPackageStatusResponse) (types.String, types.String) {
+	if resp == nil {
+		return types.StringNull(), types.StringNull()
+	}
+	return types.StringValue(transportStatusText(resp)), types.StringValue(resp.GetMsgDescription())
+}
+
+// NullTransportStatus returns the computed status attributes for a request that was not polled
+func NullTransportStatus() (types.String, types.String) {
+	return types.StringNull(), types.StringNull()
+}
@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	connectionsutil "terraform-provider-Saviynt/util/connectionsutil"
 	"terraform-provider-Saviynt/util/errorsutil"
@@ -38,47 +40,47 @@ var unixErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTypeU
 
 type UnixConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                            types.String `tfsdk:"id"`
-	HostName                      types.String `tfsdk:"host_name"`
-	PortNumber                    types.String `tfsdk:"port_number"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	PasswordWo                    types.String `tfsdk:"password_wo"`
-	GroupsFile                    types.String `tfsdk:"groups_file"`
-	AccountsFile                  types.String `tfsdk:"accounts_file"`
-	ShadowFile                    types.String `tfsdk:"shadow_file"`
-	ProvisionAccountCommand       types.String `tfsdk:"provision_account_command"`
-	DeprovisionAccountCommand     types.String `tfsdk:"deprovision_account_command"`
-	AddAccessCommand              types.String `tfsdk:"add_access_command"`
-	RemoveAccessCommand           types.String `tfsdk:"remove_access_command"`
-	ChangePasswordJSON            types.String `tfsdk:"change_password_json"`
-	PemKeyFile                    types.String `tfsdk:"pem_key_file"`
-	EnableAccountCommand          types.String `tfsdk:"enable_account_command"`
-	DisableAccountCommand         types.String `tfsdk:"disable_account_command"`
-	AccountEntitlementMappingCmd  types.String `tfsdk:"account_entitlement_mapping_command"`
-	Passphrase                    types.String `tfsdk:"passphrase"`
-	PassphraseWo                  types.String `tfsdk:"passphrase_wo"`
-	UpdateAccountCommand          types.String `tfsdk:"update_account_command"`
-	CreateGroupCommand            types.String `tfsdk:"create_group_command"`
-	DeleteGroupCommand            types.String `tfsdk:"delete_group_command"`
-	AddGroupOwnerCommand          types.String `tfsdk:"add_group_owner_command"`
-	AddPrimaryGroupCommand        types.String `tfsdk:"add_primary_group_command"`
-	FirefighterGrantAccessCommand types.String `tfsdk:"fire_fighter_id_grant_access_command"`
-	FirefighterRevokeAccessCmd    types.String `tfsdk:"fire_fighter_id_revoke_access_command"`
-	InactiveLockAccount           types.String `tfsdk:"inactive_lock_account"`
-	StatusThresholdConfig         types.String `tfsdk:"status_threshold_config"`
-	CustomConfigJSON              types.String `tfsdk:"custom_config_json"`
-	SSHKey                        types.String `tfsdk:"ssh_key"`
-	SSHKeyWo                      types.String `tfsdk:"ssh_key_wo"`
-	LockAccountCommand            types.String `tfsdk:"lock_account_command"`
-	UnlockAccountCommand          types.String `tfsdk:"unlock_account_command"`
-	PassThroughConnectionDetails  types.String `tfsdk:"pass_through_connection_details"`
-	SSHPassThroughPassword        types.String `tfsdk:"ssh_pass_through_password"`
-	SSHPassThroughPasswordWo      types.String `tfsdk:"ssh_pass_through_password_wo"`
-	SSHPassThroughSSHKEY          types.String `tfsdk:"ssh_pass_through_sshkey"`
-	SSHPassThroughSSHKEYWo        types.String `tfsdk:"ssh_pass_through_sshkey_wo"`
-	SSHPassThroughPassphrase      types.String `tfsdk:"ssh_pass_through_passphrase"`
-	SSHPassThroughPassphraseWo    types.String `tfsdk:"ssh_pass_through_passphrase_wo"`
+	ID                            types.String           `tfsdk:"id"`
+	HostName                      types.String           `tfsdk:"host_name"`
+	PortNumber                    types.String           `tfsdk:"port_number"`
+	Username                      types.String           `tfsdk:"username"`
+	Password                      types.String           `tfsdk:"password"`
+	PasswordWo                    types.String           `tfsdk:"password_wo"`
+	GroupsFile                    types.String           `tfsdk:"groups_file"`
+	AccountsFile                  types.String           `tfsdk:"accounts_file"`
+	ShadowFile                    types.String           `tfsdk:"shadow_file"`
+	ProvisionAccountCommand       types.String           `tfsdk:"provision_account_command"`
+	DeprovisionAccountCommand     types.String           `tfsdk:"deprovision_account_command"`
+	AddAccessCommand              types.String           `tfsdk:"add_access_command"`
+	RemoveAccessCommand           types.String           `tfsdk:"remove_access_command"`
+	ChangePasswordJSON            customtypes.JSONString `tfsdk:"change_password_json"`
+	PemKeyFile                    types.String           `tfsdk:"pem_key_file"`
+	EnableAccountCommand          types.String           `tfsdk:"enable_account_command"`
+	DisableAccountCommand         types.String           `tfsdk:"disable_account_command"`
+	AccountEntitlementMappingCmd  types.String           `tfsdk:"account_entitlement_mapping_command"`
+	Passphrase                    types.String           `tfsdk:"passphrase"`
+	PassphraseWo                  types.String           `tfsdk:"passphrase_wo"`
+	UpdateAccountCommand          types.String           `tfsdk:"update_account_command"`
+	CreateGroupCommand            types.String           `tfsdk:"create_group_command"`
+	DeleteGroupCommand            types.String           `tfsdk:"delete_group_command"`
+	AddGroupOwnerCommand          types.String           `tfsdk:"add_group_owner_command"`
+	AddPrimaryGroupCommand        types.String           `tfsdk:"add_primary_group_command"`
+	FirefighterGrantAccessCommand types.String           `tfsdk:"fire_fighter_id_grant_access_command"`
+	FirefighterRevokeAccessCmd    types.String           `tfsdk:"fire_fighter_id_revoke_access_command"`
+	InactiveLockAccount           types.String           `tfsdk:"inactive_lock_account"`
+	StatusThresholdConfig         customtypes.JSONString `tfsdk:"status_threshold_config"`
+	CustomConfigJSON              customtypes.JSONString `tfsdk:"custom_config_json"`
+	SSHKey                        types.String           `tfsdk:"ssh_key"`
+	SSHKeyWo                      types.String           `tfsdk:"ssh_key_wo"`
+	LockAccountCommand            types.String           `tfsdk:"lock_account_command"`
+	UnlockAccountCommand          types.String           `tfsdk:"unlock_account_command"`
+	PassThroughConnectionDetails  types.String           `tfsdk:"pass_through_connection_details"`
+	SSHPassThroughPassword        types.String           `tfsdk:"ssh_pass_through_password"`
+	SSHPassThroughPasswordWo      types.String           `tfsdk:"ssh_pass_through_password_wo"`
+	SSHPassThroughSSHKEY          types.String           `tfsdk:"ssh_pass_through_sshkey"`
+	SSHPassThroughSSHKEYWo        types.String           `tfsdk:"ssh_pass_through_sshkey_wo"`
+	SSHPassThroughPassphrase      types.String           `tfsdk:"ssh_pass_through_passphrase"`
+	SSHPassThroughPassphraseWo    types.String           `tfsdk:"ssh_pass_through_passphrase_wo"`
 
 	//25.B.1
 	ServerType types.String `tfsdk:"server_type"`
@@ -178,6 +180,7 @@ func UnixConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Property for REMOVE_ACCESS_COMMAND",
 		},
 		"change_password_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Property for CHANGE_PASSWRD_JSON",
@@ -259,14 +262,23 @@ func UnixConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Property for INACTIVE_LOCK_ACCOUNT",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Property for STATUS_THRESHOLD_CONFIG",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"custom_config_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
-			Description: "Property for CUSTOM_CONFIG_JSON",
+			Description: "Property for CUSTOM_CONFIG_JSON. Holds the timeout settings of the connection, such as connectionTimeout and retryCount; cannot set them when connection_timeout_config is set.",
+			Validators: []validator.String{
+				validators.JSONSchema("connection_timeout_config"),
+				CustomConfigJSONTimeoutConflict(),
+			},
 		},
 		"ssh_key": schema.StringAttribute{
 			Optional:    true,
@@ -480,7 +492,7 @@ func (r *UnixConnectionResource) BuildUnixConnector(plan *UnixConnectorResourceM
 		DEPROVISION_ACCOUNT_COMMAND:         util.StringPointerOrEmpty(plan.DeprovisionAccountCommand),
 		ADD_ACCESS_COMMAND:                  util.StringPointerOrEmpty(plan.AddAccessCommand),
 		REMOVE_ACCESS_COMMAND:               util.StringPointerOrEmpty(plan.RemoveAccessCommand),
-		CHANGE_PASSWRD_JSON:                 util.StringPointerOrEmpty(plan.ChangePasswordJSON),
+		CHANGE_PASSWRD_JSON:                 util.StringPointerOrEmpty(plan.ChangePasswordJSON.StringValue),
 		PEM_KEY_FILE:                        util.StringPointerOrEmpty(plan.PemKeyFile),
 		ENABLE_ACCOUNT_COMMAND:              util.StringPointerOrEmpty(plan.EnableAccountCommand),
 		DISABLE_ACCOUNT_COMMAND:             util.StringPointerOrEmpty(plan.DisableAccountCommand),
@@ -494,8 +506,8 @@ func (r *UnixConnectionResource) BuildUnixConnector(plan *UnixConnectorResourceM
 		FIREFIGHTERID_GRANT_ACCESS_COMMAND:  util.StringPointerOrEmpty(plan.FirefighterGrantAccessCommand),
 		FIREFIGHTERID_REVOKE_ACCESS_COMMAND: util.StringPointerOrEmpty(plan.FirefighterRevokeAccessCmd),
 		INACTIVE_LOCK_ACCOUNT:               util.StringPointerOrEmpty(plan.InactiveLockAccount),
-		STATUS_THRESHOLD_CONFIG:             util.StringPointerOrEmpty(plan.StatusThresholdConfig),
-		CUSTOM_CONFIG_JSON:                  util.StringPointerOrEmpty(plan.CustomConfigJSON),
+		STATUS_THRESHOLD_CONFIG:             util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
+		CUSTOM_CONFIG_JSON:                  util.StringPointerOrEmpty(plan.CustomConfigJSON.StringValue),
 		SSH_KEY:                             util.StringPointerOrEmpty(types.StringValue(sshKey)),
 		LOCK_ACCOUNT_COMMAND:                util.StringPointerOrEmpty(plan.LockAccountCommand),
 		UNLOCK_ACCOUNT_COMMAND:              util.StringPointerOrEmpty(plan.UnlockAccountCommand),
@@ -533,7 +545,7 @@ func (r *UnixConnectionResource) UpdateModelFromCreateResponse(plan *UnixConnect
 	plan.DeprovisionAccountCommand = util.SafeStringDatasource(plan.DeprovisionAccountCommand.ValueStringPointer())
 	plan.AddAccessCommand = util.SafeStringDatasource(plan.AddAccessCommand.ValueStringPointer())
 	plan.RemoveAccessCommand = util.SafeStringDatasource(plan.RemoveAccessCommand.ValueStringPointer())
-	plan.ChangePasswordJSON = util.SafeStringDatasource(plan.ChangePasswordJSON.ValueStringPointer())
+	plan.ChangePasswordJSON = customtypes.NewJSONStringPointerValue(plan.ChangePasswordJSON.ValueStringPointer())
 	plan.PemKeyFile = util.SafeStringDatasource(plan.PemKeyFile.ValueStringPointer())
 	plan.EnableAccountCommand = util.SafeStringDatasource(plan.EnableAccountCommand.ValueStringPointer())
 	plan.DisableAccountCommand = util.SafeStringDatasource(plan.DisableAccountCommand.ValueStringPointer())
@@ -546,8 +558,8 @@ func (r *UnixConnectionResource) UpdateModelFromCreateResponse(plan *UnixConnect
 	plan.FirefighterGrantAccessCommand = util.SafeStringDatasource(plan.FirefighterGrantAccessCommand.ValueStringPointer())
 	plan.FirefighterRevokeAccessCmd = util.SafeStringDatasource(plan.FirefighterRevokeAccessCmd.ValueStringPointer())
 	plan.InactiveLockAccount = util.SafeStringDatasource(plan.InactiveLockAccount.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
-	plan.CustomConfigJSON = util.SafeStringDatasource(plan.CustomConfigJSON.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.CustomConfigJSON = customtypes.NewJSONStringPointerValue(plan.CustomConfigJSON.ValueStringPointer())
 	plan.LockAccountCommand = util.SafeStringDatasource(plan.LockAccountCommand.ValueStringPointer())
 	plan.UnlockAccountCommand = util.SafeStringDatasource(plan.UnlockAccountCommand.ValueStringPointer())
 	plan.PassThroughConnectionDetails = util.SafeStringDatasource(plan.PassThroughConnectionDetails.ValueStringPointer())
@@ -616,7 +628,7 @@ func (r *UnixConnectionResource) CreateUnixConnection(ctx context.Context, plan
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_unix_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -726,7 +738,7 @@ func (r *UnixConnectionResource) UpdateModelFromReadResponse(state *UnixConnecto
 		state.DeprovisionAccountCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.DEPROVISION_ACCOUNT_COMMAND)
 		state.AddAccessCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.ADD_ACCESS_COMMAND)
 		state.RemoveAccessCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.REMOVE_ACCESS_COMMAND)
-		state.ChangePasswordJSON = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.CHANGE_PASSWRD_JSON)
+		state.ChangePasswordJSON = customtypes.NewJSONStringPointerValue(apiResp.UNIXConnectionResponse.Connectionattributes.CHANGE_PASSWRD_JSON)
 		state.PemKeyFile = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.PEM_KEY_FILE)
 		state.EnableAccountCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.ENABLE_ACCOUNT_COMMAND)
 		state.DisableAccountCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.DISABLE_ACCOUNT_COMMAND)
@@ -739,12 +751,13 @@ func (r *UnixConnectionResource) UpdateModelFromReadResponse(state *UnixConnecto
 		state.FirefighterGrantAccessCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.FIREFIGHTERID_GRANT_ACCESS_COMMAND)
 		state.FirefighterRevokeAccessCmd = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.FIREFIGHTERID_REVOKE_ACCESS_COMMAND)
 		state.InactiveLockAccount = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.INACTIVE_LOCK_ACCOUNT)
-		state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
-		state.CustomConfigJSON = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.CUSTOM_CONFIG_JSON)
+		state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.UNIXConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+		state.CustomConfigJSON = customtypes.NewJSONStringPointerValue(apiResp.UNIXConnectionResponse.Connectionattributes.CUSTOM_CONFIG_JSON)
 		state.LockAccountCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.LOCK_ACCOUNT_COMMAND)
 		state.UnlockAccountCommand = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.UNLOCK_ACCOUNT_COMMAND)
 		state.PassThroughConnectionDetails = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.PassThroughConnectionDetails)
 		state.ServerType = util.SafeStringDatasource(apiResp.UNIXConnectionResponse.Connectionattributes.SERVER_TYPE)
+		state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.UNIXConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 	}
 }
 
@@ -779,7 +792,7 @@ func (r *UnixConnectionResource) UpdateUnixConnection(ctx context.Context, plan
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_unix_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -860,8 +873,14 @@ func (r *UnixConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateUnixConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateUnixConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Unix connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -916,7 +935,9 @@ func (r *UnixConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.UNIXConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -1016,8 +1037,14 @@ func (r *UnixConnectionResource) Update(ctx context.Context, req resource.Update
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateUnixConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateUnixConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Unix connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1039,7 +1066,9 @@ func (r *UnixConnectionResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CustomPropertyIndex returns the index of a custom_properties key. Keys are the number of the
+// property ("12"), optionally prefixed with "custom_property" or "customproperty".
+func CustomPropertyIndex(key string) (int, bool) {
+	trimmed := strings.ToLower(strings.TrimSpace(key))
+	for _, prefix := range []string{"custom_property", "customproperty"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			trimmed = strings.TrimPrefix(trimmed, prefix)
+			break
+		}
+	}
+	index, err := strconv.Atoi(trimmed)
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// CustomPropertyKeys returns a validator for custom_properties maps. It rejects keys that are not
+// a custom property number between 1 and count, two keys for the same property and null values.
+func CustomPropertyKeys(count int) validator.Map {
+	return customPropertyKeysValidator{count: count}
+}
+
+// CustomPropertyKeysOrLabels returns a validator for custom_properties maps whose keys can also be
+// custom property labels. Keys that are not a custom property number are accepted as labels and
+// must be resolved by the resource at plan time; number keys are checked as in CustomPropertyKeys.
+func CustomPropertyKeysOrLabels(count int) validator.Map {
+	return customPropertyKeysValidator{count: count, allowLabels: true}
+}
+
+type customPropertyKeysValidator struct {
+	count       int
+	allowLabels bool
+}
+
+func (v customPropertyKeysValidator) Description(_ context.Context) string {
+	if v.allowLabels {
+		return fmt.Sprintf("Keys must be custom property numbers between 1 and %d or custom property labels and values must not be null.", v.count)
+	}
+	return fmt.Sprintf("Keys must be custom property numbers between 1 and %d and values must not be null.", v.count)
+}
+
+func (v customPropertyKeysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v customPropertyKeysValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seen := make(map[int]string)
+	for _, key := range keys {
+		value := elements[key]
+		keyPath := req.Path.AtMapKey(key)
+		index, ok := CustomPropertyIndex(key)
+		// Labels are resolved against the configuration in Saviynt at plan time
+		isLabel := !ok && v.allowLabels && strings.TrimSpace(key) != ""
+		if !isLabel && (!ok || index < 1 || index > v.count) {
+			resp.Diagnostics.AddAttributeError(
+				keyPath,
+				"Invalid Custom Property",
+				fmt.Sprintf("%q is not a custom property. Use the number of the property between 1 and %d, for example \"3\" or \"custom_property3\".", key, v.count),
+			)
+			continue
+		}
+		if !isLabel {
+			if other, exists := seen[index]; exists {
+				resp.Diagnostics.AddAttributeError(
+					keyPath,
+					"Duplicate Custom Property",
+					fmt.Sprintf("%q and %q both set custom property %d.", other, key, index),
+				)
+				continue
+			}
+			seen[index] = key
+		}
+		if str, ok := value.(types.String); ok && str.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				keyPath,
+				"Null Custom Property",
+				"Custom property values must not be null. Use \"\" to clear the property or remove the key.",
+			)
+		}
+	}
+}
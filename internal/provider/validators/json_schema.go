@@ -0,0 +1,510 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// jsonSchemaFiles holds the JSON Schemas of the connector JSON attributes. They use a subset of
+// JSON Schema draft-07: type, enum, properties, additionalProperties, items, definitions and $ref
+// to a definition or another bundled file. Keys are not marked as required, as the Saviynt
+// documentation does not state which keys a connector needs. jsonschemas/README.md lists the
+// sources of the keys.
+//
+//go:embed jsonschemas/*.json
+var jsonSchemaFiles embed.FS
+
+// jsonSchemas holds the bundled schemas by file name without the extension. When they cannot be
+// loaded, jsonSchemasErr is set and the validators report it instead of checking values.
+var jsonSchemas, jsonSchemasErr = loadJSONSchemas(jsonSchemaFiles)
+
+// JSONSchema returns a validator for string attributes that hold a JSON document. The value must
+// be valid JSON and match the bundled schema name, for example "rest_connection_json". Keys that
+// the schema does not know are accepted; a warning is reported for keys that differ from a key of
+// the schema only by case or a single typo. Template expressions such as ${user.firstname} in the
+// document must be closed. Strings that hold a template expression are not checked against the
+// type and enum of the schema, and a document that is JSON only once its expressions are replaced
+// is not checked against the schema. Null, unknown and empty values are not checked. When the
+// bundled schemas cannot be loaded or there is none called name, the validator reports an error.
+func JSONSchema(name string) validator.String {
+	if jsonSchemasErr != nil {
+		return jsonSchemaValidator{name: name, err: jsonSchemasErr}
+	}
+	schema, ok := jsonSchemas[name]
+	if !ok {
+		return jsonSchemaValidator{name: name, err: fmt.Errorf("no bundled JSON schema %q", name)}
+	}
+	return jsonSchemaValidator{name: name, schema: schema}
+}
+
+type jsonSchemaValidator struct {
+	name   string
+	schema *jsonSchema
+	// err is set when the schema could not be loaded
+	err error
+}
+
+func (v jsonSchemaValidator) Description(_ context.Context) string {
+	title := v.name
+	if v.schema != nil {
+		title = v.schema.Title
+	}
+	return fmt.Sprintf("Value must be a JSON document that matches the %s schema and whose template expressions are closed.", title)
+}
+
+func (v jsonSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonSchemaValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	if v.err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"JSON Schema Unavailable",
+			fmt.Sprintf("The provider could not load the %s schema, so the value cannot be checked: %s. This is a bug in the provider.", v.name, v.err),
+		)
+		return
+	}
+	raw := req.ConfigValue.ValueString()
+
+	document, err := decodeJSONDocument(raw)
+	if err != nil {
+		// Template expressions outside of strings, for example "timeout": ${secret.timeout}, are
+		// replaced before the value is used, so such a document is only checked for valid JSON
+		if strings.Contains(raw, "${") {
+			if _, templateErr := decodeJSONDocument(withoutTemplates(raw)); templateErr == nil {
+				return
+			}
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("The value of %s must be valid JSON: %s", v.schema.Title, describeJSONError(raw, err)),
+		)
+		return
+	}
+
+	var problems []jsonProblem
+	v.schema.validate(document, "", &problems)
+	checkTemplates(document, "", &problems)
+	for _, problem := range problems {
+		if problem.warning {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Possible Typo in "+v.schema.Title,
+				fmt.Sprintf("At %s: %s", problem.location(), problem.message),
+			)
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid "+v.schema.Title,
+			fmt.Sprintf("At %s: %s", problem.location(), problem.message),
+		)
+	}
+}
+
+// jsonProblem is a single violation found in a JSON document, with the JSON Pointer of the value.
+// A warning does not make the document invalid.
+type jsonProblem struct {
+	pointer string
+	message string
+	warning bool
+}
+
+func (p jsonProblem) location() string {
+	if p.pointer == "" {
+		return "the top level of the document"
+	}
+	return p.pointer
+}
+
+// jsonSchema is a schema, or a part of one, of the supported subset of JSON Schema.
+type jsonSchema struct {
+	SchemaURI            string                 `json:"$schema"`
+	Ref                  string                 `json:"$ref"`
+	Title                string                 `json:"title"`
+	Description          string                 `json:"description"`
+	Type                 jsonSchemaTypes        `json:"type"`
+	Enum                 []string               `json:"enum"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Definitions          map[string]*jsonSchema `json:"definitions"`
+
+	// resolved is the schema that Ref points to, set when the schemas are loaded.
+	resolved *jsonSchema
+}
+
+// jsonSchemaTypes is the type keyword, which is a single type name or a list of them.
+type jsonSchemaTypes []string
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings")
+	}
+	*t = list
+	return nil
+}
+
+// loadJSONSchemas reads the *.json schemas of the jsonschemas folder of files and resolves their
+// references.
+func loadJSONSchemas(files fs.FS) (map[string]*jsonSchema, error) {
+	names, err := fs.Glob(files, "jsonschemas/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]*jsonSchema, len(names))
+	for _, name := range names {
+		file := path.Base(name)
+		data, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		var schema jsonSchema
+		if err := decoder.Decode(&schema); err != nil {
+			return nil, fmt.Errorf("reading JSON schema %s: %w", file, err)
+		}
+		schemas[strings.TrimSuffix(file, ".json")] = &schema
+	}
+
+	for name, schema := range schemas {
+		if err := resolveJSONSchemaRefs(schema, schema, schemas); err != nil {
+			return nil, fmt.Errorf("resolving JSON schema %s.json: %w", name, err)
+		}
+	}
+	return schemas, nil
+}
+
+// resolveJSONSchemaRefs points every $ref below schema at its target. References are
+// "#/definitions/name" within root, or "file.json" and "file.json#/definitions/name" for another
+// bundled schema.
+func resolveJSONSchemaRefs(schema, root *jsonSchema, schemas map[string]*jsonSchema) error {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		file, fragment, _ := strings.Cut(schema.Ref, "#")
+		target := root
+		if file != "" {
+			var ok bool
+			if target, ok = schemas[strings.TrimSuffix(file, ".json")]; !ok {
+				return fmt.Errorf("unknown schema file in $ref %q", schema.Ref)
+			}
+		}
+		if fragment != "" {
+			name, ok := strings.CutPrefix(fragment, "/definitions/")
+			if !ok || target.Definitions[name] == nil {
+				return fmt.Errorf("unknown definition in $ref %q", schema.Ref)
+			}
+			target = target.Definitions[name]
+		}
+		schema.resolved = target
+	}
+
+	children := []*jsonSchema{schema.AdditionalProperties, schema.Items}
+	for _, child := range schema.Properties {
+		children = append(children, child)
+	}
+	for _, child := range schema.Definitions {
+		children = append(children, child)
+	}
+	for _, child := range children {
+		if err := resolveJSONSchemaRefs(child, root, schemas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate appends the violations of schema found in value, at the JSON Pointer pointer.
+func (s *jsonSchema) validate(value interface{}, pointer string, problems *[]jsonProblem) {
+	for s.resolved != nil {
+		s = s.resolved
+	}
+
+	// A template expression is replaced when the value is used and can produce any type or value
+	if text, ok := value.(string); ok && strings.Contains(text, "${") {
+		return
+	}
+
+	actual := jsonTypeOf(value)
+	if len(s.Type) > 0 && !s.allowsType(actual) {
+		*problems = append(*problems, jsonProblem{pointer: pointer, message: fmt.Sprintf("expected %s, got %s", strings.Join(s.Type, " or "), actual)})
+		return
+	}
+
+	if len(s.Enum) > 0 {
+		text, _ := value.(string)
+		if !containsString(s.Enum, text) {
+			*problems = append(*problems, jsonProblem{pointer: pointer, message: fmt.Sprintf("value %s is not one of %s", jsonText(value), strings.Join(s.Enum, ", "))})
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := pointer + "/" + escapeJSONPointer(key)
+			if property, ok := s.Properties[key]; ok {
+				property.validate(v[key], child, problems)
+				continue
+			}
+			if known := closestJSONSchemaKey(key, s.Properties); known != "" {
+				*problems = append(*problems, jsonProblem{pointer: child, message: fmt.Sprintf("unknown key %q is sent as is, did you mean %q?", key, known), warning: true})
+			}
+			if s.AdditionalProperties != nil {
+				s.AdditionalProperties.validate(v[key], child, problems)
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(item, fmt.Sprintf("%s/%d", pointer, i), problems)
+			}
+		}
+	}
+}
+
+func (s *jsonSchema) allowsType(actual string) bool {
+	for _, allowed := range s.Type {
+		if allowed == actual || (allowed == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// closestJSONSchemaKey returns the key of properties that key is most likely a misspelling of: a
+// key that differs only by case, or by a single inserted, removed, replaced or swapped character.
+func closestJSONSchemaKey(key string, properties map[string]*jsonSchema) string {
+	var candidates []string
+	for name := range properties {
+		if strings.EqualFold(name, key) || (len(key) > 3 && editDistanceAtMostOne(name, key)) {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Strings(candidates)
+	return candidates[0]
+}
+
+// editDistanceAtMostOne reports whether a becomes b with at most one inserted, removed or
+// replaced character, or one swap of adjacent characters.
+func editDistanceAtMostOne(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(b)-len(a) > 1 {
+		return false
+	}
+	i := 0
+	for i < len(a) && a[i] == b[i] {
+		i++
+	}
+	if i == len(a) {
+		return true
+	}
+	if len(a) == len(b) {
+		if a[i+1:] == b[i+1:] {
+			return true
+		}
+		return i+1 < len(a) && a[i] == b[i+1] && a[i+1] == b[i] && a[i+2:] == b[i+2:]
+	}
+	return a[i:] == b[i+1:]
+}
+
+// checkTemplates appends a problem for every string or key below value that opens a ${...}
+// template expression without closing it.
+func checkTemplates(value interface{}, pointer string, problems *[]jsonProblem) {
+	switch v := value.(type) {
+	case string:
+		if excerpt, ok := unclosedTemplate(v); ok {
+			*problems = append(*problems, jsonProblem{pointer: pointer, message: fmt.Sprintf("template expression %q is not closed with \"}\"", excerpt)})
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := pointer + "/" + escapeJSONPointer(key)
+			if excerpt, ok := unclosedTemplate(key); ok {
+				*problems = append(*problems, jsonProblem{pointer: child, message: fmt.Sprintf("template expression %q in the key is not closed with \"}\"", excerpt)})
+			}
+			checkTemplates(v[key], child, problems)
+		}
+	case []interface{}:
+		for i, item := range v {
+			checkTemplates(item, fmt.Sprintf("%s/%d", pointer, i), problems)
+		}
+	}
+}
+
+// unclosedTemplate returns the start of the first ${ expression in s that has no matching closing
+// brace. Braces inside quoted strings of the expression are not counted.
+func unclosedTemplate(s string) (string, bool) {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] != '$' || s[i+1] != '{' {
+			continue
+		}
+		end := templateEnd(s, i+2)
+		if end < 0 {
+			excerpt := s[i:]
+			if len(excerpt) > 40 {
+				excerpt = excerpt[:40] + "..."
+			}
+			return excerpt, true
+		}
+		i = end
+	}
+	return "", false
+}
+
+// withoutTemplates replaces every closed ${...} expression in s with null.
+func withoutTemplates(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '$' && i+1 < len(s) && s[i+1] == '{' {
+			if end := templateEnd(s, i+2); end >= 0 {
+				b.WriteString("null")
+				i = end
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// templateEnd returns the index of the brace that closes the expression starting at start, or -1.
+func templateEnd(s string, start int) int {
+	depth := 1
+	var quote byte
+	for j := start; j < len(s); j++ {
+		c := s[j]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				j++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// decodeJSONDocument decodes a single JSON document, keeping numbers as json.Number.
+func decodeJSONDocument(raw string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON document at offset %d", decoder.InputOffset())
+	}
+	return document, nil
+}
+
+// describeJSONError adds the line and column of a syntax error to its message.
+func describeJSONError(raw string, err error) string {
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return err.Error()
+	}
+	offset := int(syntaxErr.Offset)
+	if offset > len(raw) {
+		offset = len(raw)
+	}
+	line := strings.Count(raw[:offset], "\n") + 1
+	column := offset - strings.LastIndex(raw[:offset], "\n")
+	return fmt.Sprintf("%s (line %d, column %d)", syntaxErr.Error(), line, column)
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return reflect.TypeOf(value).String()
+}
+
+func jsonText(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// escapeJSONPointer escapes a key for use as a JSON Pointer reference token.
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSchemas is a small set of schemas that uses every supported keyword.
+var testSchemas = fstest.MapFS{
+	"jsonschemas/call.json": {Data: []byte(`{
+		"title": "call",
+		"type": "object",
+		"properties": {
+			"url": {"type": "string"},
+			"method": {"type": "string", "enum": ["GET", "POST"]},
+			"retries": {"type": "integer"},
+			"headers": {"type": "object", "additionalProperties": {"type": "string"}}
+		}
+	}`)},
+	"jsonschemas/operation.json": {Data: []byte(`{
+		"title": "operation",
+		"type": "object",
+		"properties": {
+			"calls": {"type": "array", "items": {"$ref": "call.json"}},
+			"timeout": {"$ref": "#/definitions/seconds"}
+		},
+		"definitions": {
+			"seconds": {"type": ["integer", "string"]}
+		}
+	}`)},
+}
+
+func TestLoadJSONSchemas(t *testing.T) {
+	if jsonSchemasErr != nil {
+		t.Fatalf("bundled schemas do not load: %s", jsonSchemasErr)
+	}
+
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantErr string
+	}{
+		{"valid", testSchemas, ""},
+		{"malformed JSON", fstest.MapFS{"jsonschemas/a.json": {Data: []byte(`{"type": `)}}, "reading JSON schema a.json"},
+		{"unsupported keyword", fstest.MapFS{"jsonschemas/a.json": {Data: []byte(`{"required": ["x"]}`)}}, "unknown field"},
+		{"invalid type", fstest.MapFS{"jsonschemas/a.json": {Data: []byte(`{"type": 1}`)}}, "type must be a string"},
+		{"unknown file reference", fstest.MapFS{"jsonschemas/a.json": {Data: []byte(`{"$ref": "b.json"}`)}}, "unknown schema file"},
+		{"unknown definition", fstest.MapFS{"jsonschemas/a.json": {Data: []byte(`{"$ref": "#/definitions/x"}`)}}, "unknown definition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadJSONSchemas(tt.files)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidator(t *testing.T) {
+	schemas, err := loadJSONSchemas(testSchemas)
+	if err != nil {
+		t.Fatalf("loading test schemas: %s", err)
+	}
+	operation := jsonSchemaValidator{name: "operation", schema: schemas["operation"]}
+
+	tests := []struct {
+		name         string
+		validator    validator.String
+		value        types.String
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{name: "null", validator: operation, value: types.StringNull()},
+		{name: "unknown", validator: operation, value: types.StringUnknown()},
+		{name: "empty", validator: operation, value: types.StringValue("")},
+		{name: "valid", validator: operation, value: types.StringValue(`{"calls":[{"url":"https://x","method":"GET","retries":2,"headers":{"Accept":"json"}}],"timeout":30}`)},
+		{name: "unknown keys are accepted", validator: operation, value: types.StringValue(`{"calls":[],"custom":{"any":1}}`)},
+		{name: "type from a definition", validator: operation, value: types.StringValue(`{"timeout":"30"}`)},
+		{name: "invalid JSON", validator: operation, value: types.StringValue(`{"calls":`), wantErrors: []string{"Invalid JSON"}},
+		{name: "trailing data", validator: operation, value: types.StringValue(`{} {}`), wantErrors: []string{"Invalid JSON"}},
+		{name: "wrong type", validator: operation, value: types.StringValue(`{"calls":{}}`), wantErrors: []string{"Invalid operation"}},
+		{name: "wrong type in a referenced file", validator: operation, value: types.StringValue(`{"calls":[{"retries":"two"}]}`), wantErrors: []string{"Invalid operation"}},
+		{name: "value not in enum", validator: operation, value: types.StringValue(`{"calls":[{"method":"PATCH"}]}`), wantErrors: []string{"Invalid operation"}},
+		{name: "additional properties", validator: operation, value: types.StringValue(`{"calls":[{"headers":{"Accept":1}}]}`), wantErrors: []string{"Invalid operation"}},
+		{name: "typo in a key", validator: operation, value: types.StringValue(`{"calls":[{"methd":"GET"}]}`), wantWarnings: []string{"Possible Typo in operation"}},
+		{name: "key in another case", validator: operation, value: types.StringValue(`{"Calls":[]}`), wantWarnings: []string{"Possible Typo in operation"}},
+		{name: "unclosed template", validator: operation, value: types.StringValue(`{"calls":[{"url":"https://x/${user.id"}]}`), wantErrors: []string{"Invalid operation"}},
+		{name: "placeholder for an integer", validator: operation, value: types.StringValue(`{"calls":[{"retries":"${secret.retries}"}]}`)},
+		{name: "placeholder for an enum", validator: operation, value: types.StringValue(`{"calls":[{"method":"${method}"}]}`)},
+		{name: "placeholder outside a string", validator: operation, value: types.StringValue(`{"calls":[{"retries":${secret.retries}}]}`)},
+		{name: "invalid JSON around a placeholder", validator: operation, value: types.StringValue(`{"calls":[{"retries":${secret.retries}}`), wantErrors: []string{"Invalid JSON"}},
+		{name: "schema not loaded", validator: jsonSchemaValidator{name: "broken", err: errors.New("test error")}, value: types.StringValue(`{}`), wantErrors: []string{"JSON Schema Unavailable"}},
+		{name: "missing schema", validator: JSONSchema("no_such_schema"), value: types.StringValue(`{}`), wantErrors: []string{"JSON Schema Unavailable"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			var errors, warnings []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			for _, d := range resp.Diagnostics.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			if !reflect.DeepEqual(errors, tt.wantErrors) {
+				t.Errorf("errors = %v, want %v (%v)", errors, tt.wantErrors, resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestCheckTemplates(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []jsonProblem
+	}{
+		{"no templates", map[string]interface{}{"a": "b"}, nil},
+		{"closed template", "${user.firstname}", nil},
+		{"nested braces", "${user.attributes.collect{it.name}}", nil},
+		{"brace in a quoted string", `${user.name + "}"}`, nil},
+		{"dollar without brace", "cost $5", nil},
+		{"unclosed template", "${user.firstname", []jsonProblem{{pointer: "", message: `template expression "${user.firstname" is not closed with "}"`}}},
+		{"unclosed after a closed one", "${a} ${b", []jsonProblem{{pointer: "", message: `template expression "${b" is not closed with "}"`}}},
+		{
+			"unclosed in an array",
+			map[string]interface{}{"calls": []interface{}{"ok", "${x"}},
+			[]jsonProblem{{pointer: "/calls/1", message: `template expression "${x" is not closed with "}"`}},
+		},
+		{
+			"unclosed in a key",
+			map[string]interface{}{"${k": "v"},
+			[]jsonProblem{{pointer: "/${k", message: `template expression "${k" in the key is not closed with "}"`}},
+		},
+		{
+			"escaped pointer",
+			map[string]interface{}{"a/b": "${x"},
+			[]jsonProblem{{pointer: "/a~1b", message: `template expression "${x" is not closed with "}"`}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var problems []jsonProblem
+			checkTemplates(tt.value, "", &problems)
+			if !reflect.DeepEqual(problems, tt.want) {
+				t.Errorf("problems = %+v, want %+v", problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutTemplates(t *testing.T) {
+	tests := map[string]string{
+		`{"a":${secret.a}}`:       `{"a":null}`,
+		`{"a":"x ${b} y"}`:        `{"a":"x null y"}`,
+		`{"a":${f({"k":1})}}`:     `{"a":null}`,
+		`{"a":${unclosed}`:        `{"a":null`,
+		`{"a":${unclosed`:         `{"a":${unclosed`,
+		`{"a":"no templates $x"}`: `{"a":"no templates $x"}`,
+	}
+	for in, want := range tests {
+		if got := withoutTemplates(in); got != want {
+			t.Errorf("withoutTemplates(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
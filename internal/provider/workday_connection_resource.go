@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -39,48 +41,48 @@ var workdayErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.ConnectorTy
 
 type WorkdayConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                     types.String `tfsdk:"id"`
-	UsersLastImportTime    types.String `tfsdk:"users_last_import_time"`
-	AccountsLastImportTime types.String `tfsdk:"accounts_last_import_time"`
-	AccessLastImportTime   types.String `tfsdk:"access_last_import_time"`
-	BaseURL                types.String `tfsdk:"base_url"`
-	APIVersion             types.String `tfsdk:"api_version"`
-	TenantName             types.String `tfsdk:"tenant_name"`
-	ReportOwner            types.String `tfsdk:"report_owner"`
-	UseOAuth               types.String `tfsdk:"use_oauth"`
-	IncludeReferenceDesc   types.String `tfsdk:"include_reference_descriptors"`
-	UseEnhancedOrgRole     types.String `tfsdk:"use_enhanced_orgrole"`
-	UseX509AuthForSOAP     types.String `tfsdk:"use_x509auth_for_soap"`
-	X509Key                types.String `tfsdk:"x509_key"`
-	X509Cert               types.String `tfsdk:"x509_cert"`
-	Username               types.String `tfsdk:"username"`
-	Password               types.String `tfsdk:"password"`
-	PasswordWo             types.String `tfsdk:"password_wo"`
-	ClientID               types.String `tfsdk:"client_id"`
-	ClientSecret           types.String `tfsdk:"client_secret"`
-	ClientSecretWo         types.String `tfsdk:"client_secret_wo"`
-	RefreshToken           types.String `tfsdk:"refresh_token"`
-	RefreshTokenWo         types.String `tfsdk:"refresh_token_wo"`
-	PageSize               types.String `tfsdk:"page_size"`
-	UserImportPayload      types.String `tfsdk:"user_import_payload"`
-	UserImportMapping      types.String `tfsdk:"user_import_mapping"`
-	AccountImportPayload   types.String `tfsdk:"account_import_payload"`
-	AccountImportMapping   types.String `tfsdk:"account_import_mapping"`
-	AccessImportList       types.String `tfsdk:"access_import_list"`
-	RAASMappingJSON        types.String `tfsdk:"raas_mapping_json"`
-	AccessImportMapping    types.String `tfsdk:"access_import_mapping"`
-	OrgRoleImportPayload   types.String `tfsdk:"orgrole_import_payload"`
-	StatusKeyJSON          types.String `tfsdk:"status_key_json"`
-	UserAttributeJSON      types.String `tfsdk:"userattributejson"`
-	CustomConfig           types.String `tfsdk:"custom_config"`
-	PAMConfig              types.String `tfsdk:"pam_config"`
-	ModifyUserDataJSON     types.String `tfsdk:"modify_user_data_json"`
-	StatusThresholdConfig  types.String `tfsdk:"status_threshold_config"`
-	CreateAccountPayload   types.String `tfsdk:"create_account_payload"`
-	UpdateAccountPayload   types.String `tfsdk:"update_account_payload"`
-	UpdateUserPayload      types.String `tfsdk:"update_user_payload"`
-	AssignOrgRolePayload   types.String `tfsdk:"assign_orgrole_payload"`
-	RemoveOrgRolePayload   types.String `tfsdk:"remove_orgrole_payload"`
+	ID                     types.String           `tfsdk:"id"`
+	UsersLastImportTime    types.String           `tfsdk:"users_last_import_time"`
+	AccountsLastImportTime types.String           `tfsdk:"accounts_last_import_time"`
+	AccessLastImportTime   types.String           `tfsdk:"access_last_import_time"`
+	BaseURL                types.String           `tfsdk:"base_url"`
+	APIVersion             types.String           `tfsdk:"api_version"`
+	TenantName             types.String           `tfsdk:"tenant_name"`
+	ReportOwner            types.String           `tfsdk:"report_owner"`
+	UseOAuth               types.String           `tfsdk:"use_oauth"`
+	IncludeReferenceDesc   types.String           `tfsdk:"include_reference_descriptors"`
+	UseEnhancedOrgRole     types.String           `tfsdk:"use_enhanced_orgrole"`
+	UseX509AuthForSOAP     types.String           `tfsdk:"use_x509auth_for_soap"`
+	X509Key                types.String           `tfsdk:"x509_key"`
+	X509Cert               types.String           `tfsdk:"x509_cert"`
+	Username               types.String           `tfsdk:"username"`
+	Password               types.String           `tfsdk:"password"`
+	PasswordWo             types.String           `tfsdk:"password_wo"`
+	ClientID               types.String           `tfsdk:"client_id"`
+	ClientSecret           types.String           `tfsdk:"client_secret"`
+	ClientSecretWo         types.String           `tfsdk:"client_secret_wo"`
+	RefreshToken           types.String           `tfsdk:"refresh_token"`
+	RefreshTokenWo         types.String           `tfsdk:"refresh_token_wo"`
+	PageSize               types.String           `tfsdk:"page_size"`
+	UserImportPayload      types.String           `tfsdk:"user_import_payload"`
+	UserImportMapping      customtypes.JSONString `tfsdk:"user_import_mapping"`
+	AccountImportPayload   types.String           `tfsdk:"account_import_payload"`
+	AccountImportMapping   customtypes.JSONString `tfsdk:"account_import_mapping"`
+	AccessImportList       types.String           `tfsdk:"access_import_list"`
+	RAASMappingJSON        customtypes.JSONString `tfsdk:"raas_mapping_json"`
+	AccessImportMapping    customtypes.JSONString `tfsdk:"access_import_mapping"`
+	OrgRoleImportPayload   types.String           `tfsdk:"orgrole_import_payload"`
+	StatusKeyJSON          customtypes.JSONString `tfsdk:"status_key_json"`
+	UserAttributeJSON      customtypes.JSONString `tfsdk:"userattributejson"`
+	CustomConfig           customtypes.JSONString `tfsdk:"custom_config"`
+	PAMConfig              customtypes.JSONString `tfsdk:"pam_config"`
+	ModifyUserDataJSON     customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	StatusThresholdConfig  customtypes.JSONString `tfsdk:"status_threshold_config"`
+	CreateAccountPayload   types.String           `tfsdk:"create_account_payload"`
+	UpdateAccountPayload   types.String           `tfsdk:"update_account_payload"`
+	UpdateUserPayload      types.String           `tfsdk:"update_user_payload"`
+	AssignOrgRolePayload   types.String           `tfsdk:"assign_orgrole_payload"`
+	RemoveOrgRolePayload   types.String           `tfsdk:"remove_orgrole_payload"`
 }
 
 // workdayConnectionResource implements the resource.Resource interface.
@@ -250,6 +252,7 @@ func WorkdayConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Request payload for importing users.",
 		},
 		"user_import_mapping": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Mapping configuration for user import.",
@@ -260,6 +263,7 @@ func WorkdayConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Request payload for importing accounts.",
 		},
 		"account_import_mapping": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Mapping configuration for account import.",
@@ -270,11 +274,13 @@ func WorkdayConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Comma-separated list of access types to import.",
 		},
 		"raas_mapping_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Overrides default report mapping for RaaS.",
 		},
 		"access_import_mapping": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Additional access attribute mapping for Workday access objects.",
@@ -285,34 +291,43 @@ func WorkdayConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Custom SOAP body for organization role import.",
 		},
 		"status_key_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Mapping of user status.",
 		},
 		"userattributejson": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Specifies which job-related attributes are stored as user attributes.",
 		},
 		"custom_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Custom configuration for Workday connector.",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Privileged Access Management configuration.",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Payload for modifying user data.",
 		},
 		"status_threshold_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Config for reading and importing status of account and entitlement.",
+			Validators: []validator.String{
+				validators.JSONSchema("status_threshold_config"),
+			},
 		},
 		"create_account_payload": schema.StringAttribute{
 			Optional:    true,
@@ -457,7 +472,7 @@ func (r *WorkdayConnectionResource) CreateWorkdayConnection(ctx context.Context,
 
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_workday_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -547,19 +562,19 @@ func (r *WorkdayConnectionResource) BuildWorkdayConnector(plan *WorkdayConnector
 		REFRESH_TOKEN:                 util.StringPointerOrEmpty(types.StringValue(refreshToken)),
 		PAGE_SIZE:                     util.StringPointerOrEmpty(plan.PageSize),
 		USER_IMPORT_PAYLOAD:           util.StringPointerOrEmpty(plan.UserImportPayload),
-		USER_IMPORT_MAPPING:           util.StringPointerOrEmpty(plan.UserImportMapping),
+		USER_IMPORT_MAPPING:           util.StringPointerOrEmpty(plan.UserImportMapping.StringValue),
 		ACCOUNT_IMPORT_PAYLOAD:        util.StringPointerOrEmpty(plan.AccountImportPayload),
-		ACCOUNT_IMPORT_MAPPING:        util.StringPointerOrEmpty(plan.AccountImportMapping),
+		ACCOUNT_IMPORT_MAPPING:        util.StringPointerOrEmpty(plan.AccountImportMapping.StringValue),
 		ACCESS_IMPORT_LIST:            util.StringPointerOrEmpty(plan.AccessImportList),
-		RAAS_MAPPING_JSON:             util.StringPointerOrEmpty(plan.RAASMappingJSON),
-		ACCESS_IMPORT_MAPPING:         util.StringPointerOrEmpty(plan.AccessImportMapping),
+		RAAS_MAPPING_JSON:             util.StringPointerOrEmpty(plan.RAASMappingJSON.StringValue),
+		ACCESS_IMPORT_MAPPING:         util.StringPointerOrEmpty(plan.AccessImportMapping.StringValue),
 		ORGROLE_IMPORT_PAYLOAD:        util.StringPointerOrEmpty(plan.OrgRoleImportPayload),
-		STATUS_KEY_JSON:               util.StringPointerOrEmpty(plan.StatusKeyJSON),
-		USERATTRIBUTEJSON:             util.StringPointerOrEmpty(plan.UserAttributeJSON),
-		CUSTOM_CONFIG:                 util.StringPointerOrEmpty(plan.CustomConfig),
-		PAM_CONFIG:                    util.StringPointerOrEmpty(plan.PAMConfig),
-		MODIFYUSERDATAJSON:            util.StringPointerOrEmpty(plan.ModifyUserDataJSON),
-		STATUS_THRESHOLD_CONFIG:       util.StringPointerOrEmpty(plan.StatusThresholdConfig),
+		STATUS_KEY_JSON:               util.StringPointerOrEmpty(plan.StatusKeyJSON.StringValue),
+		USERATTRIBUTEJSON:             util.StringPointerOrEmpty(plan.UserAttributeJSON.StringValue),
+		CUSTOM_CONFIG:                 util.StringPointerOrEmpty(plan.CustomConfig.StringValue),
+		PAM_CONFIG:                    util.StringPointerOrEmpty(plan.PAMConfig.StringValue),
+		MODIFYUSERDATAJSON:            util.StringPointerOrEmpty(plan.ModifyUserDataJSON.StringValue),
+		STATUS_THRESHOLD_CONFIG:       util.StringPointerOrEmpty(plan.StatusThresholdConfig.StringValue),
 		CREATE_ACCOUNT_PAYLOAD:        util.StringPointerOrEmpty(plan.CreateAccountPayload),
 		UPDATE_ACCOUNT_PAYLOAD:        util.StringPointerOrEmpty(plan.UpdateAccountPayload),
 		UPDATE_USER_PAYLOAD:           util.StringPointerOrEmpty(plan.UpdateUserPayload),
@@ -599,19 +614,19 @@ func (r *WorkdayConnectionResource) UpdateModelFromCreateResponse(plan *WorkdayC
 	plan.ClientID = util.SafeStringDatasource(plan.ClientID.ValueStringPointer())
 	plan.PageSize = util.SafeStringDatasource(plan.PageSize.ValueStringPointer())
 	plan.UserImportPayload = util.SafeStringDatasource(plan.UserImportPayload.ValueStringPointer())
-	plan.UserImportMapping = util.SafeStringDatasource(plan.UserImportMapping.ValueStringPointer())
+	plan.UserImportMapping = customtypes.NewJSONStringPointerValue(plan.UserImportMapping.ValueStringPointer())
 	plan.AccountImportPayload = util.SafeStringDatasource(plan.AccountImportPayload.ValueStringPointer())
-	plan.AccountImportMapping = util.SafeStringDatasource(plan.AccountImportMapping.ValueStringPointer())
+	plan.AccountImportMapping = customtypes.NewJSONStringPointerValue(plan.AccountImportMapping.ValueStringPointer())
 	plan.AccessImportList = util.SafeStringDatasource(plan.AccessImportList.ValueStringPointer())
-	plan.RAASMappingJSON = util.SafeStringDatasource(plan.RAASMappingJSON.ValueStringPointer())
-	plan.AccessImportMapping = util.SafeStringDatasource(plan.AccessImportMapping.ValueStringPointer())
+	plan.RAASMappingJSON = customtypes.NewJSONStringPointerValue(plan.RAASMappingJSON.ValueStringPointer())
+	plan.AccessImportMapping = customtypes.NewJSONStringPointerValue(plan.AccessImportMapping.ValueStringPointer())
 	plan.OrgRoleImportPayload = util.SafeStringDatasource(plan.OrgRoleImportPayload.ValueStringPointer())
-	plan.StatusKeyJSON = util.SafeStringDatasource(plan.StatusKeyJSON.ValueStringPointer())
-	plan.UserAttributeJSON = util.SafeStringDatasource(plan.UserAttributeJSON.ValueStringPointer())
-	plan.CustomConfig = util.SafeStringDatasource(plan.CustomConfig.ValueStringPointer())
-	plan.PAMConfig = util.SafeStringDatasource(plan.PAMConfig.ValueStringPointer())
-	plan.ModifyUserDataJSON = util.SafeStringDatasource(plan.ModifyUserDataJSON.ValueStringPointer())
-	plan.StatusThresholdConfig = util.SafeStringDatasource(plan.StatusThresholdConfig.ValueStringPointer())
+	plan.StatusKeyJSON = customtypes.NewJSONStringPointerValue(plan.StatusKeyJSON.ValueStringPointer())
+	plan.UserAttributeJSON = customtypes.NewJSONStringPointerValue(plan.UserAttributeJSON.ValueStringPointer())
+	plan.CustomConfig = customtypes.NewJSONStringPointerValue(plan.CustomConfig.ValueStringPointer())
+	plan.PAMConfig = customtypes.NewJSONStringPointerValue(plan.PAMConfig.ValueStringPointer())
+	plan.ModifyUserDataJSON = customtypes.NewJSONStringPointerValue(plan.ModifyUserDataJSON.ValueStringPointer())
+	plan.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(plan.StatusThresholdConfig.ValueStringPointer())
 	plan.CreateAccountPayload = util.SafeStringDatasource(plan.CreateAccountPayload.ValueStringPointer())
 	plan.UpdateAccountPayload = util.SafeStringDatasource(plan.UpdateAccountPayload.ValueStringPointer())
 	plan.UpdateUserPayload = util.SafeStringDatasource(plan.UpdateUserPayload.ValueStringPointer())
@@ -689,32 +704,32 @@ func (r *WorkdayConnectionResource) UpdateModelFromReadResponse(state *WorkdayCo
 	state.DefaultSavRoles = PreserveOrderIfSemanticallyEqual(state.DefaultSavRoles, util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Defaultsavroles))
 	state.EmailTemplate = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Emailtemplate)
 	state.UseOAuth = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USE_OAUTH)
-	state.UserImportMapping = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USER_IMPORT_MAPPING)
+	state.UserImportMapping = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.USER_IMPORT_MAPPING)
 	state.AccountsLastImportTime = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCOUNTS_LAST_IMPORT_TIME)
-	state.StatusKeyJSON = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.STATUS_KEY_JSON)
-	state.RAASMappingJSON = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.RAAS_MAPPING_JSON)
+	state.StatusKeyJSON = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.STATUS_KEY_JSON)
+	state.RAASMappingJSON = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.RAAS_MAPPING_JSON)
 	state.AccountImportPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCOUNT_IMPORT_PAYLOAD)
 	state.UpdateAccountPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.UPDATE_ACCOUNT_PAYLOAD)
 	state.ClientID = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.CLIENT_ID)
-	state.StatusThresholdConfig = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
+	state.StatusThresholdConfig = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.STATUS_THRESHOLD_CONFIG)
 	state.Username = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USERNAME)
 	state.AccessImportList = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCESS_IMPORT_LIST)
-	state.AccountImportMapping = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCOUNT_IMPORT_MAPPING)
+	state.AccountImportMapping = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCOUNT_IMPORT_MAPPING)
 	state.OrgRoleImportPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ORGROLE_IMPORT_PAYLOAD)
 	state.AssignOrgRolePayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ASSIGN_ORGROLE_PAYLOAD)
-	state.AccessImportMapping = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCESS_IMPORT_MAPPING)
+	state.AccessImportMapping = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCESS_IMPORT_MAPPING)
 	state.APIVersion = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.API_VERSION)
 	state.RemoveOrgRolePayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.REMOVE_ORGROLE_PAYLOAD)
 	state.IncludeReferenceDesc = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.INCLUDE_REFERENCE_DESCRIPTORS)
-	state.ModifyUserDataJSON = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
+	state.ModifyUserDataJSON = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.MODIFYUSERDATAJSON)
 	state.UseX509AuthForSOAP = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USEX509AUTHFORSOAP)
 	state.ReportOwner = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.REPORT_OWNER)
 	state.X509Key = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.X509KEY)
-	state.CustomConfig = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.CUSTOM_CONFIG)
-	state.UserAttributeJSON = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USERATTRIBUTEJSON)
+	state.CustomConfig = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.CUSTOM_CONFIG)
+	state.UserAttributeJSON = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.USERATTRIBUTEJSON)
 	state.X509Cert = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.X509CERT)
 	state.UserImportPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USER_IMPORT_PAYLOAD)
-	state.PAMConfig = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.PAM_CONFIG)
+	state.PAMConfig = customtypes.NewJSONStringPointerValue(apiResp.WorkdayConnectionResponse.Connectionattributes.PAM_CONFIG)
 	state.AccessLastImportTime = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.ACCESS_LAST_IMPORT_TIME)
 	state.UsersLastImportTime = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USERS_LAST_IMPORT_TIME)
 	state.UpdateUserPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.UPDATE_USER_PAYLOAD)
@@ -723,6 +738,7 @@ func (r *WorkdayConnectionResource) UpdateModelFromReadResponse(state *WorkdayCo
 	state.UseEnhancedOrgRole = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.USE_ENHANCED_ORGROLE)
 	state.CreateAccountPayload = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.CREATE_ACCOUNT_PAYLOAD)
 	state.BaseURL = util.SafeStringDatasource(apiResp.WorkdayConnectionResponse.Connectionattributes.BASE_URL)
+	state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, apiResp.WorkdayConnectionResponse.Connectionattributes.ConnectionTimeoutConfig)
 }
 
 func (r *WorkdayConnectionResource) ValidateWorkdayConnectionResponse(apiResp *openapi.GetConnectionDetailsResponse) error {
@@ -756,7 +772,7 @@ func (r *WorkdayConnectionResource) UpdateWorkdayConnection(ctx context.Context,
 
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_workday_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -838,8 +854,14 @@ func (r *WorkdayConnectionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateWorkdayConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateWorkdayConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Workday connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -894,7 +916,9 @@ func (r *WorkdayConnectionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 
 	apiMessage := util.SafeDeref(apiResp.WorkdayConnectionResponse.Msg)
 	if apiMessage == "success" {
@@ -993,8 +1017,14 @@ func (r *WorkdayConnectionResource) Update(ctx context.Context, req resource.Upd
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateWorkdayConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateWorkdayConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Workday connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -1016,7 +1046,9 @@ func (r *WorkdayConnectionResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -16,6 +16,8 @@ import (
 	"net/http"
 	"os"
 	"terraform-provider-Saviynt/internal/client"
+	"terraform-provider-Saviynt/internal/provider/customtypes"
+	"terraform-provider-Saviynt/internal/provider/validators"
 	"terraform-provider-Saviynt/util"
 	"terraform-provider-Saviynt/util/errorsutil"
 
@@ -40,41 +42,41 @@ var workdaySOAPErrorCodes = errorsutil.NewConnectorErrorCodes(errorsutil.Connect
 
 type WorkdaySOAPConnectorResourceModel struct {
 	BaseConnectorResourceModel
-	ID                       types.String `tfsdk:"id"`
-	Username                 types.String `tfsdk:"username"`
-	Password                 types.String `tfsdk:"password"`
-	PasswordWo               types.String `tfsdk:"password_wo"`
-	SoapEndpoint             types.String `tfsdk:"soap_endpoint"`
-	AccountsImportJson       types.String `tfsdk:"accounts_import_json"`
-	ChangePassJson           types.String `tfsdk:"change_pass_json"`
-	ChangePassJsonWo         types.String `tfsdk:"change_pass_json_wo"`
-	CombinedCreateRequest    types.String `tfsdk:"combined_create_request"`
-	ConnectionJson           types.String `tfsdk:"connection_json"`
-	ConnectionJsonWo         types.String `tfsdk:"connection_json_wo"`
-	CreateAccountJson        types.String `tfsdk:"create_account_json"`
-	CustomConfig             types.String `tfsdk:"custom_config"`
-	DataToImport             types.String `tfsdk:"data_to_import"`
-	DateFormat               types.String `tfsdk:"date_format"`
-	DeleteAccountJson        types.String `tfsdk:"delete_account_json"`
-	DisableAccountJson       types.String `tfsdk:"disable_account_json"`
-	EnableAccountJson        types.String `tfsdk:"enable_account_json"`
-	GrantAccessJson          types.String `tfsdk:"grant_access_json"`
-	HrImportJson             types.String `tfsdk:"hr_import_json"`
-	ModifyUserDataJson       types.String `tfsdk:"modify_user_data_json"`
-	PageSize                 types.String `tfsdk:"page_size"`
-	PamConfig                types.String `tfsdk:"pam_config"`
-	PasswordMaxLength        types.String `tfsdk:"password_max_length"`
-	PasswordMinLength        types.String `tfsdk:"password_min_length"`
-	PasswordNoofCapsAlpha    types.String `tfsdk:"password_noofcapsalpha"`
-	PasswordNoofDigits       types.String `tfsdk:"password_noofdigits"`
-	PasswordNoofSplChars     types.String `tfsdk:"password_noofsplchars"`
-	PasswordType             types.String `tfsdk:"password_type"`
-	ResponsePathPageResults  types.String `tfsdk:"responsepath_pageresults"`
-	ResponsePathTotalResults types.String `tfsdk:"responsepath_totalresults"`
-	ResponsePathUserList     types.String `tfsdk:"responsepath_userlist"`
-	RevokeAccessJson         types.String `tfsdk:"revoke_access_json"`
-	UpdateAccountJson        types.String `tfsdk:"update_account_json"`
-	UpdateUserJson           types.String `tfsdk:"update_user_json"`
+	ID                       types.String           `tfsdk:"id"`
+	Username                 types.String           `tfsdk:"username"`
+	Password                 types.String           `tfsdk:"password"`
+	PasswordWo               types.String           `tfsdk:"password_wo"`
+	SoapEndpoint             types.String           `tfsdk:"soap_endpoint"`
+	AccountsImportJson       customtypes.JSONString `tfsdk:"accounts_import_json"`
+	ChangePassJson           customtypes.JSONString `tfsdk:"change_pass_json"`
+	ChangePassJsonWo         types.String           `tfsdk:"change_pass_json_wo"`
+	CombinedCreateRequest    types.String           `tfsdk:"combined_create_request"`
+	ConnectionJson           customtypes.JSONString `tfsdk:"connection_json"`
+	ConnectionJsonWo         types.String           `tfsdk:"connection_json_wo"`
+	CreateAccountJson        customtypes.JSONString `tfsdk:"create_account_json"`
+	CustomConfig             customtypes.JSONString `tfsdk:"custom_config"`
+	DataToImport             types.String           `tfsdk:"data_to_import"`
+	DateFormat               types.String           `tfsdk:"date_format"`
+	DeleteAccountJson        customtypes.JSONString `tfsdk:"delete_account_json"`
+	DisableAccountJson       customtypes.JSONString `tfsdk:"disable_account_json"`
+	EnableAccountJson        customtypes.JSONString `tfsdk:"enable_account_json"`
+	GrantAccessJson          customtypes.JSONString `tfsdk:"grant_access_json"`
+	HrImportJson             customtypes.JSONString `tfsdk:"hr_import_json"`
+	ModifyUserDataJson       customtypes.JSONString `tfsdk:"modify_user_data_json"`
+	PageSize                 types.String           `tfsdk:"page_size"`
+	PamConfig                customtypes.JSONString `tfsdk:"pam_config"`
+	PasswordMaxLength        types.String           `tfsdk:"password_max_length"`
+	PasswordMinLength        types.String           `tfsdk:"password_min_length"`
+	PasswordNoofCapsAlpha    types.String           `tfsdk:"password_noofcapsalpha"`
+	PasswordNoofDigits       types.String           `tfsdk:"password_noofdigits"`
+	PasswordNoofSplChars     types.String           `tfsdk:"password_noofsplchars"`
+	PasswordType             types.String           `tfsdk:"password_type"`
+	ResponsePathPageResults  types.String           `tfsdk:"responsepath_pageresults"`
+	ResponsePathTotalResults types.String           `tfsdk:"responsepath_totalresults"`
+	ResponsePathUserList     types.String           `tfsdk:"responsepath_userlist"`
+	RevokeAccessJson         customtypes.JSONString `tfsdk:"revoke_access_json"`
+	UpdateAccountJson        customtypes.JSONString `tfsdk:"update_account_json"`
+	UpdateUserJson           customtypes.JSONString `tfsdk:"update_user_json"`
 }
 
 type WorkdaySOAPConnectionResource struct {
@@ -133,11 +135,13 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "SOAP endpoint URL for Workday. Example: \"https://wd2-impl-services1.workday.com/ccx/service/tenant/Human_Resources/v35.0\"",
 		},
 		"accounts_import_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for accounts import.",
 		},
 		"change_pass_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "JSON configuration for password changes. Either this or change_pass_json_wo must be set.",
@@ -159,11 +163,13 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Combined create request configuration.",
 		},
 		"connection_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Sensitive:   true,
 			Description: "General connection JSON configuration. Either this or connection_json_wo must be set.",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json_wo")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"connection_json_wo": schema.StringAttribute{
@@ -172,17 +178,24 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Write-only general connection JSON configuration. Either this or connection_json must be set.",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRoot("connection_json")),
+				validators.JSONSchema("rest_connection_json"),
 			},
 		},
 		"create_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for account creation.",
 		},
 		"custom_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "Custom configuration JSON.",
+			Validators: []validator.String{
+				validators.JSONSchema("config_json"),
+				ConfigJSONTimeoutConflict(),
+			},
 		},
 		"data_to_import": schema.StringAttribute{
 			Optional:    true,
@@ -195,31 +208,37 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Date format for data processing. Example: \"yyyy-MM-dd\"",
 		},
 		"delete_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for account deletion.",
 		},
 		"disable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for account disabling.",
 		},
 		"enable_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for account enabling.",
 		},
 		"grant_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for granting access.",
 		},
 		"hr_import_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for HR data import.",
 		},
 		"modify_user_data_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for modifying user data.",
@@ -230,6 +249,7 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Number of records per page. Example: \"100\"",
 		},
 		"pam_config": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "PAM configuration JSON.",
@@ -280,16 +300,19 @@ func WorkdaySOAPConnectorResourceSchema() map[string]schema.Attribute {
 			Description: "Response path for user list.",
 		},
 		"revoke_access_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for revoking access.",
 		},
 		"update_account_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for account updates.",
 		},
 		"update_user_json": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
 			Optional:    true,
 			Computed:    true,
 			Description: "JSON configuration for user updates.",
@@ -393,26 +416,26 @@ func (r *WorkdaySOAPConnectionResource) BuildWorkdaySOAPConnector(plan *WorkdayS
 		SOAP_ENDPOINT: util.StringPointerOrEmpty(plan.SoapEndpoint),
 
 		// Data Import Configuration
-		ACCOUNTS_IMPORT_JSON: util.StringPointerOrEmpty(plan.AccountsImportJson),
-		HR_IMPORT_JSON:       util.StringPointerOrEmpty(plan.HrImportJson),
+		ACCOUNTS_IMPORT_JSON: util.StringPointerOrEmpty(plan.AccountsImportJson.StringValue),
+		HR_IMPORT_JSON:       util.StringPointerOrEmpty(plan.HrImportJson.StringValue),
 		DATA_TO_IMPORT:       util.StringPointerOrEmpty(plan.DataToImport),
 		PAGE_SIZE:            util.StringPointerOrEmpty(plan.PageSize),
 		DATEFORMAT:           util.StringPointerOrEmpty(plan.DateFormat),
 
 		// Account Management
-		CREATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.CreateAccountJson),
-		UPDATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.UpdateAccountJson),
-		DELETEACCOUNTJSON:  util.StringPointerOrEmpty(plan.DeleteAccountJson),
-		ENABLEACCOUNTJSON:  util.StringPointerOrEmpty(plan.EnableAccountJson),
-		DISABLEACCOUNTJSON: util.StringPointerOrEmpty(plan.DisableAccountJson),
+		CREATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.CreateAccountJson.StringValue),
+		UPDATEACCOUNTJSON:  util.StringPointerOrEmpty(plan.UpdateAccountJson.StringValue),
+		DELETEACCOUNTJSON:  util.StringPointerOrEmpty(plan.DeleteAccountJson.StringValue),
+		ENABLEACCOUNTJSON:  util.StringPointerOrEmpty(plan.EnableAccountJson.StringValue),
+		DISABLEACCOUNTJSON: util.StringPointerOrEmpty(plan.DisableAccountJson.StringValue),
 
 		// User Management
-		UPDATEUSERJSON:     util.StringPointerOrEmpty(plan.UpdateUserJson),
-		MODIFYUSERDATAJSON: util.StringPointerOrEmpty(plan.ModifyUserDataJson),
+		UPDATEUSERJSON:     util.StringPointerOrEmpty(plan.UpdateUserJson.StringValue),
+		MODIFYUSERDATAJSON: util.StringPointerOrEmpty(plan.ModifyUserDataJson.StringValue),
 
 		// Access Management
-		GRANTACCESSJSON:  util.StringPointerOrEmpty(plan.GrantAccessJson),
-		REVOKEACCESSJSON: util.StringPointerOrEmpty(plan.RevokeAccessJson),
+		GRANTACCESSJSON:  util.StringPointerOrEmpty(plan.GrantAccessJson.StringValue),
+		REVOKEACCESSJSON: util.StringPointerOrEmpty(plan.RevokeAccessJson.StringValue),
 
 		// Password Management
 		CHANGEPASSJSON:         &changePassJson,
@@ -430,8 +453,8 @@ func (r *WorkdaySOAPConnectionResource) BuildWorkdaySOAPConnector(plan *WorkdayS
 
 		// Advanced Configuration
 		CONNECTIONJSON:        &connectionJson,
-		CUSTOM_CONFIG:         util.StringPointerOrEmpty(plan.CustomConfig),
-		PAM_CONFIG:            util.StringPointerOrEmpty(plan.PamConfig),
+		CUSTOM_CONFIG:         util.StringPointerOrEmpty(plan.CustomConfig.StringValue),
+		PAM_CONFIG:            util.StringPointerOrEmpty(plan.PamConfig.StringValue),
 		COMBINEDCREATEREQUEST: util.StringPointerOrEmpty(plan.CombinedCreateRequest),
 	}
 
@@ -446,20 +469,20 @@ func (r *WorkdaySOAPConnectionResource) UpdateModelFromCreateResponse(plan *Work
 	plan.EmailTemplate = util.SafeStringDatasource(plan.EmailTemplate.ValueStringPointer())
 	plan.Username = util.SafeStringDatasource(plan.Username.ValueStringPointer())
 	plan.SoapEndpoint = util.SafeStringDatasource(plan.SoapEndpoint.ValueStringPointer())
-	plan.AccountsImportJson = util.SafeStringDatasource(plan.AccountsImportJson.ValueStringPointer())
+	plan.AccountsImportJson = customtypes.NewJSONStringPointerValue(plan.AccountsImportJson.ValueStringPointer())
 	plan.CombinedCreateRequest = util.SafeStringDatasource(plan.CombinedCreateRequest.ValueStringPointer())
-	plan.CreateAccountJson = util.SafeStringDatasource(plan.CreateAccountJson.ValueStringPointer())
-	plan.CustomConfig = util.SafeStringDatasource(plan.CustomConfig.ValueStringPointer())
+	plan.CreateAccountJson = customtypes.NewJSONStringPointerValue(plan.CreateAccountJson.ValueStringPointer())
+	plan.CustomConfig = customtypes.NewJSONStringPointerValue(plan.CustomConfig.ValueStringPointer())
 	plan.DataToImport = util.SafeStringDatasource(plan.DataToImport.ValueStringPointer())
 	plan.DateFormat = util.SafeStringDatasource(plan.DateFormat.ValueStringPointer())
-	plan.DeleteAccountJson = util.SafeStringDatasource(plan.DeleteAccountJson.ValueStringPointer())
-	plan.DisableAccountJson = util.SafeStringDatasource(plan.DisableAccountJson.ValueStringPointer())
-	plan.EnableAccountJson = util.SafeStringDatasource(plan.EnableAccountJson.ValueStringPointer())
-	plan.GrantAccessJson = util.SafeStringDatasource(plan.GrantAccessJson.ValueStringPointer())
-	plan.HrImportJson = util.SafeStringDatasource(plan.HrImportJson.ValueStringPointer())
-	plan.ModifyUserDataJson = util.SafeStringDatasource(plan.ModifyUserDataJson.ValueStringPointer())
+	plan.DeleteAccountJson = customtypes.NewJSONStringPointerValue(plan.DeleteAccountJson.ValueStringPointer())
+	plan.DisableAccountJson = customtypes.NewJSONStringPointerValue(plan.DisableAccountJson.ValueStringPointer())
+	plan.EnableAccountJson = customtypes.NewJSONStringPointerValue(plan.EnableAccountJson.ValueStringPointer())
+	plan.GrantAccessJson = customtypes.NewJSONStringPointerValue(plan.GrantAccessJson.ValueStringPointer())
+	plan.HrImportJson = customtypes.NewJSONStringPointerValue(plan.HrImportJson.ValueStringPointer())
+	plan.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(plan.ModifyUserDataJson.ValueStringPointer())
 	plan.PageSize = util.SafeStringDatasource(plan.PageSize.ValueStringPointer())
-	plan.PamConfig = util.SafeStringDatasource(plan.PamConfig.ValueStringPointer())
+	plan.PamConfig = customtypes.NewJSONStringPointerValue(plan.PamConfig.ValueStringPointer())
 	plan.PasswordMaxLength = util.SafeStringDatasource(plan.PasswordMaxLength.ValueStringPointer())
 	plan.PasswordMinLength = util.SafeStringDatasource(plan.PasswordMinLength.ValueStringPointer())
 	plan.PasswordNoofCapsAlpha = util.SafeStringDatasource(plan.PasswordNoofCapsAlpha.ValueStringPointer())
@@ -469,9 +492,9 @@ func (r *WorkdaySOAPConnectionResource) UpdateModelFromCreateResponse(plan *Work
 	plan.ResponsePathPageResults = util.SafeStringDatasource(plan.ResponsePathPageResults.ValueStringPointer())
 	plan.ResponsePathTotalResults = util.SafeStringDatasource(plan.ResponsePathTotalResults.ValueStringPointer())
 	plan.ResponsePathUserList = util.SafeStringDatasource(plan.ResponsePathUserList.ValueStringPointer())
-	plan.RevokeAccessJson = util.SafeStringDatasource(plan.RevokeAccessJson.ValueStringPointer())
-	plan.UpdateAccountJson = util.SafeStringDatasource(plan.UpdateAccountJson.ValueStringPointer())
-	plan.UpdateUserJson = util.SafeStringDatasource(plan.UpdateUserJson.ValueStringPointer())
+	plan.RevokeAccessJson = customtypes.NewJSONStringPointerValue(plan.RevokeAccessJson.ValueStringPointer())
+	plan.UpdateAccountJson = customtypes.NewJSONStringPointerValue(plan.UpdateAccountJson.ValueStringPointer())
+	plan.UpdateUserJson = customtypes.NewJSONStringPointerValue(plan.UpdateUserJson.ValueStringPointer())
 	plan.Msg = types.StringValue(util.SafeDeref(apiResp.Msg))
 	plan.ErrorCode = types.StringValue(util.SafeDeref(apiResp.ErrorCode))
 
@@ -531,7 +554,7 @@ func (r *WorkdaySOAPConnectionResource) CreateWorkdaySOAPConnection(ctx context.
 	tflog.Debug(ctx, "Executing create operation")
 	err = r.provider.AuthenticatedAPICallWithRetry(ctx, "create_workday_soap_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, createReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -653,7 +676,7 @@ func (r *WorkdaySOAPConnectionResource) UpdateWorkdaySOAPConnection(ctx context.
 	tflog.Debug(logCtx, "Executing update operation")
 	err := r.provider.AuthenticatedAPICallWithRetry(ctx, "update_workday_soap_connection", func(token string) error {
 		connectionOps := r.connectionFactory.CreateConnectionOperations(r.client.APIBaseURL(), token)
-		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq)
+		resp, httpResp, err := connectionOps.CreateOrUpdateConnection(ctx, updateReq, BuildConnectionTimeoutConfig(plan.ConnectionTimeoutConfig))
 		if httpResp != nil && httpResp.StatusCode == 401 {
 			return fmt.Errorf("401 unauthorized")
 		}
@@ -701,20 +724,20 @@ func (r *WorkdaySOAPConnectionResource) UpdateModelFromReadResponse(state *Workd
 		attrs := apiResp.WorkdaySOAPConnectionResponse.Connectionattributes
 		state.Username = util.SafeStringDatasource(attrs.USERNAME)
 		state.SoapEndpoint = util.SafeStringDatasource(attrs.SOAP_ENDPOINT)
-		state.AccountsImportJson = util.SafeStringDatasource(attrs.ACCOUNTS_IMPORT_JSON)
+		state.AccountsImportJson = customtypes.NewJSONStringPointerValue(attrs.ACCOUNTS_IMPORT_JSON)
 		state.CombinedCreateRequest = util.SafeStringDatasource(attrs.COMBINEDCREATEREQUEST)
-		state.CreateAccountJson = util.SafeStringDatasource(attrs.CREATEACCOUNTJSON)
-		state.CustomConfig = util.SafeStringDatasource(attrs.CUSTOM_CONFIG)
+		state.CreateAccountJson = customtypes.NewJSONStringPointerValue(attrs.CREATEACCOUNTJSON)
+		state.CustomConfig = customtypes.NewJSONStringPointerValue(attrs.CUSTOM_CONFIG)
 		state.DataToImport = util.SafeStringDatasource(attrs.DATA_TO_IMPORT)
 		state.DateFormat = util.SafeStringDatasource(attrs.DATEFORMAT)
-		state.DeleteAccountJson = util.SafeStringDatasource(attrs.DELETEACCOUNTJSON)
-		state.DisableAccountJson = util.SafeStringDatasource(attrs.DISABLEACCOUNTJSON)
-		state.EnableAccountJson = util.SafeStringDatasource(attrs.ENABLEACCOUNTJSON)
-		state.GrantAccessJson = util.SafeStringDatasource(attrs.GRANTACCESSJSON)
-		state.HrImportJson = util.SafeStringDatasource(attrs.HR_IMPORT_JSON)
-		state.ModifyUserDataJson = util.SafeStringDatasource(attrs.MODIFYUSERDATAJSON)
+		state.DeleteAccountJson = customtypes.NewJSONStringPointerValue(attrs.DELETEACCOUNTJSON)
+		state.DisableAccountJson = customtypes.NewJSONStringPointerValue(attrs.DISABLEACCOUNTJSON)
+		state.EnableAccountJson = customtypes.NewJSONStringPointerValue(attrs.ENABLEACCOUNTJSON)
+		state.GrantAccessJson = customtypes.NewJSONStringPointerValue(attrs.GRANTACCESSJSON)
+		state.HrImportJson = customtypes.NewJSONStringPointerValue(attrs.HR_IMPORT_JSON)
+		state.ModifyUserDataJson = customtypes.NewJSONStringPointerValue(attrs.MODIFYUSERDATAJSON)
 		state.PageSize = util.SafeStringDatasource(attrs.PAGE_SIZE)
-		state.PamConfig = util.SafeStringDatasource(attrs.PAM_CONFIG)
+		state.PamConfig = customtypes.NewJSONStringPointerValue(attrs.PAM_CONFIG)
 		state.PasswordMaxLength = util.SafeStringDatasource(attrs.PASSWORD_MAX_LENGTH)
 		state.PasswordMinLength = util.SafeStringDatasource(attrs.PASSWORD_MIN_LENGTH)
 		state.PasswordNoofCapsAlpha = util.SafeStringDatasource(attrs.PASSWORD_NOOFCAPSALPHA)
@@ -724,9 +747,10 @@ func (r *WorkdaySOAPConnectionResource) UpdateModelFromReadResponse(state *Workd
 		state.ResponsePathPageResults = util.SafeStringDatasource(attrs.RESPONSEPATH_PAGERESULTS)
 		state.ResponsePathTotalResults = util.SafeStringDatasource(attrs.RESPONSEPATH_TOTALRESULTS)
 		state.ResponsePathUserList = util.SafeStringDatasource(attrs.RESPONSEPATH_USERLIST)
-		state.RevokeAccessJson = util.SafeStringDatasource(attrs.REVOKEACCESSJSON)
-		state.UpdateAccountJson = util.SafeStringDatasource(attrs.UPDATEACCOUNTJSON)
-		state.UpdateUserJson = util.SafeStringDatasource(attrs.UPDATEUSERJSON)
+		state.RevokeAccessJson = customtypes.NewJSONStringPointerValue(attrs.REVOKEACCESSJSON)
+		state.UpdateAccountJson = customtypes.NewJSONStringPointerValue(attrs.UPDATEACCOUNTJSON)
+		state.UpdateUserJson = customtypes.NewJSONStringPointerValue(attrs.UPDATEUSERJSON)
+		state.ConnectionTimeoutConfig = ReadConnectionTimeoutConfig(state.ConnectionTimeoutConfig, attrs.ConnectionTimeoutConfig)
 	}
 }
 
@@ -769,8 +793,14 @@ func (r *WorkdaySOAPConnectionResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	apiResp, err := r.CreateWorkdaySOAPConnection(ctx, &plan, &config)
+	apiResp, err := r.CreateWorkdaySOAPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Workday SOAP connection creation failed", "", err)
 		resp.Diagnostics.AddError(
@@ -825,7 +855,9 @@ func (r *WorkdaySOAPConnectionResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Update model from read response
+	prior := state
 	r.UpdateModelFromReadResponse(&state, apiResp)
+	keepSecretPlaceholders(&prior, &state)
 	apiMessage := util.SafeDeref(apiResp.WorkdaySOAPConnectionResponse.Msg)
 	if apiMessage == "success" {
 		state.Msg = types.StringValue("Connection Read Successful")
@@ -918,8 +950,14 @@ func (r *WorkdaySOAPConnectionResource) Update(ctx context.Context, req resource
 	opCtx.ConnectionName = connectionName
 	ctx = opCtx.AddContextToLogger(ctx)
 
+	requestPlan, requestConfig, secretDiags := connectionRequestModels(ctx, plan, config, config.Secrets)
+	resp.Diagnostics.Append(secretDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use interface pattern instead of direct API client creation
-	updateResp, err := r.UpdateWorkdaySOAPConnection(ctx, &plan, &config)
+	updateResp, err := r.UpdateWorkdaySOAPConnection(ctx, &requestPlan, &requestConfig)
 	if err != nil {
 		opCtx.LogOperationError(ctx, "Workday SOAP connection update failed", "", err)
 		resp.Diagnostics.AddError(
@@ -941,7 +979,9 @@ func (r *WorkdaySOAPConnectionResource) Update(ctx context.Context, req resource
 	}
 
 	// Update model from read response
+	prior := plan
 	r.UpdateModelFromReadResponse(&plan, getResp)
+	keepSecretPlaceholders(&prior, &plan)
 
 	apiMessage := util.SafeDeref(updateResp.Msg)
 	plan.Msg = types.StringValue(apiMessage)
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds calls for connector types that have no typed model.
+package connections
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// CreateOrUpdateGeneric creates or updates a connection of any connector type through the same
+// multipart form call as CreateOrUpdate. When the connection is saved with a
+// connectionTimeoutConfig attribute, it is verified the way CreateOrUpdateWithTimeoutConfig does.
+func (a *ConnectionsAPIService) CreateOrUpdateGeneric(ctx context.Context, connector GenericConnector) (*CreateOrUpdateResponse, *http.Response, error) {
+	var localVarReturnValue *CreateOrUpdateResponse
+
+	formBody, contentType, err := ConvertToMultipartForm(connector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating multipart form: %w", err)
+	}
+	headers := map[string]string{
+		"Content-Type": contentType,
+		"Accept":       "application/json",
+	}
+
+	localVarHTTPResponse, err := a.postCustom(ctx, "ConnectionsAPIService.CreateOrUpdate", "/ECM/api/v5/testConnection", formBody, headers, &localVarReturnValue)
+	if err != nil || localVarReturnValue == nil || (localVarReturnValue.ErrorCode != nil && *localVarReturnValue.ErrorCode != "0") {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	timeoutConfig, ok := connector.Attributes["connectionTimeoutConfig"]
+	if !ok || connector.Attributes["saveconnection"] == "N" {
+		return localVarReturnValue, localVarHTTPResponse, nil
+	}
+	if err := a.verifyTimeoutConfig(ctx, connector.ConnectionName, timeoutConfig); err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// TestConnectionGeneric runs the Test Connection check of Saviynt for a connection of any
+// connector type. saveconnection is sent as "N", so the connection is tested without being saved.
+func (a *ConnectionsAPIService) TestConnectionGeneric(ctx context.Context, connector GenericConnector) (*CreateOrUpdateResponse, *http.Response, error) {
+	attributes := make(map[string]string, len(connector.Attributes)+1)
+	for name, value := range connector.Attributes {
+		attributes[name] = value
+	}
+	attributes["saveconnection"] = "N"
+	connector.Attributes = attributes
+
+	return a.CreateOrUpdateGeneric(ctx, connector)
+}
+
+// GetConnectionDetailsGeneric returns the details of a connection of any connector type, with its
+// attributes left undecoded.
+func (a *ConnectionsAPIService) GetConnectionDetailsGeneric(ctx context.Context, getConnectionDetailsRequest GetConnectionDetailsRequest) (*GenericConnectionResponse, *http.Response, error) {
+	var localVarReturnValue *GenericConnectionResponse
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	}
+
+	localVarHTTPResponse, err := a.postCustom(ctx, "ConnectionsAPIService.GetConnectionDetails", "/ECM/api/v5/getConnectionDetails", &getConnectionDetailsRequest, headers, &localVarReturnValue)
+	return localVarReturnValue, localVarHTTPResponse, err
+}
+
+// postCustom posts body to path and decodes the response into returnValue, the way the generated
+// Execute methods do.
+func (a *ConnectionsAPIService) postCustom(ctx context.Context, operation, path string, body interface{}, headers map[string]string, returnValue interface{}) (*http.Response, error) {
+	localBasePath, err := a.client.cfg.ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	req, err := a.client.prepareRequest(ctx, localBasePath+path, http.MethodPost, body, headers, url.Values{}, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		return localVarHTTPResponse, &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+	}
+
+	if err := a.client.decode(returnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type")); err != nil {
+		return localVarHTTPResponse, &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+	}
+	return localVarHTTPResponse, nil
+}
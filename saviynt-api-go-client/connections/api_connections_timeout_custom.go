@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds the timeout settings of a connection to create or update.
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// CreateOrUpdateWithTimeoutConfig creates or updates a connection the way CreateOrUpdate does and
+// sends timeoutConfig as the connectionTimeoutConfig attribute, which is a JSON string like the
+// other JSON attributes of the multipart form. A nil timeoutConfig sends the request unchanged.
+//
+// api/openapi.yaml lists connectionTimeoutConfig in the connection attributes returned by Get
+// Connection Details, but not in the create or update request. When Saviynt accepts the request,
+// the connection is read back and an error is returned if it does not hold the sent settings.
+func (a *ConnectionsAPIService) CreateOrUpdateWithTimeoutConfig(ctx context.Context, createOrUpdateRequest CreateOrUpdateRequest, timeoutConfig *ConnectionTimeoutConfig) (*CreateOrUpdateResponse, *http.Response, error) {
+	if timeoutConfig == nil {
+		return a.CreateOrUpdate(ctx).CreateOrUpdateRequest(createOrUpdateRequest).Execute()
+	}
+
+	var localVarReturnValue *CreateOrUpdateResponse
+
+	serialized, err := json.Marshal(createOrUpdateRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal connection: %w", err)
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(serialized, &fields); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal connection: %w", err)
+	}
+	timeoutConfigJSON, err := json.Marshal(timeoutConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal connectionTimeoutConfig: %w", err)
+	}
+	fields["connectionTimeoutConfig"] = string(timeoutConfigJSON)
+
+	formBody, contentType, err := ConvertToMultipartForm(fields)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating multipart form: %w", err)
+	}
+	headers := map[string]string{
+		"Content-Type": contentType,
+		"Accept":       "application/json",
+	}
+
+	localVarHTTPResponse, err := a.postCustom(ctx, "ConnectionsAPIService.CreateOrUpdate", "/ECM/api/v5/testConnection", formBody, headers, &localVarReturnValue)
+	if err != nil || localVarReturnValue == nil || (localVarReturnValue.ErrorCode != nil && *localVarReturnValue.ErrorCode != "0") {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	connectionName, _ := fields["connectionName"].(string)
+	if err := a.verifyTimeoutConfig(ctx, connectionName, string(timeoutConfigJSON)); err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// verifyTimeoutConfig reads the connection back and returns an error unless its
+// connectionTimeoutConfig holds every setting of timeoutConfig, the JSON string that was sent.
+func (a *ConnectionsAPIService) verifyTimeoutConfig(ctx context.Context, connectionName string, timeoutConfig string) error {
+	request := GetConnectionDetailsRequest{}
+	request.SetConnectionname(connectionName)
+	details, _, err := a.GetConnectionDetailsGeneric(ctx, request)
+	if err != nil {
+		return fmt.Errorf("failed to read connection %s back to verify connectionTimeoutConfig: %w", connectionName, err)
+	}
+
+	expected := decodeTimeoutConfig(timeoutConfig)
+
+	var actual map[string]interface{}
+	if details != nil {
+		actual = decodeTimeoutConfig(details.Connectionattributes["connectionTimeoutConfig"])
+	}
+	var mismatched []string
+	for key, value := range expected {
+		// Saviynt may return the numbers as strings, so compare their text
+		got, ok := actual[key]
+		if !ok || got == nil {
+			mismatched = append(mismatched, fmt.Sprintf("%s is not set instead of %v", key, value))
+		} else if fmt.Sprint(got) != fmt.Sprint(value) {
+			mismatched = append(mismatched, fmt.Sprintf("%s is %v instead of %v", key, got, value))
+		}
+	}
+	if len(mismatched) > 0 {
+		sort.Strings(mismatched)
+		return fmt.Errorf("Saviynt accepted connection %s but did not apply connectionTimeoutConfig: %s",
+			connectionName, strings.Join(mismatched, ", "))
+	}
+	return nil
+}
+
+// decodeTimeoutConfig decodes the connectionTimeoutConfig attribute of a connection, which holds
+// either an object or a JSON string. It returns nil when the attribute is missing or not an object.
+func decodeTimeoutConfig(value interface{}) map[string]interface{} {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		data = []byte(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		data = encoded
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var config map[string]interface{}
+	if decoder.Decode(&config) != nil {
+		return nil
+	}
+	return config
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds models for connector types that have no typed model.
+package connections
+
+import (
+	"encoding/json"
+)
+
+// checks if the GenericConnector type satisfies the MappedNullable interface at compile time
+var _ MappedNullable = &GenericConnector{}
+
+// GenericConnector is a connection of any connector type. Attributes are sent as top level
+// fields next to the fields of BaseConnector, the way the typed connectors send theirs.
+type GenericConnector struct {
+	BaseConnector
+	// Connector specific attributes keyed by their API name, for example "URL" or "CONFIG_JSON".
+	Attributes map[string]string `json:"-"`
+}
+
+// NewGenericConnector instantiates a new GenericConnector object
+func NewGenericConnector(connectionName string, connectiontype string, attributes map[string]string) *GenericConnector {
+	this := GenericConnector{}
+	this.ConnectionName = connectionName
+	this.Connectiontype = connectiontype
+	this.Attributes = attributes
+	return &this
+}
+
+func (o GenericConnector) MarshalJSON() ([]byte, error) {
+	toSerialize, err := o.ToMap()
+	if err != nil {
+		return []byte{}, err
+	}
+	return json.Marshal(toSerialize)
+}
+
+// ToMap returns the attributes merged with the fields of BaseConnector, which take precedence.
+func (o GenericConnector) ToMap() (map[string]interface{}, error) {
+	toSerialize := map[string]interface{}{}
+	for name, value := range o.Attributes {
+		toSerialize[name] = value
+	}
+	base, err := o.BaseConnector.ToMap()
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	for name, value := range base {
+		toSerialize[name] = value
+	}
+	return toSerialize, nil
+}
+
+// GenericConnectionResponse is the getConnectionDetails response of a connection of any connector
+// type, with its attributes left undecoded.
+type GenericConnectionResponse struct {
+	// API response message
+	Msg *string `json:"msg,omitempty"`
+	// Email template for the connection
+	Emailtemplate *string `json:"emailtemplate,omitempty"`
+	// Updator account for the connection
+	Updatedby *string `json:"updatedby,omitempty"`
+	// Name of the connection
+	Connectionname *string `json:"connectionname,omitempty"`
+	// Connection key
+	Connectionkey *int32 `json:"connectionkey,omitempty"`
+	// Description for the connection
+	Description *string `json:"description,omitempty"`
+	// Connection type
+	Connectiontype *string `json:"connectiontype,omitempty"`
+	// Connection creation time
+	Createdon *string `json:"createdon,omitempty"`
+	// Creator account for the connection
+	Createdby *string `json:"createdby,omitempty"`
+	// Error code
+	Errorcode       *int32  `json:"errorcode,omitempty"`
+	Status          *int32  `json:"status,omitempty"`
+	Defaultsavroles *string `json:"defaultsavroles,omitempty"`
+	// Connector specific attributes as returned by the API
+	Connectionattributes map[string]interface{} `json:"connectionattributes,omitempty"`
+}
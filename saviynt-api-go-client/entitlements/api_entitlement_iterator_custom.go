@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds a paging iterator to EntitlementAPIService.
+package entitlements
+
+import (
+	"context"
+	"iter"
+
+	"github.com/saviynt/saviynt-api-go-client/util/paginationutil"
+)
+
+// GetEntitlementsAll returns an iterator over every record of GetEntitlements matching req, read pageSize
+// entitlements at a time (paginationutil.DefaultPageSize when zero). The offset and max of req are set
+// for each page. Iteration stops after a short page; a failed call, an error code in the response
+// or the cancellation of ctx is yielded as an error and ends the iteration.
+func (a *EntitlementAPIService) GetEntitlementsAll(ctx context.Context, req GetEntitlementRequest, pageSize int) iter.Seq2[GetEntitlementResponseEntitlementdetailsInner, error] {
+	return paginationutil.All(ctx, pageSize, func(ctx context.Context, offset, max int) ([]GetEntitlementResponseEntitlementdetailsInner, error) {
+		req.SetOffset(int32(offset))
+		req.SetMax(int32(max))
+		resp, _, err := a.GetEntitlements(ctx).GetEntitlementRequest(req).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, nil
+		}
+		if err := paginationutil.ResponseError(resp.ErrorCode, resp.Msg); err != nil {
+			return nil, err
+		}
+		return resp.Entitlementdetails, nil
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds a paging iterator to PrivilegeAPIService.
+package privileges
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/saviynt/saviynt-api-go-client/util/paginationutil"
+)
+
+// GetPrivilegeAll returns an iterator over every record of GetPrivilege matching req, read pageSize
+// privileges at a time (paginationutil.DefaultPageSize when zero). The offset and max of req are set
+// for each page. Iteration stops after a short page; a failed call, an error code in the response
+// or the cancellation of ctx is yielded as an error and ends the iteration.
+func (a *PrivilegeAPIService) GetPrivilegeAll(ctx context.Context, req GetPrivilegeListRequest, pageSize int) iter.Seq2[GetPrivilegeDetail, error] {
+	return paginationutil.All(ctx, pageSize, func(ctx context.Context, offset, max int) ([]GetPrivilegeDetail, error) {
+		req.SetOffset(strconv.Itoa(offset))
+		req.SetMax(strconv.Itoa(max))
+		resp, _, err := a.GetPrivilege(ctx).GetPrivilegeListRequest(req).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, nil
+		}
+		if err := paginationutil.ResponseError(resp.ErrorCode, resp.Msg); err != nil {
+			return nil, err
+		}
+		return resp.PrivilegeDetails, nil
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds a paging iterator to RolesAPIService.
+package roles
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/saviynt/saviynt-api-go-client/util/paginationutil"
+)
+
+// GetRolesAll returns an iterator over every record of GetRoles matching req, read pageSize
+// roles at a time (paginationutil.DefaultPageSize when zero). The offset and max of req are set
+// for each page. Iteration stops after a short page; a failed call, an error code in the response
+// or the cancellation of ctx is yielded as an error and ends the iteration.
+func (a *RolesAPIService) GetRolesAll(ctx context.Context, req GetRolesRequest, pageSize int) iter.Seq2[GetRoleDetailsResponse, error] {
+	return paginationutil.All(ctx, pageSize, func(ctx context.Context, offset, max int) ([]GetRoleDetailsResponse, error) {
+		req.SetOffset(strconv.Itoa(offset))
+		req.SetMax(strconv.Itoa(max))
+		resp, _, err := a.GetRoles(ctx).GetRolesRequest(req).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, nil
+		}
+		if err := paginationutil.ResponseError(resp.ErrorCode, resp.Msg); err != nil {
+			return nil, err
+		}
+		return resp.Roledetails, nil
+	})
+}
@@ -88,6 +88,7 @@ type CreateEnterpriseRoleRequest struct {
 	Customproperty60 *string                  `json:"customproperty60,omitempty"`
 	Endpointname     *string                  `json:"endpointname,omitempty"`
 	Defaulttimeframe *string                  `json:"defaulttimeframe,omitempty"`
+	Maxtimeframe     *string                  `json:"maxtimeframe,omitempty"`
 	Description      *string                  `json:"description,omitempty"`
 	Displayname      *string                  `json:"displayname,omitempty"`
 	Glossary         *string                  `json:"glossary,omitempty"`
@@ -2205,6 +2206,38 @@ func (o *CreateEnterpriseRoleRequest) SetDefaulttimeframe(v string) {
 	o.Defaulttimeframe = &v
 }
 
+// GetMaxtimeframe returns the Maxtimeframe field value if set, zero value otherwise.
+func (o *CreateEnterpriseRoleRequest) GetMaxtimeframe() string {
+	if o == nil || IsNil(o.Maxtimeframe) {
+		var ret string
+		return ret
+	}
+	return *o.Maxtimeframe
+}
+
+// GetMaxtimeframeOk returns a tuple with the Maxtimeframe field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateEnterpriseRoleRequest) GetMaxtimeframeOk() (*string, bool) {
+	if o == nil || IsNil(o.Maxtimeframe) {
+		return nil, false
+	}
+	return o.Maxtimeframe, true
+}
+
+// HasMaxtimeframe returns a boolean if a field has been set.
+func (o *CreateEnterpriseRoleRequest) HasMaxtimeframe() bool {
+	if o != nil && !IsNil(o.Maxtimeframe) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxtimeframe gets a reference to the given string and assigns it to the Maxtimeframe field.
+func (o *CreateEnterpriseRoleRequest) SetMaxtimeframe(v string) {
+	o.Maxtimeframe = &v
+}
+
 // GetDescription returns the Description field value if set, zero value otherwise.
 func (o *CreateEnterpriseRoleRequest) GetDescription() string {
 	if o == nil || IsNil(o.Description) {
@@ -2789,6 +2822,9 @@ func (o CreateEnterpriseRoleRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Defaulttimeframe) {
 		toSerialize["defaulttimeframe"] = o.Defaulttimeframe
 	}
+	if !IsNil(o.Maxtimeframe) {
+		toSerialize["maxtimeframe"] = o.Maxtimeframe
+	}
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
@@ -43,6 +43,7 @@ type GetRoleDetailsResponse struct {
 	LastReviewedCampaignName *string                                  `json:"lastReviewedCampaignName,omitempty"`
 	Risk                     *string                                  `json:"risk,omitempty"`
 	LastReviewedBy           *string                                  `json:"lastReviewedBy,omitempty"`
+	LastReviewedDate         *string                                  `json:"lastReviewedDate,omitempty"`
 	Owner                    *GetRoleDetailsResponseOwner             `json:"owner,omitempty"`
 	UserDetails              []GetRoleDetailsResponseUserDetailsInner `json:"UserDetails,omitempty"`
 	EntitlementDetails       []GetEntitlementDetailsResponse          `json:"EntitlementDetails,omitempty"`
@@ -893,6 +894,38 @@ func (o *GetRoleDetailsResponse) SetLastReviewedBy(v string) {
 	o.LastReviewedBy = &v
 }
 
+// GetLastReviewedDate returns the LastReviewedDate field value if set, zero value otherwise.
+func (o *GetRoleDetailsResponse) GetLastReviewedDate() string {
+	if o == nil || IsNil(o.LastReviewedDate) {
+		var ret string
+		return ret
+	}
+	return *o.LastReviewedDate
+}
+
+// GetLastReviewedDateOk returns a tuple with the LastReviewedDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GetRoleDetailsResponse) GetLastReviewedDateOk() (*string, bool) {
+	if o == nil || IsNil(o.LastReviewedDate) {
+		return nil, false
+	}
+	return o.LastReviewedDate, true
+}
+
+// HasLastReviewedDate returns a boolean if a field has been set.
+func (o *GetRoleDetailsResponse) HasLastReviewedDate() bool {
+	if o != nil && !IsNil(o.LastReviewedDate) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastReviewedDate gets a reference to the given string and assigns it to the LastReviewedDate field.
+func (o *GetRoleDetailsResponse) SetLastReviewedDate(v string) {
+	o.LastReviewedDate = &v
+}
+
 // GetOwner returns the Owner field value if set, zero value otherwise.
 func (o *GetRoleDetailsResponse) GetOwner() GetRoleDetailsResponseOwner {
 	if o == nil || IsNil(o.Owner) {
@@ -2991,6 +3024,9 @@ func (o GetRoleDetailsResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.LastReviewedBy) {
 		toSerialize["lastReviewedBy"] = o.LastReviewedBy
 	}
+	if !IsNil(o.LastReviewedDate) {
+		toSerialize["lastReviewedDate"] = o.LastReviewedDate
+	}
 	if !IsNil(o.Owner) {
 		toSerialize["owner"] = o.Owner
 	}
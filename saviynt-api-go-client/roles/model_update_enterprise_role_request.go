@@ -29,6 +29,7 @@ type UpdateEnterpriseRoleRequest struct {
 	Endpointname     *string                    `json:"endpointname,omitempty"`
 	Entitlements     []UpdateEntitlementPayload `json:"entitlements,omitempty"`
 	Defaulttimeframe *string                    `json:"defaulttimeframe,omitempty"`
+	Maxtimeframe     *string                    `json:"maxtimeframe,omitempty"`
 	Description      *string                    `json:"description,omitempty"`
 	Displayname      *string                    `json:"displayname,omitempty"`
 	Glossary         *string                    `json:"glossary,omitempty"`
@@ -365,6 +366,38 @@ func (o *UpdateEnterpriseRoleRequest) SetDefaulttimeframe(v string) {
 	o.Defaulttimeframe = &v
 }
 
+// GetMaxtimeframe returns the Maxtimeframe field value if set, zero value otherwise.
+func (o *UpdateEnterpriseRoleRequest) GetMaxtimeframe() string {
+	if o == nil || IsNil(o.Maxtimeframe) {
+		var ret string
+		return ret
+	}
+	return *o.Maxtimeframe
+}
+
+// GetMaxtimeframeOk returns a tuple with the Maxtimeframe field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdateEnterpriseRoleRequest) GetMaxtimeframeOk() (*string, bool) {
+	if o == nil || IsNil(o.Maxtimeframe) {
+		return nil, false
+	}
+	return o.Maxtimeframe, true
+}
+
+// HasMaxtimeframe returns a boolean if a field has been set.
+func (o *UpdateEnterpriseRoleRequest) HasMaxtimeframe() bool {
+	if o != nil && !IsNil(o.Maxtimeframe) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxtimeframe gets a reference to the given string and assigns it to the Maxtimeframe field.
+func (o *UpdateEnterpriseRoleRequest) SetMaxtimeframe(v string) {
+	o.Maxtimeframe = &v
+}
+
 // GetDescription returns the Description field value if set, zero value otherwise.
 func (o *UpdateEnterpriseRoleRequest) GetDescription() string {
 	if o == nil || IsNil(o.Description) {
@@ -2699,6 +2732,9 @@ func (o UpdateEnterpriseRoleRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Defaulttimeframe) {
 		toSerialize["defaulttimeframe"] = o.Defaulttimeframe
 	}
+	if !IsNil(o.Maxtimeframe) {
+		toSerialize["maxtimeframe"] = o.Maxtimeframe
+	}
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
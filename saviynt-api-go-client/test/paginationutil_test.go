@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/saviynt/saviynt-api-go-client/util/paginationutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageCall records the offset and max of a single fetch.
+type pageCall struct {
+	Offset int
+	Max    int
+}
+
+// fakeServer serves total records, at most maxPageSize per call, and records every call.
+type fakeServer struct {
+	total       int
+	maxPageSize int
+	calls       []pageCall
+}
+
+func (s *fakeServer) fetch(ctx context.Context, offset, max int) ([]int, error) {
+	s.calls = append(s.calls, pageCall{Offset: offset, Max: max})
+	if max > s.maxPageSize {
+		max = s.maxPageSize
+	}
+	var items []int
+	for i := offset; i < s.total && len(items) < max; i++ {
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+func collect(t *testing.T, seq func(yield func(int, error) bool)) []int {
+	t.Helper()
+	var items []int
+	for item, err := range seq {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func sequence(n int) []int {
+	items := []int{}
+	for i := 0; i < n; i++ {
+		items = append(items, i)
+	}
+	return items
+}
+
+func TestPaginationAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		pageSize    int
+		total       int
+		maxPageSize int
+		wantCalls   []pageCall
+	}{
+		{
+			name:        "stops after a short page",
+			pageSize:    3,
+			total:       7,
+			maxPageSize: 100,
+			wantCalls:   []pageCall{{0, 3}, {3, 3}, {6, 3}},
+		},
+		{
+			name:        "stops after an empty page",
+			pageSize:    3,
+			total:       6,
+			maxPageSize: 100,
+			wantCalls:   []pageCall{{0, 3}, {3, 3}, {6, 3}},
+		},
+		{
+			name:        "server caps the page size",
+			pageSize:    5,
+			total:       5,
+			maxPageSize: 2,
+			wantCalls:   []pageCall{{0, 5}, {2, 2}, {4, 2}},
+		},
+		{
+			name:        "server caps the page size at a multiple of the total",
+			pageSize:    10,
+			total:       8,
+			maxPageSize: 4,
+			wantCalls:   []pageCall{{0, 10}, {4, 4}, {8, 4}},
+		},
+		{
+			name:        "single short page",
+			pageSize:    5,
+			total:       2,
+			maxPageSize: 100,
+			wantCalls:   []pageCall{{0, 5}, {2, 2}},
+		},
+		{
+			name:        "no records",
+			pageSize:    5,
+			total:       0,
+			maxPageSize: 100,
+			wantCalls:   []pageCall{{0, 5}},
+		},
+		{
+			name:        "default page size",
+			pageSize:    0,
+			total:       150,
+			maxPageSize: 1000,
+			wantCalls:   []pageCall{{0, paginationutil.DefaultPageSize}, {paginationutil.DefaultPageSize, paginationutil.DefaultPageSize}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeServer{total: tt.total, maxPageSize: tt.maxPageSize}
+			items := collect(t, paginationutil.All(context.Background(), tt.pageSize, server.fetch))
+			if tt.total == 0 {
+				assert.Empty(t, items)
+			} else {
+				assert.Equal(t, sequence(tt.total), items)
+			}
+			assert.Equal(t, tt.wantCalls, server.calls)
+		})
+	}
+}
+
+func TestPaginationAllStopsEarly(t *testing.T) {
+	server := &fakeServer{total: 10, maxPageSize: 100}
+	var items []int
+	for item, err := range paginationutil.All(context.Background(), 3, server.fetch) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if len(items) == 4 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2, 3}, items)
+	assert.Equal(t, []pageCall{{0, 3}, {3, 3}}, server.calls)
+}
+
+func TestPaginationAllErrors(t *testing.T) {
+	t.Run("fetch error", func(t *testing.T) {
+		fetchErr := errors.New("error code 1: failed")
+		calls := 0
+		fetch := func(ctx context.Context, offset, max int) ([]int, error) {
+			calls++
+			if offset > 0 {
+				return nil, fetchErr
+			}
+			return []int{1, 2}, nil
+		}
+
+		var items []int
+		var errs []error
+		for item, err := range paginationutil.All(context.Background(), 2, fetch) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{1, 2}, items)
+		assert.Equal(t, []error{fetchErr}, errs)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("context cancelled before the first page", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		server := &fakeServer{total: 10, maxPageSize: 100}
+
+		var errs []error
+		for _, err := range paginationutil.All(ctx, 3, server.fetch) {
+			errs = append(errs, err)
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+		assert.Empty(t, server.calls)
+	})
+
+	t.Run("context cancelled between pages", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		server := &fakeServer{total: 10, maxPageSize: 100}
+
+		var items []int
+		var errs []error
+		for item, err := range paginationutil.All(ctx, 3, server.fetch) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			items = append(items, item)
+			cancel()
+		}
+		assert.Equal(t, []int{0, 1, 2}, items)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+		assert.Equal(t, []pageCall{{0, 3}}, server.calls)
+	})
+}
+
+func TestPaginationResponseError(t *testing.T) {
+	code := func(s string) *string { return &s }
+
+	assert.NoError(t, paginationutil.ResponseError(nil, nil))
+	assert.NoError(t, paginationutil.ResponseError(code("0"), code("Success")))
+	assert.NoError(t, paginationutil.ResponseError(code(""), nil))
+	assert.EqualError(t, paginationutil.ResponseError(code("1"), code("No records found")), "error code 1: No records found")
+	assert.EqualError(t, paginationutil.ResponseError(code("1"), nil), "error code 1: ")
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is NOT generated. It adds a paging iterator to UsersAPIService.
+package users
+
+import (
+	"context"
+	"iter"
+
+	"github.com/saviynt/saviynt-api-go-client/util/paginationutil"
+)
+
+// GetUserAll returns an iterator over every record of GetUser matching req, read pageSize
+// users at a time (paginationutil.DefaultPageSize when zero). The offset and max of req are set
+// for each page. Iteration stops after a short page; a failed call, an error code in the response
+// or the cancellation of ctx is yielded as an error and ends the iteration.
+func (a *UsersAPIService) GetUserAll(ctx context.Context, req GetUserRequest, pageSize int) iter.Seq2[User, error] {
+	return paginationutil.All(ctx, pageSize, func(ctx context.Context, offset, max int) ([]User, error) {
+		req.SetOffset(int32(offset))
+		req.SetMax(int32(max))
+		resp, _, err := a.GetUser(ctx).GetUserRequest(req).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, nil
+		}
+		if err := paginationutil.ResponseError(resp.ErrorCode, resp.Msg); err != nil {
+			return nil, err
+		}
+		return resp.Userdetails, nil
+	})
+}
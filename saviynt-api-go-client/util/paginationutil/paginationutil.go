@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package paginationutil reads list APIs that page with max and offset as iterators.
+package paginationutil
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// DefaultPageSize is the number of records read per page when the page size is zero or less.
+const DefaultPageSize = 100
+
+// Fetcher reads up to max records starting at offset.
+type Fetcher[T any] func(ctx context.Context, offset, max int) ([]T, error)
+
+// All returns an iterator over the records of every page read by fetch, pageSize records at a
+// time. Servers may return fewer records per page than requested, so a first page with fewer than
+// pageSize records sets the page size for the following pages instead of ending the iteration.
+// Iteration stops after an empty page or a page with fewer records than the page size. An error of
+// fetch, or the error of ctx once it is cancelled, is yielded with the zero record and ends the
+// iteration.
+func All[T any](ctx context.Context, pageSize int, fetch Fetcher[T]) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		step := pageSize
+		for offset := 0; ; offset += step {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, err := fetch(ctx, offset, step)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 {
+				return
+			}
+			if offset == 0 && len(items) < step {
+				// The server caps the page size below pageSize, or the first page is the only one;
+				// the next page tells them apart
+				step = len(items)
+				continue
+			}
+			if len(items) < step {
+				return
+			}
+		}
+	}
+}
+
+// ResponseError returns an error for a response whose error code is set and not "0".
+func ResponseError(errorCode, msg *string) error {
+	if errorCode == nil || *errorCode == "0" || *errorCode == "" {
+		return nil
+	}
+	message := ""
+	if msg != nil {
+		message = *msg
+	}
+	return fmt.Errorf("error code %s: %s", *errorCode, message)
+}
@@ -33,6 +33,7 @@ const (
 	ConnectorTypeGithubREST  ConnectorType = "GITHUBREST"
 	ConnectorTypeOkta        ConnectorType = "OKTA"
 	ConnectorTypeSFTP        ConnectorType = "SFTP"
+	ConnectorTypeGeneric     ConnectorType = "GENERIC"
 )
 
 // ErrorCategory represents different categories of errors
@@ -182,6 +183,8 @@ func GetErrorMessage(errorCode string) string {
 		connectorType = "Okta"
 	} else if strings.HasPrefix(errorCode, "SFTP_CONN_") {
 		connectorType = "SFTP"
+	} else if strings.HasPrefix(errorCode, "GENERIC_CONN_") {
+		connectorType = "Generic"
 	}
 
 	// Then check if it's a connector-specific error code and map to specific message
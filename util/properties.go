@@ -25,6 +25,7 @@ var SAPConnDescription = "Create and manage SAP connector in Saviynt"
 var SalesforceConnDescription = "Create and manage Salesforce connector in Saviynt"
 var UnixConnDescription = "Create and manage Unix connector in Saviynt"
 var WorkdayConnDescription = "Create and manage Workday connector in Saviynt"
+var ConnectionDescription = "Create and manage a connection of any connector type in Saviynt"
 var WorkdaySOAPConnDescription = "Create and manage Workday SOAP connector in Saviynt"
 var DynamicAttrDescription = "Create and manage Dynamic Attributes in Saviynt"
 var OktaConnDescription = "Create and manage Okta connector in Saviynt"
@@ -46,6 +47,16 @@ var JobControlDescription = "Create and manage job control operations in Saviynt
 var PrivilegeDescription = "Create and manage privileges in Saviynt"
 var ImportTransportPackageDescription = "Import transport packages in Saviynt"
 var ExportTransportPackageDescription = "Export transport packages from Saviynt"
+var TransportPackageDataSourceDescription = "Inspect an exported transport package on local disk and list the objects it contains with their checksums, without calling the Saviynt server"
+var RoleMembershipDescription = "Assign a single user to an enterprise role in Saviynt"
+var RoleMembersDescription = "Authoritatively manage all users assigned to an enterprise role in Saviynt"
+var RoleEntitlementDescription = "Attach a single entitlement to an enterprise role in Saviynt"
+var EntitlementMapDescription = "Map a single entitlement to a primary entitlement in Saviynt"
+var EntitlementsBulkDescription = "Create and update many entitlements of one endpoint and entitlement type in Saviynt from a list or a CSV or JSON file"
+var FirefighterRolesDataSourceDescription = "Retrieve the firefighter roles in Saviynt with their time frame limits, mapped firefighter ID accounts and last certification details"
+var RoleHistoryDataSourceDescription = "Retrieve the version metadata and last certification details of a role in Saviynt"
+var ConnectionTestDataSourceDescription = "Run the Test Connection check of Saviynt for a saved connection and return whether it succeeded, how long it took and the server message"
+var ConnectionTemplateDataSourceDescription = "Read a saved connection and return its non-secret attributes, keyed by the arguments of the matching connection resource, to create copies of it"
 var FileUploadDescription = "File upload resource for uploading files to Saviynt"
 var SFTPConnDescription = "Create and manage SFTP connector in Saviynt"
 
@@ -0,0 +1,268 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package transportutil inspects exported transport packages on local disk without
+// calling the Saviynt server. A transport package is a zip archive with one entry per
+// exported object, grouped in folders named after the object type.
+package transportutil
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Object types reported for the entries of a transport package
+const (
+	ObjectTypeRole           = "roles"
+	ObjectTypeSAVRole        = "sav_roles"
+	ObjectTypeWorkflow       = "workflows"
+	ObjectTypeConnection     = "connections"
+	ObjectTypeSecuritySystem = "security_systems"
+	ObjectTypeEmailTemplate  = "email_templates"
+	ObjectTypeAnalytics      = "analytics"
+	ObjectTypeOther          = "other"
+)
+
+// ObjectTypes lists every object type in the order they are reported
+var ObjectTypes = []string{
+	ObjectTypeRole,
+	ObjectTypeSAVRole,
+	ObjectTypeWorkflow,
+	ObjectTypeConnection,
+	ObjectTypeSecuritySystem,
+	ObjectTypeEmailTemplate,
+	ObjectTypeAnalytics,
+	ObjectTypeOther,
+}
+
+// maxEntrySize caps the uncompressed size read from a single package entry
+const maxEntrySize = 256 << 20
+
+// objectTypeAliases maps normalized folder names and file name prefixes to object types.
+// Keys are lower case with separators removed so that "SAV Roles", "sav_roles" and
+// "savRoles" all resolve to the same type.
+var objectTypeAliases = map[string]string{
+	"role":            ObjectTypeRole,
+	"roles":           ObjectTypeRole,
+	"savrole":         ObjectTypeSAVRole,
+	"savroles":        ObjectTypeSAVRole,
+	"workflow":        ObjectTypeWorkflow,
+	"workflows":       ObjectTypeWorkflow,
+	"connection":      ObjectTypeConnection,
+	"connections":     ObjectTypeConnection,
+	"connector":       ObjectTypeConnection,
+	"connectors":      ObjectTypeConnection,
+	"securitysystem":  ObjectTypeSecuritySystem,
+	"securitysystems": ObjectTypeSecuritySystem,
+	"emailtemplate":   ObjectTypeEmailTemplate,
+	"emailtemplates":  ObjectTypeEmailTemplate,
+	"analytics":       ObjectTypeAnalytics,
+	"analyticsv1":     ObjectTypeAnalytics,
+	"analyticsv2":     ObjectTypeAnalytics,
+	"analyticsconfig": ObjectTypeAnalytics,
+}
+
+// nameKeys are the JSON keys checked, in order, for the display name of an object
+var nameKeys = []string{
+	"name", "roleName", "rolename", "savRoleName", "workflowName", "workflowname",
+	"connectionName", "connectionname", "systemname", "systemName", "templateName",
+	"emailTemplateName", "analyticsName", "reportName",
+}
+
+// PackageObject describes a single object found in a transport package
+type PackageObject struct {
+	Type   string
+	Name   string
+	Path   string
+	SHA256 string
+	Size   int64
+}
+
+// Package describes the contents of a transport package
+type Package struct {
+	Path     string
+	FileName string
+	SHA256   string
+	Size     int64
+	Objects  []PackageObject
+}
+
+// Inspect opens the transport package at packagePath and lists the objects it contains.
+// The package is never modified and no network calls are made.
+func Inspect(packagePath string) (*Package, error) {
+	data, err := os.ReadFile(packagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transport package %q: %w", packagePath, err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("transport package %q is not a valid zip archive: %w", packagePath, err)
+	}
+
+	sum := sha256.Sum256(data)
+	pkg := &Package{
+		Path:     packagePath,
+		FileName: filepath.Base(packagePath),
+		SHA256:   hex.EncodeToString(sum[:]),
+		Size:     int64(len(data)),
+		Objects:  make([]PackageObject, 0, len(reader.File)),
+	}
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || isMetadataEntry(file.Name) {
+			continue
+		}
+		obj, err := inspectEntry(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect entry %q of transport package %q: %w", file.Name, packagePath, err)
+		}
+		pkg.Objects = append(pkg.Objects, obj)
+	}
+
+	sort.SliceStable(pkg.Objects, func(i, j int) bool {
+		a, b := pkg.Objects[i], pkg.Objects[j]
+		if a.Type != b.Type {
+			return typeOrder(a.Type) < typeOrder(b.Type)
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Path < b.Path
+	})
+
+	return pkg, nil
+}
+
+// ObjectsByType groups the object names of the package by object type. Every known
+// type is present in the result, with an empty list when the package has none.
+func (p *Package) ObjectsByType() map[string][]string {
+	grouped := make(map[string][]string, len(ObjectTypes))
+	for _, objectType := range ObjectTypes {
+		grouped[objectType] = []string{}
+	}
+	for _, obj := range p.Objects {
+		grouped[obj.Type] = append(grouped[obj.Type], obj.Name)
+	}
+	return grouped
+}
+
+// Filter returns the objects whose type is in objectTypes. An empty filter returns all objects.
+func (p *Package) Filter(objectTypes []string) []PackageObject {
+	if len(objectTypes) == 0 {
+		return p.Objects
+	}
+	wanted := make(map[string]bool, len(objectTypes))
+	for _, objectType := range objectTypes {
+		wanted[objectType] = true
+	}
+	filtered := make([]PackageObject, 0, len(p.Objects))
+	for _, obj := range p.Objects {
+		if wanted[obj.Type] {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// ClassifyEntry returns the object type of a package entry based on its folder names,
+// falling back to a prefix of the file name such as "Role_" or "workflow-".
+func ClassifyEntry(entryName string) string {
+	entryName = strings.Trim(path.Clean(strings.ReplaceAll(entryName, "\\", "/")), "/")
+	segments := strings.Split(entryName, "/")
+
+	for _, segment := range segments[:len(segments)-1] {
+		if objectType, ok := objectTypeAliases[normalize(segment)]; ok {
+			return objectType
+		}
+	}
+
+	base := segments[len(segments)-1]
+	if idx := strings.IndexAny(base, "_-."); idx > 0 {
+		if objectType, ok := objectTypeAliases[normalize(base[:idx])]; ok {
+			return objectType
+		}
+	}
+	return ObjectTypeOther
+}
+
+func inspectEntry(file *zip.File) (PackageObject, error) {
+	if file.UncompressedSize64 > maxEntrySize {
+		return PackageObject{}, fmt.Errorf("entry exceeds the maximum supported size of %d bytes", maxEntrySize)
+	}
+
+	rc, err := file.Open()
+	if err != nil {
+		return PackageObject{}, err
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxEntrySize+1))
+	if err != nil {
+		return PackageObject{}, err
+	}
+	if len(content) > maxEntrySize {
+		return PackageObject{}, fmt.Errorf("entry exceeds the maximum supported size of %d bytes", maxEntrySize)
+	}
+
+	sum := sha256.Sum256(content)
+	return PackageObject{
+		Type:   ClassifyEntry(file.Name),
+		Name:   objectName(file.Name, content),
+		Path:   file.Name,
+		SHA256: hex.EncodeToString(sum[:]),
+		Size:   int64(len(content)),
+	}, nil
+}
+
+// objectName reads the object name from a JSON entry and falls back to the file name
+// without its extension.
+func objectName(entryName string, content []byte) string {
+	if strings.EqualFold(path.Ext(entryName), ".json") {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(content, &fields); err == nil {
+			for _, key := range nameKeys {
+				if name, ok := fields[key].(string); ok && strings.TrimSpace(name) != "" {
+					return name
+				}
+			}
+		}
+	}
+	base := path.Base(strings.ReplaceAll(entryName, "\\", "/"))
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// isMetadataEntry reports whether the entry is archive metadata rather than an exported object
+func isMetadataEntry(entryName string) bool {
+	base := path.Base(strings.ReplaceAll(entryName, "\\", "/"))
+	return strings.HasPrefix(entryName, "__MACOSX/") || base == ".DS_Store"
+}
+
+func normalize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func typeOrder(objectType string) int {
+	for i, t := range ObjectTypes {
+		if t == objectType {
+			return i
+		}
+	}
+	return len(ObjectTypes)
+}
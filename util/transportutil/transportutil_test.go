@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Saviynt Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transportutil
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// fixturePackage follows the layout of an exported transport package: one folder per object
+// type under the export folder, JSON entries named after the object and archive metadata that
+// is not reported. See testdata/README.md.
+const fixturePackage = "testdata/transport_package.zip"
+
+func TestClassifyEntry(t *testing.T) {
+	tests := []struct {
+		name      string
+		entryName string
+		want      string
+	}{
+		{"roles folder", "transportPackage/Roles/Manager.json", ObjectTypeRole},
+		{"singular folder", "transportPackage/role/Manager.json", ObjectTypeRole},
+		{"folder with space", "transportPackage/SAV Roles/ROLE_ADMIN.json", ObjectTypeSAVRole},
+		{"snake case folder", "transportPackage/sav_roles/ROLE_ADMIN.json", ObjectTypeSAVRole},
+		{"camel case folder", "transportPackage/savRoles/ROLE_ADMIN.json", ObjectTypeSAVRole},
+		{"workflows folder", "transportPackage/workflows/User_Provisioning.json", ObjectTypeWorkflow},
+		{"connections folder", "transportPackage/Connections/AD_Connection.json", ObjectTypeConnection},
+		{"connectors folder", "Connectors/AD_Connection.json", ObjectTypeConnection},
+		{"security systems folder", "transportPackage/securitySystems/Active_Directory.json", ObjectTypeSecuritySystem},
+		{"email templates folder", "transportPackage/email_templates/Onboarding.json", ObjectTypeEmailTemplate},
+		{"analytics v2 folder", "transportPackage/AnalyticsV2/Dormant_Accounts.json", ObjectTypeAnalytics},
+		{"outermost known folder wins", "Roles/workflows/Manager.json", ObjectTypeRole},
+		{"file name prefix with dash", "transportPackage/Workflow-Approval.xml", ObjectTypeWorkflow},
+		{"file name prefix with underscore", "transportPackage/role_Auditor.json", ObjectTypeRole},
+		{"windows separators", `transportPackage\Roles\Manager.json`, ObjectTypeRole},
+		{"unknown folder and prefix", "transportPackage/manifest.txt", ObjectTypeOther},
+		{"unknown prefix", "misc/Manager_Role.json", ObjectTypeOther},
+		{"bare file name", "Manager.json", ObjectTypeOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyEntry(tt.entryName); got != tt.want {
+				t.Errorf("ClassifyEntry(%q) = %q, want %q", tt.entryName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectName(t *testing.T) {
+	tests := []struct {
+		name      string
+		entryName string
+		content   string
+		want      string
+	}{
+		{"name key", "Roles/a.json", `{"name":"Manager"}`, "Manager"},
+		{"role name key", "Roles/a.json", `{"roleName":"Manager"}`, "Manager"},
+		{"lower case key", "workflows/a.json", `{"workflowname":"User Provisioning"}`, "User Provisioning"},
+		{"first key in order wins", "Roles/a.json", `{"roleName":"Role","name":"Name"}`, "Name"},
+		{"blank value is skipped", "Roles/a.json", `{"name":"  ","roleName":"Manager"}`, "Manager"},
+		{"non string value is skipped", "Roles/a.json", `{"name":7}`, "a"},
+		{"no name key", "Roles/Auditor.json", `{"description":"x"}`, "Auditor"},
+		{"invalid JSON", "Roles/Auditor.json", `{`, "Auditor"},
+		{"JSON array", "Roles/Auditor.json", `[{"name":"Manager"}]`, "Auditor"},
+		{"upper case extension", "Roles/Auditor.JSON", `{"name":"Manager"}`, "Manager"},
+		{"not JSON", "Workflow-Approval.xml", `{"name":"Manager"}`, "Workflow-Approval"},
+		{"windows separators", `Roles\Auditor.txt`, ``, "Auditor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := objectName(tt.entryName, []byte(tt.content)); got != tt.want {
+				t.Errorf("objectName(%q) = %q, want %q", tt.entryName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	pkg, err := Inspect(fixturePackage)
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+
+	if pkg.FileName != "transport_package.zip" {
+		t.Errorf("FileName = %q, want %q", pkg.FileName, "transport_package.zip")
+	}
+	if len(pkg.SHA256) != 64 {
+		t.Errorf("SHA256 = %q, want a hex encoded sha256 checksum", pkg.SHA256)
+	}
+	info, err := os.Stat(fixturePackage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg.Size != info.Size() {
+		t.Errorf("Size = %d, want %d", pkg.Size, info.Size())
+	}
+
+	type object struct{ Type, Name, Path string }
+	want := []object{
+		{ObjectTypeRole, "Employee", "transportPackage/Roles/Employee.json"},
+		{ObjectTypeRole, "Manager", "transportPackage/Roles/Manager.json"},
+		{ObjectTypeRole, "role_Auditor", "transportPackage/role_Auditor.json"},
+		{ObjectTypeSAVRole, "ROLE_ADMIN", "transportPackage/SAV Roles/ROLE_ADMIN.json"},
+		{ObjectTypeWorkflow, "User Provisioning", "transportPackage/workflows/User_Provisioning.json"},
+		{ObjectTypeWorkflow, "Workflow-Approval", "transportPackage/Workflow-Approval.xml"},
+		{ObjectTypeConnection, "AD_Connection", "transportPackage/Connections/AD_Connection.json"},
+		{ObjectTypeSecuritySystem, "Active Directory", "transportPackage/securitySystems/Active_Directory.json"},
+		{ObjectTypeEmailTemplate, "Onboarding Mail", "transportPackage/email_templates/Onboarding.json"},
+		{ObjectTypeAnalytics, "Dormant Accounts", "transportPackage/AnalyticsV2/Dormant_Accounts.json"},
+		{ObjectTypeOther, "manifest", "transportPackage/manifest.txt"},
+	}
+	got := make([]object, 0, len(pkg.Objects))
+	for _, obj := range pkg.Objects {
+		got = append(got, object{obj.Type, obj.Name, obj.Path})
+		if len(obj.SHA256) != 64 || obj.Size <= 0 {
+			t.Errorf("object %q has SHA256 %q and size %d", obj.Path, obj.SHA256, obj.Size)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Objects = %+v\nwant %+v", got, want)
+	}
+
+	byType := pkg.ObjectsByType()
+	if len(byType) != len(ObjectTypes) {
+		t.Errorf("ObjectsByType() has %d types, want %d", len(byType), len(ObjectTypes))
+	}
+	if names := byType[ObjectTypeRole]; !reflect.DeepEqual(names, []string{"Employee", "Manager", "role_Auditor"}) {
+		t.Errorf("ObjectsByType()[roles] = %v", names)
+	}
+
+	if filtered := pkg.Filter([]string{ObjectTypeWorkflow, ObjectTypeConnection}); len(filtered) != 3 {
+		t.Errorf("Filter() returned %d objects, want 3", len(filtered))
+	}
+	if all := pkg.Filter(nil); len(all) != len(pkg.Objects) {
+		t.Errorf("Filter(nil) returned %d objects, want %d", len(all), len(pkg.Objects))
+	}
+}
+
+func TestInspectErrors(t *testing.T) {
+	dir := t.TempDir()
+	notZip := filepath.Join(dir, "package.zip")
+	if err := os.WriteFile(notZip, []byte("not a zip archive"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, packagePath := range []string{filepath.Join(dir, "missing.zip"), notZip} {
+		if _, err := Inspect(packagePath); err == nil {
+			t.Errorf("Inspect(%q) error = nil, want an error", packagePath)
+		}
+	}
+}